@@ -156,12 +156,18 @@ var (
 	MqCloudQueueManagerVersionUpdate            string
 	MqCloudVirtualPrivateEndPointTargetCrn      string
 	MqCloudVirtualPrivateEndPointTrustedProfile string
+	MqcloudAdminEndpoint                        string
+	MqcloudQueueManagerName                     string
+	MqcloudAdminUser                            string
+	MqcloudAdminAPIKey                          string
 )
 
 // Logs
 var (
 	LogsInstanceId                      string
 	LogsInstanceRegion                  string
+	MonitoringInstanceID                string
+	MonitoringInstanceRegion            string
 	LogsEventNotificationInstanceId     string
 	LogsEventNotificationInstanceRegion string
 )
@@ -2012,6 +2018,22 @@ func init() {
 	if MqcloudTSCertFilePath == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_TS_CERT_PATH for ibm_mqcloud_truststore_certificate resource or datasource else tests will fail if this is not set correctly")
 	}
+	MqcloudAdminEndpoint = os.Getenv("IBM_MQCLOUD_ADMIN_ENDPOINT")
+	if MqcloudAdminEndpoint == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_ADMIN_ENDPOINT for ibm_mqcloud_queue and ibm_mqcloud_channel resources else tests will fail if this is not set correctly")
+	}
+	MqcloudQueueManagerName = os.Getenv("IBM_MQCLOUD_QUEUEMANAGER_NAME")
+	if MqcloudQueueManagerName == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_QUEUEMANAGER_NAME for ibm_mqcloud_queue and ibm_mqcloud_channel resources else tests will fail if this is not set correctly")
+	}
+	MqcloudAdminUser = os.Getenv("IBM_MQCLOUD_ADMIN_USER")
+	if MqcloudAdminUser == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_ADMIN_USER for ibm_mqcloud_queue and ibm_mqcloud_channel resources else tests will fail if this is not set correctly")
+	}
+	MqcloudAdminAPIKey = os.Getenv("IBM_MQCLOUD_ADMIN_APIKEY")
+	if MqcloudAdminAPIKey == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_ADMIN_APIKEY for ibm_mqcloud_queue and ibm_mqcloud_channel resources else tests will fail if this is not set correctly")
+	}
 	MqCloudQueueManagerLocation = os.Getenv(("IBM_MQCLOUD_QUEUEMANAGER_LOCATION"))
 	if MqCloudQueueManagerLocation == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_MQCLOUD_QUEUEMANAGER_LOCATION for ibm_mqcloud_queue_manager resource or datasource else tests will fail if this is not set correctly")
@@ -2040,6 +2062,14 @@ func init() {
 	if LogsInstanceRegion == "" {
 		fmt.Println("[INFO] Set the environment variable IBMCLOUD_LOGS_SERVICE_INSTANCE_REGION for testing cloud logs related operations")
 	}
+	MonitoringInstanceID = os.Getenv("IBMCLOUD_MONITORING_INSTANCE_ID")
+	if MonitoringInstanceID == "" {
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_MONITORING_INSTANCE_ID for testing cloud monitoring related operations")
+	}
+	MonitoringInstanceRegion = os.Getenv("IBMCLOUD_MONITORING_INSTANCE_REGION")
+	if MonitoringInstanceRegion == "" {
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_MONITORING_INSTANCE_REGION for testing cloud monitoring related operations")
+	}
 	LogsEventNotificationInstanceId = os.Getenv("IBMCLOUD_LOGS_SERVICE_EVENT_NOTIFICATIONS_INSTANCE_ID")
 	if LogsEventNotificationInstanceId == "" {
 		fmt.Println("[INFO] Set the environment variable IBMCLOUD_LOGS_SERVICE_EVENT_NOTIFICATIONS_INSTANCE_ID for testing cloud logs related operations")
@@ -2269,6 +2299,18 @@ func TestAccPreCheckCloudLogs(t *testing.T) {
 	})
 }
 
+func TestAccPreCheckMonitoring(t *testing.T) {
+	if v := os.Getenv("IC_API_KEY"); v == "" {
+		t.Fatal("IC_API_KEY must be set for acceptance tests")
+	}
+	if MonitoringInstanceID == "" {
+		t.Fatal("IBMCLOUD_MONITORING_INSTANCE_ID must be set for acceptance tests")
+	}
+	if MonitoringInstanceRegion == "" {
+		t.Fatal("IBMCLOUD_MONITORING_INSTANCE_REGION must be set for acceptance tests")
+	}
+}
+
 func TestAccPreCheckCloudShell(t *testing.T) {
 	TestAccPreCheck(t)
 	if CloudShellAccountID == "" {
@@ -2461,6 +2503,22 @@ func TestAccPreCheckMqcloud(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckMqcloudAdmin(t *testing.T) {
+	TestAccPreCheck(t)
+	if MqcloudAdminEndpoint == "" {
+		t.Fatal("IBM_MQCLOUD_ADMIN_ENDPOINT must be set for acceptance tests")
+	}
+	if MqcloudQueueManagerName == "" {
+		t.Fatal("IBM_MQCLOUD_QUEUEMANAGER_NAME must be set for acceptance tests")
+	}
+	if MqcloudAdminUser == "" {
+		t.Fatal("IBM_MQCLOUD_ADMIN_USER must be set for acceptance tests")
+	}
+	if MqcloudAdminAPIKey == "" {
+		t.Fatal("IBM_MQCLOUD_ADMIN_APIKEY must be set for acceptance tests")
+	}
+}
+
 func TestAccPreCheckCbr(t *testing.T) {
 	TestAccPreCheck(t)
 	IAMAccountId = os.Getenv("IBM_IAMACCOUNTID")
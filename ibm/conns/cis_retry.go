@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// enableCisRetries applies the cis_retry setting of the provider to a CIS client whose retries are
+// enabled with core.BaseService.EnableRetries. Its retryable client already retries the 429 and
+// 5xx responses with an exponential backoff and honours Retry-After, so only its limits and its
+// retry policy are changed and the calls are retried by a single layer.
+func enableCisRetries(service *core.BaseService, config CisRetryConfig) {
+	if service == nil {
+		return
+	}
+	client := service.GetHTTPClient()
+	if client == nil {
+		return
+	}
+	transport, ok := client.Transport.(*retryablehttp.RoundTripper)
+	if !ok || transport.Client == nil {
+		return
+	}
+	transport.Client.RetryMax = config.MaxRetries
+	transport.Client.RetryWaitMin = config.MinDelay
+	transport.Client.RetryWaitMax = config.MaxDelay
+	transport.Client.CheckRetry = cisRetryPolicy
+}
+
+// cisRetryPolicy is the retry policy of the SDK for the idempotent calls. A POST may already have
+// created the object and a PATCH is not idempotent, they are only retried when CIS rejected them with
+// 429 or 503 and asks for a retry with Retry-After. They are not retried on a network error, as the
+// request may have reached CIS. Without a response the method is taken from the *url.Error of the
+// HTTP client.
+func cisRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, checkErr := core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	if !retry {
+		return retry, checkErr
+	}
+	method := ""
+	if resp != nil && resp.Request != nil {
+		method = resp.Request.Method
+	} else {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			method = strings.ToUpper(urlErr.Op)
+		}
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return retry, checkErr
+	case http.MethodPost, http.MethodPatch:
+		if resp == nil {
+			return false, checkErr
+		}
+		return (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) &&
+			resp.Header.Get("Retry-After") != "", nil
+	}
+	return false, checkErr
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCisRetryPolicy(t *testing.T) {
+	networkErr := func(method string) error {
+		return &url.Error{Op: method, URL: "https://api.cis.cloud.ibm.com", Err: errors.New("connection reset by peer")}
+	}
+	response := func(method string, status int, retryAfter string) *http.Response {
+		resp := &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Request:    &http.Request{Method: method},
+		}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	cases := []struct {
+		name  string
+		resp  *http.Response
+		err   error
+		retry bool
+	}{
+		{name: "GET network error", err: networkErr("Get"), retry: true},
+		{name: "DELETE network error", err: networkErr("Delete"), retry: true},
+		{name: "POST network error", err: networkErr("Post"), retry: false},
+		{name: "PATCH network error", err: networkErr("Patch"), retry: false},
+		{name: "unknown method network error", err: errors.New("connection reset by peer"), retry: false},
+		{name: "GET server error", resp: response(http.MethodGet, http.StatusInternalServerError, ""), retry: true},
+		{name: "PUT rate limited", resp: response(http.MethodPut, http.StatusTooManyRequests, ""), retry: true},
+		{name: "POST server error", resp: response(http.MethodPost, http.StatusInternalServerError, ""), retry: false},
+		{name: "POST rate limited without Retry-After", resp: response(http.MethodPost, http.StatusTooManyRequests, ""), retry: false},
+		{name: "POST rate limited with Retry-After", resp: response(http.MethodPost, http.StatusTooManyRequests, "2"), retry: true},
+		{name: "PATCH unavailable with Retry-After", resp: response(http.MethodPatch, http.StatusServiceUnavailable, "2"), retry: true},
+		{name: "GET not found", resp: response(http.MethodGet, http.StatusNotFound, ""), retry: false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			retry, _ := cisRetryPolicy(context.Background(), c.resp, c.err)
+			if retry != c.retry {
+				t.Errorf("expected retry %t, got %t", c.retry, retry)
+			}
+		})
+	}
+}
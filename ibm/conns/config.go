@@ -29,6 +29,7 @@ import (
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
 	kp "github.com/IBM/keyprotect-go-client"
@@ -49,6 +50,7 @@ import (
 	cisedgefunctionv1 "github.com/IBM/networking-go-sdk/edgefunctionsapiv1"
 	cisfiltersv1 "github.com/IBM/networking-go-sdk/filtersv1"
 	cisfirewallrulesv1 "github.com/IBM/networking-go-sdk/firewallrulesv1"
+	cisglbeventsv1 "github.com/IBM/networking-go-sdk/globalloadbalancereventsv1"
 	cisglbhealthcheckv1 "github.com/IBM/networking-go-sdk/globalloadbalancermonitorv1"
 	cisglbpoolv0 "github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
 	cisglbv1 "github.com/IBM/networking-go-sdk/globalloadbalancerv1"
@@ -140,6 +142,13 @@ import (
 // RetryAPIDelay - retry api delay
 const RetryAPIDelay = 5 * time.Second
 
+// Default retries of the CIS calls, used when cis_retry is not set
+const (
+	CisRetryDefaultMaxRetries = 5
+	CisRetryDefaultMinDelay   = 2 * time.Second
+	CisRetryDefaultMaxDelay   = 60 * time.Second
+)
+
 // BluemixRegion ...
 var BluemixRegion string
 
@@ -209,6 +218,19 @@ type Config struct {
 	Visibility          string
 	PrivateEndpointType string
 	EndpointsFile       string
+
+	// Retries of the CIS calls
+	CisRetry CisRetryConfig
+}
+
+// CisRetryConfig is the retry policy of the CIS calls that are rate limited or fail with a
+// server error. It replaces the limits of RetryCount and RetryDelay for the CIS clients. The delay
+// between the retries doubles from MinDelay up to MaxDelay, unless CIS sends a Retry-After header.
+// A POST or PATCH is only retried when CIS asks for it with Retry-After.
+type CisRetryConfig struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -227,9 +249,12 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	Authenticator() (core.Authenticator, error)
+	RetryConfig() (int, time.Duration)
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
+	VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error)
 	ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error)
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
@@ -276,6 +301,7 @@ type ClientSession interface {
 	CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error)
 	CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error)
 	CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error)
+	CisGLBEventsClientSession() (*cisglbeventsv1.GlobalLoadBalancerEventsV1, error)
 	CisIPClientSession() (*cisipv1.CisIpApiV1, error)
 	CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error)
 	CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error)
@@ -347,6 +373,12 @@ type clientSession struct {
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
+	authenticator    core.Authenticator
+	authenticatorErr error
+
+	retryCount int
+	retryDelay time.Duration
+
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
@@ -356,6 +388,9 @@ type clientSession struct {
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	vulnerabilityAdvisorClientErr error
+	vulnerabilityAdvisorClient    *vulnerabilityadvisorv4.VulnerabilityAdvisorV4
+
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
@@ -485,6 +520,10 @@ type clientSession struct {
 	cisGLBHealthCheckErr    error
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
+	// CIS GLB events service options
+	cisGLBEventsErr    error
+	cisGLBEventsClient *cisglbeventsv1.GlobalLoadBalancerEventsV1
+
 	// CIS IP service options
 	cisIPErr    error
 	cisIPClient *cisipv1.CisIpApiV1
@@ -746,6 +785,16 @@ func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// Authenticator provides the IAM authenticator of the session, for APIs that have no Go SDK
+func (sess clientSession) Authenticator() (core.Authenticator, error) {
+	return sess.authenticator, sess.authenticatorErr
+}
+
+// RetryConfig provides the retry count and delay of the provider, for clients of APIs that have no Go SDK
+func (sess clientSession) RetryConfig() (int, time.Duration) {
+	return sess.retryCount, sess.retryDelay
+}
+
 // ContainerAPI provides Container Service APIs ...
 func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
 	return sess.csServiceAPI, sess.csConfigErr
@@ -761,6 +810,11 @@ func (session clientSession) ContainerRegistryV1() (*containerregistryv1.Contain
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
+// VulnerabilityAdvisorV4 provides Vulnerability Advisor APIs ...
+func (session clientSession) VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error) {
+	return session.vulnerabilityAdvisorClient, session.vulnerabilityAdvisorClientErr
+}
+
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 	if sess.schematicsClientErr != nil {
@@ -1004,6 +1058,14 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 	return sess.cisGLBHealthCheckClient.Clone(), nil
 }
 
+// CIS GLB Events
+func (sess clientSession) CisGLBEventsClientSession() (*cisglbeventsv1.GlobalLoadBalancerEventsV1, error) {
+	if sess.cisGLBEventsErr != nil {
+		return sess.cisGLBEventsClient, sess.cisGLBEventsErr
+	}
+	return sess.cisGLBEventsClient.Clone(), nil
+}
+
 // CIS Zone Rate Limits
 func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
 	if sess.cisRLErr != nil {
@@ -1355,18 +1417,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:    sess,
+		retryCount: c.RetryCount,
+		retryDelay: c.RetryDelay,
 	}
 
 	if sess.BluemixSession == nil {
 		// Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
 		session.bluemixSessionErr = errEmptyBluemixCredentials
+		session.authenticatorErr = errEmptyBluemixCredentials
 		session.accountConfigErr = errEmptyBluemixCredentials
 		session.accountV1ConfigErr = errEmptyBluemixCredentials
 		session.csConfigErr = errEmptyBluemixCredentials
 		session.csv2ConfigErr = errEmptyBluemixCredentials
 		session.containerRegistryClientErr = errEmptyBluemixCredentials
+		session.vulnerabilityAdvisorClientErr = errEmptyBluemixCredentials
 		session.kpErr = errEmptyBluemixCredentials
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
@@ -1408,6 +1474,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisGLBPoolErr = errEmptyBluemixCredentials
 		session.cisGLBErr = errEmptyBluemixCredentials
 		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
+		session.cisGLBEventsErr = errEmptyBluemixCredentials
 		session.cisIPErr = errEmptyBluemixCredentials
 		session.cisZonesErr = errEmptyBluemixCredentials
 		session.cisRLErr = errEmptyBluemixCredentials
@@ -1651,6 +1718,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 		}
 	}
+	session.authenticator = authenticator
 
 	// Construct the service options.
 	var backupRecoveryURL string
@@ -2226,6 +2294,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	// VULNERABILITY ADVISOR Service
+	// Vulnerability Advisor is served by the same regional registry endpoint as Container Registry
+	vulnerabilityAdvisorClientOptions := &vulnerabilityadvisorv4.VulnerabilityAdvisorV4Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		Account:       core.StringPtr(userConfig.UserAccount),
+	}
+	session.vulnerabilityAdvisorClient, err = vulnerabilityadvisorv4.NewVulnerabilityAdvisorV4(vulnerabilityAdvisorClientOptions)
+	if err != nil {
+		session.vulnerabilityAdvisorClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Vulnerability Advisor API service: %q", err)
+	}
+	if session.vulnerabilityAdvisorClient != nil && session.vulnerabilityAdvisorClient.Service != nil {
+		// Enable retries for API calls
+		session.vulnerabilityAdvisorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.vulnerabilityAdvisorClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -2551,6 +2639,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBEventsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
@@ -2591,6 +2680,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
 		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisZonesV1Client.Service, c.CisRetry)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2609,6 +2699,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
 		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisDNSRecordsClient.Service, c.CisRetry)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2629,6 +2720,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
 		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisDNSRecordBulkClient.Service, c.CisRetry)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2647,6 +2739,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
 		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisGLBPoolClient.Service, c.CisRetry)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2666,6 +2759,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
 		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisGLBClient.Service, c.CisRetry)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2684,11 +2778,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
 		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisGLBHealthCheckClient.Service, c.CisRetry)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 
+	// IBM Network CIS Global load balancer events
+	cisGLBEventsOpt := &cisglbeventsv1.GlobalLoadBalancerEventsV1Options{
+		URL:           cisEndPoint,
+		Crn:           core.StringPtr(""),
+		Authenticator: authenticator,
+	}
+	session.cisGLBEventsClient, session.cisGLBEventsErr = cisglbeventsv1.NewGlobalLoadBalancerEventsV1(cisGLBEventsOpt)
+	if session.cisGLBEventsErr != nil {
+		session.cisGLBEventsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Events service: %s",
+			session.cisGLBEventsErr)
+	}
+	if session.cisGLBEventsClient != nil && session.cisGLBEventsClient.Service != nil {
+		session.cisGLBEventsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisGLBEventsClient.Service, c.CisRetry)
+		session.cisGLBEventsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// IBM Network CIS IP
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
@@ -2701,6 +2815,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
 		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisIPClient.Service, c.CisRetry)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2721,6 +2836,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
 		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisRLClient.Service, c.CisRetry)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2738,6 +2854,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
 		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisAlertsClient.Service, c.CisRetry)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2757,6 +2874,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
 		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisRulesetsClient.Service, c.CisRetry)
 		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2777,6 +2895,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
 		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisPageRuleClient.Service, c.CisRetry)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2796,6 +2915,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
 		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisEdgeFunctionClient.Service, c.CisRetry)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2816,6 +2936,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
 		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisSSLClient.Service, c.CisRetry)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2835,6 +2956,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
 		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisWAFPackageClient.Service, c.CisRetry)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2854,6 +2976,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
 		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisDomainSettingsClient.Service, c.CisRetry)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2873,6 +2996,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
 		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisRoutingClient.Service, c.CisRetry)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2892,6 +3016,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
 		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisWAFGroupClient.Service, c.CisRetry)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2911,6 +3036,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
 		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisCacheClient.Service, c.CisRetry)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2931,6 +3057,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
 		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisCustomPageClient.Service, c.CisRetry)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2950,6 +3077,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
 		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisAccessRuleClient.Service, c.CisRetry)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2969,6 +3097,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
 		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisUARuleClient.Service, c.CisRetry)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2988,6 +3117,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
 		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisLockdownClient.Service, c.CisRetry)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3007,6 +3137,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
 		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisRangeAppClient.Service, c.CisRetry)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3027,6 +3158,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
 		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisWAFRuleClient.Service, c.CisRetry)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3047,6 +3179,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
 		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisLogpushJobsClient.Service, c.CisRetry)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3065,6 +3198,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
 		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisMtlsClient.Service, c.CisRetry)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3084,6 +3218,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
 		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisBotManagementClient.Service, c.CisRetry)
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3103,6 +3238,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
 		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisBotAnalyticsClient.Service, c.CisRetry)
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3121,6 +3257,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
 		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisWebhooksClient.Service, c.CisRetry)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3137,6 +3274,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
 		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisFiltersClient.Service, c.CisRetry)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3154,6 +3292,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
 		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisFirewallRulesClient.Service, c.CisRetry)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3175,6 +3314,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
 		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisOriginAuthClient.Service, c.CisRetry)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -3196,6 +3336,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisListsClient != nil && session.cisListsClient.Service != nil {
 		session.cisListsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enableCisRetries(session.cisListsClient.Service, c.CisRetry)
 		session.cisListsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
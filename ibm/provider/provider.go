@@ -51,6 +51,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/logs"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/logsrouting"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/metricsrouter"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/monitoring"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/mqcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/pag"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/partnercentersell"
@@ -237,6 +238,37 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"cis_retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retries of the CIS calls that are rate limited (429) or fail with a server error (5xx)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      conns.CisRetryDefaultMaxRetries,
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 20),
+							Description:  "The number of retries of a CIS call, 0 disables the retries.",
+						},
+						"min_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(conns.CisRetryDefaultMinDelay / time.Second),
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 600),
+							Description:  "The delay (in seconds) before the first retry, doubled for each retry.",
+						},
+						"max_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(conns.CisRetryDefaultMaxDelay / time.Second),
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 600),
+							Description:  "The maximum delay (in seconds) between two retries, unless CIS asks for a longer delay with Retry-After.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -314,6 +346,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_global_load_balancers":                 cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                          cis.DataSourceIBMCISOriginPools(),
 			"ibm_cis_healthchecks":                          cis.DataSourceIBMCISHealthChecks(),
+			"ibm_cis_healthcheck_events":                    cis.DataSourceIBMCISHealthCheckEvents(),
 			"ibm_cis_domain":                                cis.DataSourceIBMCISDomain(),
 			"ibm_cis_firewall":                              cis.DataSourceIBMCISFirewallsRecord(),
 			"ibm_cis_cache_settings":                        cis.DataSourceIBMCISCacheSetting(),
@@ -393,6 +426,7 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host_flavor":           kubernetes.DataSourceIBMContainerDedicatedHostFlavor(),
 			"ibm_container_dedicated_host_flavors":          kubernetes.DataSourceIBMContainerDedicatedHostFlavors(),
 			"ibm_container_dedicated_host":                  kubernetes.DataSourceIBMContainerDedicatedHost(),
+			"ibm_cr_images":                                 registry.DataIBMContainerRegistryImages(),
 			"ibm_cr_namespaces":                             registry.DataIBMContainerRegistryNamespaces(),
 			"ibm_cloud_shell_account_settings":              cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                                cos.DataSourceIBMCosBucket(),
@@ -411,6 +445,7 @@ func Provider() *schema.Provider {
 			"ibm_hpcs_managed_key":                          hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                         hpcs.DataSourceIbmKeyTemplate(),
 			"ibm_hpcs_keystore":                             hpcs.DataSourceIbmKeystore(),
+			"ibm_hpcs_key_inventory":                        hpcs.DataSourceIbmKeyInventory(),
 			"ibm_hpcs_vault":                                hpcs.DataSourceIbmVault(),
 			"ibm_iam_access_group":                          iamaccessgroup.DataSourceIBMIAMAccessGroup(),
 			"ibm_iam_access_group_policy":                   iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
@@ -633,6 +668,7 @@ func Provider() *schema.Provider {
 
 			"ibm_is_vpn_gateway_connection_local_cidrs": vpc.DataSourceIBMIsVPNGatewayConnectionLocalCidrs(),
 			"ibm_is_vpn_gateway_connection_peer_cidrs":  vpc.DataSourceIBMIsVPNGatewayConnectionPeerCidrs(),
+			"ibm_is_vpn_gateway_connection_routes":      vpc.DataSourceIBMIsVPNGatewayConnectionRoutes(),
 
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_table":               vpc.DataSourceIBMIsVPCRoutingTable(),
@@ -664,6 +700,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_registrations":                  kms.DataSourceIBMKMSRegistrations(),
 			"ibm_kms_kmip_adapter":                   kms.DataSourceIBMKMSKmipAdapter(),
 			"ibm_kms_kmip_adapters":                  kms.DataSourceIBMKMSKmipAdapters(),
 			"ibm_kms_kmip_client_cert":               kms.DataSourceIBMKmsKMIPClientCertificate(),
@@ -729,6 +766,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_hosts":                                  power.DataSourceIBMPIHosts(),
 			"ibm_pi_image":                                  power.DataSourceIBMPIImage(),
 			"ibm_pi_images":                                 power.DataSourceIBMPIImages(),
+			"ibm_pi_instance_console":                       power.DataSourceIBMPIInstanceConsole(),
 			"ibm_pi_instance_ip":                            power.DataSourceIBMPIInstanceIP(),
 			"ibm_pi_instance_snapshot":                      power.DataSourceIBMPIInstanceSnapshot(),
 			"ibm_pi_instance_snapshots":                     power.DataSourceIBMPIInstanceSnapshots(),
@@ -738,6 +776,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_key":                                    power.DataSourceIBMPIKey(),
 			"ibm_pi_keys":                                   power.DataSourceIBMPIKeys(),
 			"ibm_pi_network_address_group":                  power.DataSourceIBMPINetworkAddressGroup(),
+			"ibm_pi_network_available_ips":                  power.DataSourceIBMPINetworkAvailableIPs(),
 			"ibm_pi_network_address_groups":                 power.DataSourceIBMPINetworkAddressGroups(),
 			"ibm_pi_network_interface":                      power.DataSourceIBMPINetworkInterface(),
 			"ibm_pi_network_interfaces":                     power.DataSourceIBMPINetworkInterfaces(),
@@ -785,6 +824,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_snapshots":                       power.DataSourceIBMPIVolumeSnapshots(),
 			"ibm_pi_volume":                                 power.DataSourceIBMPIVolume(),
 			"ibm_pi_workspace":                              power.DatasourceIBMPIWorkspace(),
+			"ibm_pi_workspace_capabilities":                 power.DataSourceIBMPIWorkspaceCapabilities(),
 			"ibm_pi_workspaces":                             power.DatasourceIBMPIWorkspaces(),
 
 			// Added for private dns zones
@@ -792,12 +832,14 @@ func Provider() *schema.Provider {
 			"ibm_dns_zones":                            dnsservices.DataSourceIBMPrivateDNSZones(),
 			"ibm_dns_permitted_networks":               dnsservices.DataSourceIBMPrivateDNSPermittedNetworks(),
 			"ibm_dns_resource_records":                 dnsservices.DataSourceIBMPrivateDNSResourceRecords(),
+			"ibm_dns_zone_export":                      dnsservices.DataSourceIBMDNSZoneExport(),
 			"ibm_dns_glb_monitors":                     dnsservices.DataSourceIBMPrivateDNSGLBMonitors(),
 			"ibm_dns_glb_pools":                        dnsservices.DataSourceIBMPrivateDNSGLBPools(),
 			"ibm_dns_glbs":                             dnsservices.DataSourceIBMPrivateDNSGLBs(),
 			"ibm_dns_custom_resolvers":                 dnsservices.DataSourceIBMPrivateDNSCustomResolver(),
 			"ibm_dns_custom_resolver_forwarding_rules": dnsservices.DataSourceIBMPrivateDNSForwardingRules(),
 			"ibm_dns_custom_resolver_secondary_zones":  dnsservices.DataSourceIBMPrivateDNSSecondaryZones(),
+			"ibm_dns_zone_access_requests":             dnsservices.DataSourceIBMDNSZoneAccessRequests(),
 
 			// Added for Direct Link
 
@@ -837,6 +879,7 @@ func Provider() *schema.Provider {
 
 			// //Added for Usage Reports
 			"ibm_billing_snapshot_list": usagereports.DataSourceIBMBillingSnapshotList(),
+			"ibm_billing_usage":         usagereports.DataSourceIBMBillingUsage(),
 
 			// Added for Secrets Manager
 			"ibm_sm_secret_group":  secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretGroup()),
@@ -1063,7 +1106,8 @@ func Provider() *schema.Provider {
 			"ibm_project_environment": project.DataSourceIbmProjectEnvironment(),
 
 			// Added for VMware as a Service
-			"ibm_vmaas_vdc": vmware.DataSourceIbmVmaasVdc(),
+			"ibm_vmaas_vdc":            vmware.DataSourceIbmVmaasVdc(),
+			"ibm_vmaas_director_sites": vmware.DataSourceIbmVmaasDirectorSites(),
 			// Logs Service
 			"ibm_logs_alert":              logs.AddLogsInstanceFields(logs.DataSourceIbmLogsAlert()),
 			"ibm_logs_alerts":             logs.AddLogsInstanceFields(logs.DataSourceIbmLogsAlerts()),
@@ -1125,6 +1169,7 @@ func Provider() *schema.Provider {
 			"ibm_appid_audit_status":             appid.ResourceIBMAppIDAuditStatus(),
 			"ibm_appid_cloud_directory_template": appid.ResourceIBMAppIDCloudDirectoryTemplate(),
 			"ibm_appid_cloud_directory_user":     appid.ResourceIBMAppIDCloudDirectoryUser(),
+			"ibm_appid_idp_attribute_mapping":    appid.ResourceIBMAppIDIDPAttributeMapping(),
 			"ibm_appid_idp_cloud_directory":      appid.ResourceIBMAppIDIDPCloudDirectory(),
 			"ibm_appid_idp_custom":               appid.ResourceIBMAppIDIDPCustom(),
 			"ibm_appid_idp_facebook":             appid.ResourceIBMAppIDIDPFacebook(),
@@ -1170,6 +1215,8 @@ func Provider() *schema.Provider {
 			"ibm_cis_origin_auth":                     cis.ResourceIBMCISOriginAuthPull(),
 			"ibm_cis_mtls":                            cis.ResourceIBMCISMtls(),
 			"ibm_cis_mtls_app":                        cis.ResourceIBMCISMtlsApp(),
+			"ibm_cis_mtls_hostname_settings":          cis.ResourceIBMCISMtlsHostnameSettings(),
+			"ibm_cis_cache_rule":                      cis.ResourceIBMCISCacheRule(),
 			"ibm_cis_bot_management":                  cis.ResourceIBMCISBotManagement(),
 			"ibm_cis_logpush_job":                     cis.ResourceIBMCISLogPushJob(),
 			"ibm_cis_alert":                           cis.ResourceIBMCISAlert(),
@@ -1181,6 +1228,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_order":               cis.ResourceIBMCISCertificateOrder(),
 			"ibm_cis_filter":                          cis.ResourceIBMCISFilter(),
 			"ibm_cis_firewall_rule":                   cis.ResourceIBMCISFirewallrules(),
+			"ibm_cis_firewall_rule_set":               cis.ResourceIBMCISFirewallRuleSet(),
 			"ibm_cis_ruleset":                         cis.ResourceIBMCISRuleset(),
 			"ibm_cis_ruleset_version_detach":          cis.ResourceIBMCISRulesetVersionDetach(),
 			"ibm_cis_ruleset_rule":                    cis.ResourceIBMCISRulesetRule(),
@@ -1192,6 +1240,8 @@ func Provider() *schema.Provider {
 
 			"ibm_cloudant":                                  cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                         cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_replication":                      cloudant.ResourceIBMCloudantReplication(),
+			"ibm_cloudant_index":                            cloudant.ResourceIBMCloudantIndex(),
 			"ibm_cloud_shell_account_settings":              cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":                   classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":                  classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -1227,6 +1277,7 @@ func Provider() *schema.Provider {
 			"ibm_container_nlb_dns":                         kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":             kubernetes.ResourceIBMContainerDedicatedHostPool(),
 			"ibm_container_dedicated_host":                  kubernetes.ResourceIBMContainerDedicatedHost(),
+			"ibm_cr_exemption":                              registry.ResourceIBMCrExemption(),
 			"ibm_cr_namespace":                              registry.ResourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                       registry.ResourceIBMCrRetentionPolicy(),
 			"ibm_cos_bucket":                                cos.ResourceIBMCOSBucket(),
@@ -1277,6 +1328,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_identities":            iamidentity.ResourceIBMIamTrustedProfileIdentities(),
 			"ibm_iam_trusted_profile_claim_rule":            iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
 			"ibm_iam_trusted_profile_link":                  iamidentity.ResourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_identity_preference":                   iamidentity.ResourceIBMIamIdentityPreference(),
 			"ibm_iam_trusted_profile_policy":                iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
 			"ibm_iam_account_settings_template":             iamidentity.ResourceIBMAccountSettingsTemplate(),
 			"ibm_iam_trusted_profile_template":              iamidentity.ResourceIBMTrustedProfileTemplate(),
@@ -1361,6 +1413,7 @@ func Provider() *schema.Provider {
 			"ibm_is_reservation":                           vpc.ResourceIBMISReservation(),
 			"ibm_is_reservation_activate":                  vpc.ResourceIBMISReservationActivate(),
 			"ibm_is_subnet_reserved_ip":                    vpc.ResourceIBMISReservedIP(),
+			"ibm_is_subnet_reserved_ip_pool":               vpc.ResourceIBMISSubnetReservedIPPool(),
 			"ibm_is_subnet_reserved_ip_patch":              vpc.ResourceIBMISReservedIPPatch(),
 			"ibm_is_subnet_network_acl_attachment":         vpc.ResourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_subnet_public_gateway_attachment":      vpc.ResourceIBMISSubnetPublicGatewayAttachment(),
@@ -1416,6 +1469,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_with_policy_overrides":            kms.ResourceIBMKmsKeyWithPolicyOverrides(),
 			"ibm_kms_key_alias":                            kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                            kms.ResourceIBMKmskeyRings(),
+			"ibm_kms_import_token":                         kms.ResourceIBMKmsImportToken(),
 			"ibm_kms_key_policies":                         kms.ResourceIBMKmskeyPolicies(),
 			"ibm_kp_key":                                   kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                    kms.ResourceIBMKmsInstancePolicy(),
@@ -1472,6 +1526,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_network_address_group":           power.ResourceIBMPINetworkAddressGroup(),
 			"ibm_pi_network_interface":               power.ResourceIBMPINetworkInterface(),
 			"ibm_pi_network_port_attach":             power.ResourceIBMPINetworkPortAttach(),
+			"ibm_pi_network_ports":                   power.ResourceIBMPINetworkPorts(),
 			"ibm_pi_network_security_group_action":   power.ResourceIBMPINetworkSecurityGroupAction(),
 			"ibm_pi_network_security_group_member":   power.ResourceIBMPINetworkSecurityGroupMember(),
 			"ibm_pi_network_security_group_rule":     power.ResourceIBMPINetworkSecurityGroupRule(),
@@ -1496,6 +1551,8 @@ func Provider() *schema.Provider {
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
 			"ibm_dns_permitted_network": dnsservices.ResourceIBMPrivateDNSPermittedNetwork(),
 			"ibm_dns_resource_record":   dnsservices.ResourceIBMPrivateDNSResourceRecord(),
+			"ibm_dns_resource_records":  dnsservices.ResourceIBMDNSResourceRecords(),
+			"ibm_dns_zone_import":       dnsservices.ResourceIBMDNSZoneImport(),
 			"ibm_dns_glb_monitor":       dnsservices.ResourceIBMPrivateDNSGLBMonitor(),
 			"ibm_dns_glb_pool":          dnsservices.ResourceIBMPrivateDNSGLBPool(),
 			"ibm_dns_glb":               dnsservices.ResourceIBMPrivateDNSGLB(),
@@ -1505,6 +1562,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_custom_resolver_forwarding_rule": dnsservices.ResourceIBMPrivateDNSForwardingRule(),
 			"ibm_dns_custom_resolver_secondary_zone":  dnsservices.ResourceIBMPrivateDNSSecondaryZone(),
 			"ibm_dns_linked_zone":                     dnsservices.ResourceIBMDNSLinkedZone(),
+			"ibm_dns_zone_access_request":             dnsservices.ResourceIBMDNSZoneAccessRequest(),
 
 			// Direct Link related resources
 			"ibm_dl_gateway":               directlink.ResourceIBMDLGateway(),
@@ -1572,6 +1630,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsConfiguration()),
 			"ibm_sm_custom_credentials_configuration":                            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmCustomCredentialsConfiguration()),
 			"ibm_sm_public_certificate_action_validate_manual_dns":               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateActionValidateManualDns()),
+			"ibm_sm_secret_rotation":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretRotation()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistration()),
 			"ibm_sm_private_certificate_configuration_action_sign_csr":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSignCsr()),
 			"ibm_sm_private_certificate_configuration_action_set_signed":         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSetSigned()),
@@ -1594,6 +1653,9 @@ func Provider() *schema.Provider {
 			// Added for Iam Access Tag
 			"ibm_iam_access_tag": globaltagging.ResourceIBMIamAccessTag(),
 
+			// Added for unused tag cleanup
+			"ibm_resource_tag_cleanup": globaltagging.ResourceIBMResourceTagCleanup(),
+
 			// Atracker
 			"ibm_atracker_target":   atracker.ResourceIBMAtrackerTarget(),
 			"ibm_atracker_route":    atracker.ResourceIBMAtrackerRoute(),
@@ -1604,6 +1666,11 @@ func Provider() *schema.Provider {
 			"ibm_metrics_router_route":    metricsrouter.ResourceIBMMetricsRouterRoute(),
 			"ibm_metrics_router_settings": metricsrouter.ResourceIBMMetricsRouterSettings(),
 
+			// Cloud Monitoring
+			"ibm_monitoring_notification_channel": monitoring.ResourceIBMMonitoringNotificationChannel(),
+			"ibm_monitoring_alert":                monitoring.ResourceIBMMonitoringAlert(),
+			"ibm_monitoring_team":                 monitoring.ResourceIBMMonitoringTeam(),
+
 			// MQ on Cloud
 			"ibm_mqcloud_queue_manager":                    mqcloud.ResourceIbmMqcloudQueueManager(),
 			"ibm_mqcloud_application":                      mqcloud.ResourceIbmMqcloudApplication(),
@@ -1611,6 +1678,8 @@ func Provider() *schema.Provider {
 			"ibm_mqcloud_keystore_certificate":             mqcloud.ResourceIbmMqcloudKeystoreCertificate(),
 			"ibm_mqcloud_truststore_certificate":           mqcloud.ResourceIbmMqcloudTruststoreCertificate(),
 			"ibm_mqcloud_virtual_private_endpoint_gateway": mqcloud.ResourceIbmMqcloudVirtualPrivateEndpointGateway(),
+			"ibm_mqcloud_queue":                            mqcloud.ResourceIbmMqcloudQueue(),
+			"ibm_mqcloud_channel":                          mqcloud.ResourceIbmMqcloudChannel(),
 
 			// Security and Compliance Center(soon to be deprecated)
 			"ibm_scc_account_settings":    scc.ResourceIBMSccAccountSettings(),
@@ -1961,6 +2030,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_certificate_order":                    cis.ResourceIBMCISCertificateOrderValidator(),
 				"ibm_cis_filter":                               cis.ResourceIBMCISFilterValidator(),
 				"ibm_cis_firewall_rules":                       cis.ResourceIBMCISFirewallrulesValidator(),
+				"ibm_cis_firewall_rule_set":                    cis.ResourceIBMCISFirewallRuleSetValidator(),
 				"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooksValidator(),
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
@@ -1971,6 +2041,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_logpush_job":                          cis.ResourceIBMCISLogPushJobValidator(),
 				"ibm_cis_mtls_app":                             cis.ResourceIBMCISMtlsAppValidator(),
 				"ibm_cis_mtls":                                 cis.ResourceIBMCISMtlsValidator(),
+				"ibm_cis_mtls_hostname_settings":               cis.ResourceIBMCISMtlsHostnameSettingsValidator(),
+				"ibm_cis_cache_rule":                           cis.ResourceIBMCISCacheRuleValidator(),
 				"ibm_cis_bot_management":                       cis.ResourceIBMCISBotManagementValidator(),
 				"ibm_cis_origin_auth":                          cis.ResourceIBMCISOriginAuthPullValidator(),
 				"ibm_cis_origin_pool":                          cis.ResourceIBMCISPoolValidator(),
@@ -2023,6 +2095,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_mqcloud_keystore_certificate":             mqcloud.ResourceIbmMqcloudKeystoreCertificateValidator(),
 				"ibm_mqcloud_truststore_certificate":           mqcloud.ResourceIbmMqcloudTruststoreCertificateValidator(),
 				"ibm_mqcloud_virtual_private_endpoint_gateway": mqcloud.ResourceIbmMqcloudVirtualPrivateEndpointGatewayValidator(),
+				"ibm_mqcloud_queue":                            mqcloud.ResourceIbmMqcloudQueueValidator(),
+				"ibm_mqcloud_channel":                          mqcloud.ResourceIbmMqcloudChannelValidator(),
 
 				"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicyValidator(),
 				"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlanValidator(),
@@ -2201,6 +2275,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_access_group_template_assignment": iamaccessgroup.ResourceIBMIAMAccessGroupTemplateAssignmentValidator(),
 				"ibm_iam_trusted_profile_claim_rule":       iamidentity.ResourceIBMIAMTrustedProfileClaimRuleValidator(),
 				"ibm_iam_trusted_profile_link":             iamidentity.ResourceIBMIAMTrustedProfileLinkValidator(),
+				"ibm_iam_identity_preference":              iamidentity.ResourceIBMIamIdentityPreferenceValidator(),
 				"ibm_iam_service_api_key":                  iamidentity.ResourceIBMIAMServiceAPIKeyValidator(),
 				"ibm_iam_trusted_profile_identity":         iamidentity.ResourceIBMIamTrustedProfileIdentityValidator(),
 
@@ -2299,6 +2374,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_firewall":                    cis.DataSourceIBMCISFirewallsRecordValidator(),
 				"ibm_cis_global_load_balancers":       cis.DataSourceIBMCISGlbsValidator(),
 				"ibm_cis_healthchecks":                cis.DataSourceIBMCISHealthChecksValidator(),
+				"ibm_cis_healthcheck_events":          cis.DataSourceIBMCISHealthCheckEventsValidator(),
 				"ibm_cis_mtls_apps":                   cis.DataSourceIBMCISMtlsAppValidator(),
 				"ibm_cis_mtlss":                       cis.DataSourceIBMCISMtlsValidator(),
 				"ibm_cis_origin_auths":                cis.DataSourceIBMCISOriginAuthPullValidator(),
@@ -2426,6 +2502,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	cisRetry := conns.CisRetryConfig{
+		MaxRetries: conns.CisRetryDefaultMaxRetries,
+		MinDelay:   conns.CisRetryDefaultMinDelay,
+		MaxDelay:   conns.CisRetryDefaultMaxDelay,
+	}
+	if r, ok := d.GetOk("cis_retry"); ok && len(r.([]interface{})) > 0 && r.([]interface{})[0] != nil {
+		retry := r.([]interface{})[0].(map[string]interface{})
+		cisRetry.MaxRetries = retry["max_retries"].(int)
+		cisRetry.MinDelay = time.Duration(retry["min_delay"].(int)) * time.Second
+		cisRetry.MaxDelay = time.Duration(retry["max_delay"].(int)) * time.Second
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
@@ -2463,6 +2550,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		PrivateEndpointType:  privateEndpointType,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		CisRetry:             cisRetry,
 	}
 
 	return config.ClientSession()
@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The attribute mappings of an identity provider are the token claims whose source is the provider.
+// They are kept in the token configuration of the tenant, the claims of the other sources are left
+// untouched.
+func ResourceIBMAppIDIDPAttributeMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMAppIDIDPAttributeMappingCreate,
+		ReadContext:   resourceIBMAppIDIDPAttributeMappingRead,
+		UpdateContext: resourceIBMAppIDIDPAttributeMappingUpdate,
+		DeleteContext: resourceIBMAppIDIDPAttributeMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The service `tenantId`",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"identity_provider": {
+				Description:  "The identity provider the attributes are mapped from: `saml` or `appid_custom` for the custom OIDC/JWT identity provider",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"saml", "appid_custom"}, false),
+			},
+			"attribute": {
+				Description: "A set of identity provider attributes mapped to token claims",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_claim": {
+							Description: "The attribute as provided by the identity provider, for example `attributes.uid` for a SAML attribute",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"destination_claim": {
+							Description: "The claim the attribute is mapped to in the token. The `source_claim` is used if it is not set",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"access_token": {
+							Description: "Map the attribute to a claim of the access token",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"id_token": {
+							Description: "Map the attribute to a claim of the identity token",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMAppIDIDPAttributeMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tenantID := d.Get("tenant_id").(string)
+	idp := d.Get("identity_provider").(string)
+
+	if err := putAppIDIDPAttributeMapping(ctx, meta, tenantID, idp, d.Get("attribute").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", tenantID, idp))
+
+	return resourceIBMAppIDIDPAttributeMappingRead(ctx, d, meta)
+}
+
+func resourceIBMAppIDIDPAttributeMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	appidClient, err := meta.(conns.ClientSession).AppIDAPI()
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idParts := strings.Split(d.Id(), "/")
+
+	if len(idParts) != 2 {
+		return diag.Errorf("Incorrect ID %s: ID should be a combination of tenantID/identityProvider", d.Id())
+	}
+
+	tenantID := idParts[0]
+	idp := idParts[1]
+
+	tokenConfig, response, err := appidClient.GetTokensConfigWithContext(ctx, &appid.GetTokensConfigOptions{
+		TenantID: &tenantID,
+	})
+
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error reading AppID token configuration: %s\n%s", err, response)
+	}
+
+	attributes := flattenAppIDIDPAttributeMapping(idp, tokenConfig.AccessTokenClaims, tokenConfig.IDTokenClaims)
+
+	if len(attributes) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("attribute", attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("tenant_id", tenantID)
+	d.Set("identity_provider", idp)
+
+	return nil
+}
+
+func resourceIBMAppIDIDPAttributeMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("attribute") {
+		if err := putAppIDIDPAttributeMapping(ctx, meta, d.Get("tenant_id").(string), d.Get("identity_provider").(string), d.Get("attribute").(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMAppIDIDPAttributeMappingRead(ctx, d, meta)
+}
+
+func resourceIBMAppIDIDPAttributeMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putAppIDIDPAttributeMapping(ctx, meta, d.Get("tenant_id").(string), d.Get("identity_provider").(string), nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// putAppIDIDPAttributeMapping replaces the claims of the identity provider in the token configuration,
+// the token expiration settings and the claims of the other sources are sent back unchanged
+func putAppIDIDPAttributeMapping(ctx context.Context, meta interface{}, tenantID, idp string, attributes []interface{}) error {
+	appidClient, err := meta.(conns.ClientSession).AppIDAPI()
+
+	if err != nil {
+		return err
+	}
+
+	// the token configuration is read, changed and written back as a whole, the writes of the
+	// mappings and of ibm_appid_token_config of the tenant must not interleave
+	mk := appIDTokenConfigMutexKey(tenantID)
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	tokenConfig, resp, err := appidClient.GetTokensConfigWithContext(ctx, &appid.GetTokensConfigOptions{
+		TenantID: &tenantID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading AppID token configuration: %s\n%s", err, resp)
+	}
+
+	config := &appid.PutTokensConfigOptions{
+		TenantID: helpers.String(tenantID),
+	}
+
+	if tokenConfig.Access != nil {
+		config.Access = &appid.AccessTokenConfigParams{
+			ExpiresIn: tokenConfig.Access.ExpiresIn,
+		}
+	}
+
+	if tokenConfig.Refresh != nil {
+		config.Refresh = &appid.TokenConfigParams{
+			Enabled:   tokenConfig.Refresh.Enabled,
+			ExpiresIn: tokenConfig.Refresh.ExpiresIn,
+		}
+	}
+
+	if tokenConfig.AnonymousAccess != nil {
+		config.AnonymousAccess = &appid.TokenConfigParams{
+			Enabled:   tokenConfig.AnonymousAccess.Enabled,
+			ExpiresIn: tokenConfig.AnonymousAccess.ExpiresIn,
+		}
+	}
+
+	config.AccessTokenClaims = otherAppIDTokenClaims(idp, tokenConfig.AccessTokenClaims)
+	config.IDTokenClaims = otherAppIDTokenClaims(idp, tokenConfig.IDTokenClaims)
+
+	for _, item := range attributes {
+		aMap := item.(map[string]interface{})
+
+		if !aMap["access_token"].(bool) && !aMap["id_token"].(bool) {
+			return fmt.Errorf("The attribute %s must be mapped to the access token, the identity token or both", aMap["source_claim"])
+		}
+
+		claim := appid.TokenClaimMapping{
+			Source:      helpers.String(idp),
+			SourceClaim: helpers.String(aMap["source_claim"].(string)),
+		}
+
+		if dClaim, ok := aMap["destination_claim"]; ok && dClaim.(string) != "" {
+			claim.DestinationClaim = helpers.String(dClaim.(string))
+		}
+
+		if aMap["access_token"].(bool) {
+			config.AccessTokenClaims = append(config.AccessTokenClaims, claim)
+		}
+
+		if aMap["id_token"].(bool) {
+			config.IDTokenClaims = append(config.IDTokenClaims, claim)
+		}
+	}
+
+	_, resp, err = appidClient.PutTokensConfigWithContext(ctx, config)
+
+	if err != nil {
+		return fmt.Errorf("Error updating AppID token configuration: %s\n%s", err, resp)
+	}
+
+	return nil
+}
+
+// isAppIDIDPAttributeMappingSource reports whether the claims of the source can be managed by ibm_appid_idp_attribute_mapping
+func isAppIDIDPAttributeMappingSource(source string) bool {
+	return source == "saml" || source == "appid_custom"
+}
+
+// appIDTokenConfigMutexKey is the key of the lock of the token configuration of a tenant
+func appIDTokenConfigMutexKey(tenantID string) string {
+	return fmt.Sprintf("appid_token_config_%s", tenantID)
+}
+
+func otherAppIDTokenClaims(idp string, claims []appid.TokenClaimMapping) []appid.TokenClaimMapping {
+	result := []appid.TokenClaimMapping{}
+
+	for _, claim := range claims {
+		if claim.Source != nil && *claim.Source == idp {
+			continue
+		}
+
+		result = append(result, claim)
+	}
+
+	return result
+}
+
+// flattenAppIDIDPAttributeMapping merges the access and identity token claims of an attribute
+func flattenAppIDIDPAttributeMapping(idp string, accessClaims, idClaims []appid.TokenClaimMapping) []interface{} {
+	var keys []string
+	attributes := map[string]map[string]interface{}{}
+
+	add := func(claims []appid.TokenClaimMapping, token string) {
+		for _, claim := range claims {
+			if claim.Source == nil || *claim.Source != idp || claim.SourceClaim == nil {
+				continue
+			}
+
+			destination := ""
+
+			if claim.DestinationClaim != nil {
+				destination = *claim.DestinationClaim
+			}
+
+			key := *claim.SourceClaim + "/" + destination
+
+			if _, ok := attributes[key]; !ok {
+				keys = append(keys, key)
+				attributes[key] = map[string]interface{}{
+					"source_claim":      *claim.SourceClaim,
+					"destination_claim": destination,
+					"access_token":      false,
+					"id_token":          false,
+				}
+			}
+
+			attributes[key][token] = true
+		}
+	}
+
+	add(accessClaims, "access_token")
+	add(idClaims, "id_token")
+
+	result := make([]interface{}, 0, len(keys))
+
+	for _, key := range keys {
+		result = append(result, attributes[key])
+	}
+
+	return result
+}
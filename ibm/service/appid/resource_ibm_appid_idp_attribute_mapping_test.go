@@ -0,0 +1,132 @@
+package appid_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMAppIDIDPAttributeMapping_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAppIDIDPAttributeMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setupIBMAppIDIDPAttributeMappingConfig(acc.AppIDTenantID, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_idp_attribute_mapping.saml", "tenant_id", acc.AppIDTenantID),
+					resource.TestCheckResourceAttr("ibm_appid_idp_attribute_mapping.saml", "identity_provider", "saml"),
+					resource.TestCheckResourceAttr("ibm_appid_idp_attribute_mapping.saml", "attribute.#", "2"),
+				),
+			},
+			{
+				Config: setupIBMAppIDIDPAttributeMappingConfig(acc.AppIDTenantID, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_idp_attribute_mapping.saml", "attribute.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_appid_idp_attribute_mapping.saml", "attribute.*", map[string]string{
+						"source_claim":      "attributes.department",
+						"destination_claim": "department",
+						"access_token":      "true",
+						"id_token":          "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMAppIDIDPAttributeMapping_withTokenConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAppIDIDPAttributeMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setupIBMAppIDIDPAttributeMappingWithTokenConfig(acc.AppIDTenantID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_idp_attribute_mapping.saml", "attribute.#", "2"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.#", "1"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "id_token_claim.#", "0"),
+				),
+			},
+			{
+				// neither resource reads the claims of the other one as a change
+				Config:   setupIBMAppIDIDPAttributeMappingWithTokenConfig(acc.AppIDTenantID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func setupIBMAppIDIDPAttributeMappingWithTokenConfig(tenantID string) string {
+	return setupIBMAppIDIDPAttributeMappingConfig(tenantID, "true") + fmt.Sprintf(`
+		resource "ibm_appid_token_config" "tc" {
+			tenant_id = "%s"
+
+			access_token_claim {
+				source            = "roles"
+				destination_claim = "groupIds"
+			}
+		}
+	`, tenantID)
+}
+
+func setupIBMAppIDIDPAttributeMappingConfig(tenantID, idToken string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_idp_attribute_mapping" "saml" {
+			tenant_id         = "%s"
+			identity_provider = "saml"
+
+			attribute {
+				source_claim      = "attributes.uid"
+				destination_claim = "employeeId"
+			}
+
+			attribute {
+				source_claim      = "attributes.department"
+				destination_claim = "department"
+				id_token          = %s
+			}
+		}
+	`, tenantID, idToken)
+}
+
+func testAccCheckIBMAppIDIDPAttributeMappingDestroy(s *terraform.State) error {
+	appIDClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AppIDAPI()
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_appid_idp_attribute_mapping" {
+			continue
+		}
+
+		tenantID := rs.Primary.Attributes["tenant_id"]
+		idp := rs.Primary.Attributes["identity_provider"]
+
+		config, _, err := appIDClient.GetTokensConfig(&appid.GetTokensConfigOptions{
+			TenantID: &tenantID,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error checking if AppID attribute mapping was removed: %s", err)
+		}
+
+		for _, claim := range append(config.AccessTokenClaims, config.IDTokenClaims...) {
+			if claim.Source != nil && *claim.Source == idp {
+				return fmt.Errorf("[ERROR] Error checking if AppID attribute mapping was removed: a %s claim is still mapped", idp)
+			}
+		}
+	}
+
+	return nil
+}
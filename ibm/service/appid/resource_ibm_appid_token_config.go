@@ -5,6 +5,7 @@ package appid
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -121,6 +122,28 @@ func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceDa
 
 	input := expandTokenConfig(d)
 
+	mk := appIDTokenConfigMutexKey(tenantID)
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	// the claims of the identity providers that are left to ibm_appid_idp_attribute_mapping are kept, a
+	// source removed from the configuration was managed here and its claims are removed
+	sources := configuredAppIDClaimSources(d)
+
+	for _, key := range []string{"access_token_claim", "id_token_claim"} {
+		old, _ := d.GetChange(key)
+
+		if old, ok := old.(*schema.Set); ok {
+			for _, item := range old.List() {
+				sources[item.(map[string]interface{})["source"].(string)] = true
+			}
+		}
+	}
+
+	if err := keepAppIDIDPAttributeMappingClaims(ctx, appidClient, input, sources); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, input)
 
 	if err != nil {
@@ -180,14 +203,17 @@ func resourceIBMAppIDTokenConfigRead(ctx context.Context, d *schema.ResourceData
 		d.Set("anonymous_token_expires_in", *tokenConfig.AnonymousAccess.ExpiresIn)
 	}
 
+	// the identity provider claims of a source that is not configured here belong to ibm_appid_idp_attribute_mapping
+	sources := configuredAppIDClaimSources(d)
+
 	if tokenConfig.AccessTokenClaims != nil {
-		if err := d.Set("access_token_claim", flattenTokenClaims(tokenConfig.AccessTokenClaims)); err != nil {
+		if err := d.Set("access_token_claim", flattenTokenClaims(ownAppIDTokenClaims(tokenConfig.AccessTokenClaims, sources))); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if tokenConfig.IDTokenClaims != nil {
-		if err := d.Set("id_token_claim", flattenTokenClaims(tokenConfig.IDTokenClaims)); err != nil {
+		if err := d.Set("id_token_claim", flattenTokenClaims(ownAppIDTokenClaims(tokenConfig.IDTokenClaims, sources))); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -312,6 +338,15 @@ func resourceIBMAppIDTokenConfigDelete(ctx context.Context, d *schema.ResourceDa
 	tenantID := d.Get("tenant_id").(string)
 
 	config := tokenConfigDefaults(tenantID)
+
+	mk := appIDTokenConfigMutexKey(tenantID)
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	if err := keepAppIDIDPAttributeMappingClaims(ctx, appidClient, config, configuredAppIDClaimSources(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, config)
 
 	if err != nil {
@@ -322,3 +357,63 @@ func resourceIBMAppIDTokenConfigDelete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// configuredAppIDClaimSources returns the sources of the access and identity token claims of the configuration
+func configuredAppIDClaimSources(d *schema.ResourceData) map[string]bool {
+	sources := map[string]bool{}
+
+	for _, key := range []string{"access_token_claim", "id_token_claim"} {
+		if claims, ok := d.GetOk(key); ok {
+			for _, item := range claims.(*schema.Set).List() {
+				sources[item.(map[string]interface{})["source"].(string)] = true
+			}
+		}
+	}
+
+	return sources
+}
+
+// ownAppIDTokenClaims leaves out the claims of the identity providers that ibm_appid_idp_attribute_mapping
+// can manage when the configuration has no claim of that source
+func ownAppIDTokenClaims(claims []appid.TokenClaimMapping, sources map[string]bool) []appid.TokenClaimMapping {
+	result := []appid.TokenClaimMapping{}
+
+	for _, claim := range claims {
+		if claim.Source != nil && isAppIDIDPAttributeMappingSource(*claim.Source) && !sources[*claim.Source] {
+			continue
+		}
+
+		result = append(result, claim)
+	}
+
+	return result
+}
+
+// keepAppIDIDPAttributeMappingClaims adds the current identity provider claims of the sources that are not
+// configured to the token configuration that is written
+func keepAppIDIDPAttributeMappingClaims(ctx context.Context, appidClient *appid.AppIDManagementV4, config *appid.PutTokensConfigOptions, sources map[string]bool) error {
+	tokenConfig, resp, err := appidClient.GetTokensConfigWithContext(ctx, &appid.GetTokensConfigOptions{
+		TenantID: config.TenantID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading AppID token configuration: %s\n%s", err, resp)
+	}
+
+	keep := func(claims []appid.TokenClaimMapping) []appid.TokenClaimMapping {
+		var result []appid.TokenClaimMapping
+
+		for _, claim := range claims {
+			if claim.Source != nil && isAppIDIDPAttributeMappingSource(*claim.Source) && !sources[*claim.Source] {
+				result = append(result, claim)
+			}
+		}
+
+		return result
+	}
+
+	config.AccessTokenClaims = append(config.AccessTokenClaims, keep(tokenConfig.AccessTokenClaims)...)
+	config.IDTokenClaims = append(config.IDTokenClaims, keep(tokenConfig.IDTokenClaims)...)
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +31,8 @@ func ResourceIBMAtrackerRoute() *schema.Resource {
 		DeleteContext: resourceIBMAtrackerRouteDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMAtrackerRouteCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -46,18 +49,26 @@ func ResourceIBMAtrackerRoute() *schema.Resource {
 						"target_ids": &schema.Schema{
 							Type:        schema.TypeList,
 							Required:    true,
+							MinItems:    1,
 							Description: "The target ID List. All the events will be send to all targets listed in the rule. You can include targets from other regions.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"locations": &schema.Schema{
 							Type:        schema.TypeList,
 							Required:    true,
+							MinItems:    1,
 							Description: "Logs from these locations will be sent to the targets specified. Locations is a superset of regions including global and *.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
 			},
+			"strict_validation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, rules that can never match because an earlier rule already matches all of their locations are rejected at plan time.",
+			},
 			"crn": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -273,6 +284,62 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 	return nil
 }
 
+var atrackerRouteLocationRegexp = regexp.MustCompile(`^[a-z]{2}-[a-z]{2,5}[0-9]?$`)
+
+func resourceIBMAtrackerRouteCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rules := diff.Get("rules").([]interface{})
+	for i := range rules {
+		if !diff.NewValueKnown(fmt.Sprintf("rules.%d.locations", i)) {
+			return nil
+		}
+	}
+	return ValidateAtrackerRouteRules(rules, diff.Get("strict_validation").(bool))
+}
+
+// ValidateAtrackerRouteRules checks the locations of route rules. Rules are evaluated in order and the first
+// matching rule wins, so in strict mode a rule is rejected when earlier rules already match all of its locations.
+func ValidateAtrackerRouteRules(rules []interface{}, strict bool) error {
+	matched := map[string]bool{}
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		locations := []string{}
+		seen := map[string]bool{}
+		for _, l := range rule["locations"].([]interface{}) {
+			location, _ := l.(string)
+			if location != "*" && location != "global" && !atrackerRouteLocationRegexp.MatchString(location) {
+				return fmt.Errorf("rules.%d: location %q is invalid, it must be a region such as us-south, global or *", i, location)
+			}
+			if seen[location] {
+				return fmt.Errorf("rules.%d: location %q is listed more than once", i, location)
+			}
+			seen[location] = true
+			locations = append(locations, location)
+		}
+		if seen["*"] && len(locations) > 1 {
+			return fmt.Errorf("rules.%d: location * already matches all locations and cannot be combined with other locations", i)
+		}
+
+		if strict {
+			shadowed := len(locations) > 0
+			for _, location := range locations {
+				if !matched["*"] && !matched[location] {
+					shadowed = false
+				}
+			}
+			if shadowed {
+				return fmt.Errorf("rules.%d: the rule is never evaluated because earlier rules already match all of its locations", i)
+			}
+		}
+		for _, location := range locations {
+			matched[location] = true
+		}
+	}
+	return nil
+}
+
 func ResourceIBMAtrackerRouteMapToRulePrototype(modelMap map[string]interface{}) (*atrackerv2.RulePrototype, error) {
 	model := &atrackerv2.RulePrototype{}
 	targetIds := []string{}
@@ -224,3 +224,24 @@ func TestResourceIBMAtrackerRouteMapToRulePrototype(t *testing.T) {
 	assert.Nil(t, err)
 	checkResult(result)
 }
+
+func TestValidateAtrackerRouteRules(t *testing.T) {
+	rule := func(locations ...interface{}) interface{} {
+		return map[string]interface{}{
+			"target_ids": []interface{}{"c3af557f-fb0e-4476-85c3-0889e7fe7bc4"},
+			"locations":  locations,
+		}
+	}
+
+	assert.Nil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us-south", "global"), rule("eu-de")}, true))
+	assert.Nil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us-south"), rule("*")}, true))
+	assert.NotNil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us_south")}, false))
+	assert.NotNil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us-south", "us-south")}, false))
+	assert.NotNil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("*", "us-south")}, false))
+
+	// later rules that can never match are only rejected in strict mode
+	assert.Nil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("*"), rule("us-south")}, false))
+	assert.NotNil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("*"), rule("us-south")}, true))
+	assert.NotNil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us-south", "eu-de"), rule("eu-de")}, true))
+	assert.Nil(t, atracker.ValidateAtrackerRouteRules([]interface{}{rule("us-south"), rule("us-south", "eu-de")}, true))
+}
@@ -12,8 +12,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -31,7 +33,15 @@ func ResourceIbmBackupRecovery() *schema.Resource {
 		UpdateContext: resourceIbmBackupRecoveryUpdate,
 		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: checkDiffResourceIbmBackupRecovery,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait for the Recovery to reach a final status on create. The apply fails if the Recovery does not succeed.",
+			},
 			"x_ibm_tenant_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -2408,6 +2418,10 @@ func checkDiffResourceIbmBackupRecovery(context context.Context, d *schema.Resou
 	}
 
 	for fieldName := range ResourceIbmBackupRecovery().Schema {
+		// only changes the behaviour of create, the Recovery itself is not updated
+		if fieldName == "wait_for_completion" {
+			continue
+		}
 		if d.HasChange(fieldName) {
 			return fmt.Errorf("[ERROR] Resource ibm_backup_recovery_recovery cannot be updated. Field: %s", fieldName)
 		}
@@ -2456,9 +2470,55 @@ func resourceIbmBackupRecoveryCreate(context context.Context, d *schema.Resource
 	recoveryId := fmt.Sprintf("%s::%s", tenantId, *recovery.ID)
 	d.SetId(recoveryId)
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = waitForBackupRecoveryCompletion(context, backupRecoveryClient, tenantId, *recovery.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for recovery (%s) to complete: %s", *recovery.ID, err.Error()), "ibm_backup_recovery_recovery", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
 	return resourceIbmBackupRecoveryRead(context, d, meta)
 }
 
+func waitForBackupRecoveryCompletion(context context.Context, backupRecoveryClient *backuprecoveryv1.BackupRecoveryV1, tenantId string, recoveryId string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Accepted", "Running", "OnHold", "Finalizing", "Canceling"},
+		Target:     []string{"Succeeded", "SucceededWithWarning"},
+		Refresh:    backupRecoveryStatusRefreshFunc(context, backupRecoveryClient, tenantId, recoveryId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func backupRecoveryStatusRefreshFunc(context context.Context, backupRecoveryClient *backuprecoveryv1.BackupRecoveryV1, tenantId string, recoveryId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getRecoveryByIdOptions := &backuprecoveryv1.GetRecoveryByIdOptions{}
+		getRecoveryByIdOptions.SetID(recoveryId)
+		getRecoveryByIdOptions.SetXIBMTenantID(tenantId)
+
+		recovery, response, err := backupRecoveryClient.GetRecoveryByIDWithContext(context, getRecoveryByIdOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("GetRecoveryByIDWithContext failed: %s\n%s", err, response)
+		}
+		if recovery.Status == nil {
+			return recovery, "Accepted", nil
+		}
+		switch *recovery.Status {
+		case "Failed", "Canceled", "Skipped", "Missed":
+			return recovery, *recovery.Status, fmt.Errorf("recovery finished with status %s", *recovery.Status)
+		case "LegalHold":
+			// A recovery under legal hold does not progress until the hold is released outside of Terraform
+			return recovery, *recovery.Status, fmt.Errorf("recovery is on legal hold, it does not complete until the hold is released")
+		}
+		return recovery, *recovery.Status, nil
+	}
+}
+
 func resourceIbmBackupRecoveryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	backupRecoveryClient, err := meta.(conns.ClientSession).BackupRecoveryV1()
 	if err != nil {
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-backup-recovery-sdk-go/backuprecoveryv1"
+)
+
+func TestBackupRecoveryStatusRefreshFunc(t *testing.T) {
+	cases := []struct {
+		status    string
+		wantState string
+		wantErr   string
+	}{
+		{status: "Running", wantState: "Running"},
+		{status: "OnHold", wantState: "OnHold"},
+		{status: "Succeeded", wantState: "Succeeded"},
+		{status: "SucceededWithWarning", wantState: "SucceededWithWarning"},
+		{status: "Failed", wantState: "Failed", wantErr: "recovery finished with status Failed"},
+		{status: "Canceled", wantState: "Canceled", wantErr: "recovery finished with status Canceled"},
+		{status: "LegalHold", wantState: "LegalHold", wantErr: "recovery is on legal hold"},
+	}
+	for _, c := range cases {
+		t.Run(c.status, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/data-protect/recoveries/rec-1" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if tenant := r.Header.Get("X-IBM-Tenant-Id"); tenant != "tenant-1/" {
+					t.Errorf("unexpected tenant %s", tenant)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"id":"rec-1","status":"%s"}`, c.status)
+			}))
+			defer server.Close()
+
+			client, err := backuprecoveryv1.NewBackupRecoveryV1(&backuprecoveryv1.BackupRecoveryV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			if err != nil {
+				t.Fatalf("NewBackupRecoveryV1: %s", err)
+			}
+
+			_, state, err := backupRecoveryStatusRefreshFunc(context.Background(), client, "tenant-1/", "rec-1")()
+			if state != c.wantState {
+				t.Errorf("expected state %s, got %s", c.wantState, state)
+			}
+			if c.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)) {
+				t.Errorf("expected error %q, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
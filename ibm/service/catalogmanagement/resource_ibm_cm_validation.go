@@ -26,6 +26,10 @@ func ResourceIBMCmValidation() *schema.Resource {
 		DeleteContext: resourceIBMCmValidationDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version_locator": &schema.Schema{
 				Type:        schema.TypeString,
@@ -203,8 +207,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	conns.IbmMutexKV.Lock(mk)
 	defer conns.IbmMutexKV.Unlock(mk)
 
-	valid := "valid"
-	if version.Validation.State == &valid && d.Get("revalidate_if_validated") != true {
+	if version.Validation != nil && version.Validation.State != nil && *version.Validation.State == "valid" && d.Get("revalidate_if_validated") != true {
 		// version already validated and do not wish to revalidate
 		d.SetId(*validateInstallOptions.VersionLocID)
 		if _, ok := d.GetOk("mark_version_consumable"); ok && d.Get("mark_version_consumable").(bool) {
@@ -279,6 +282,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	status := *result.State
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
 
 	// Track progress of validation through schematics workspace status
 	// Do a GET every 5 seconds to check for an updated status
@@ -294,6 +298,13 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 			ticker.Stop()
 			break
 		}
+		if time.Now().After(deadline) {
+			ticker.Stop()
+			err = fmt.Errorf("timed out waiting for validation of version %s, last state was %s", *validateInstallOptions.VersionLocID, status)
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_validation", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		result, response, err = catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 		if err != nil {
@@ -303,8 +314,20 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// a version that failed validation can not be shared, so fail instead of silently skipping the consumable step
+	if _, ok := d.GetOk("mark_version_consumable"); ok && d.Get("mark_version_consumable").(bool) && status != "valid" {
+		message := ""
+		if result.Message != nil {
+			message = *result.Message
+		}
+		err = fmt.Errorf("version %s was not marked as consumable because validation finished in state %s: %s", *validateInstallOptions.VersionLocID, status, message)
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_validation", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	// mark consumable if specified and validation passed
-	if _, ok := d.GetOk("mark_version_consumable"); ok && d.Get("mark_version_consumable").(bool) && status == "valid" {
+	if _, ok := d.GetOk("mark_version_consumable"); ok && d.Get("mark_version_consumable").(bool) {
 		err = markVersionAsConsumable(version, context, meta)
 		if err != nil {
 			d.SetId("")
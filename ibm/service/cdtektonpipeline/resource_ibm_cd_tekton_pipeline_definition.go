@@ -66,14 +66,16 @@ func ResourceIBMCdTektonPipelineDefinition() *schema.Resource {
 										Description: "URL of the definition repository.",
 									},
 									"branch": &schema.Schema{
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A branch from the repo, specify one of branch or tag only.",
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"source.0.properties.0.tag"},
+										Description:   "A branch from the repo, specify one of branch or tag only.",
 									},
 									"tag": &schema.Schema{
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A tag from the repo, specify one of branch or tag only.",
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"source.0.properties.0.branch"},
+										Description:   "A tag from the repo, specify one of branch or tag only.",
 									},
 									"path": &schema.Schema{
 										Type:        schema.TypeString,
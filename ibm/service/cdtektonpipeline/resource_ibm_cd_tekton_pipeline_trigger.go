@@ -89,9 +89,10 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 				},
 			},
 			"max_concurrent_runs": &schema.Schema{
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Defines the maximum number of concurrent runs for this trigger. If omitted then the concurrency limit is disabled for this trigger.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_trigger", "max_concurrent_runs"),
+				Description:  "Defines the maximum number of concurrent runs for this trigger. If omitted then the concurrency limit is disabled for this trigger.",
 			},
 			"enabled": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -352,6 +353,13 @@ func ResourceIBMCdTektonPipelineTriggerValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             253,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "max_concurrent_runs",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+		},
 		validate.ValidateSchema{
 			Identifier:                 "filter",
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// cisZoneClient calls the zone rulesets API of CIS with request bodies that the networking SDK
+// cannot carry. The paths are the ones of the zone operations of rulesetsv1 in networking-go-sdk
+// v0.51.9:
+//
+//   - GET and PUT /rulesets/phases/{phase}/entrypoint: GetZoneEntrypointRuleset and
+//     UpdateZoneEntrypointRuleset, whose phases include http_request_cache_settings
+//   - GET /rulesets/{ruleset_id}: GetZoneRuleset
+//   - POST /rulesets/{ruleset_id}/rules: CreateZoneRulesetRule
+//   - PATCH and DELETE /rulesets/{ruleset_id}/rules/{rule_id}: UpdateZoneRulesetRule and
+//     DeleteZoneRulesetRule
+//
+// The ActionParameters model of rulesetsv1 has no cache settings, like the TTLs and the cache key,
+// so the rules are sent and read with the models of the caller. The client uses the base service
+// of the CIS rulesets client, which has the CIS endpoint, the IAM authenticator, the retries and
+// the default headers of the provider.
+type cisZoneClient struct {
+	service *core.BaseService
+	crn     string
+	zoneID  string
+}
+
+func getCISZoneClient(meta interface{}, crn, zoneID string) (*cisZoneClient, error) {
+	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
+	if err != nil {
+		return nil, err
+	}
+	return &cisZoneClient{service: sess.Service, crn: crn, zoneID: zoneID}, nil
+}
+
+// request sends a request to the given path below /v1/{crn}/zones/{zone_identifier} and unmarshals
+// the response into result.
+func (c *cisZoneClient) request(ctx context.Context, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	params := map[string]string{"crn": c.crn, "zone_identifier": c.zoneID}
+	for k, v := range pathParams {
+		params[k] = v
+	}
+
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.service.GetServiceURL(), "/v1/{crn}/zones/{zone_identifier}"+path, params)
+	if err != nil {
+		return nil, err
+	}
+	for header, values := range c.service.DefaultHeaders {
+		for _, value := range values {
+			builder.AddHeader(header, value)
+		}
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return c.service.Request(request, result)
+}
+
+func isCISZoneClientNotFound(resp *core.DetailedResponse) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
@@ -68,6 +68,30 @@ func DataSourceIBMCISDomain() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			cisDomainVerificationRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "DNS records to publish at the authoritative DNS provider of a partial domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDomainRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the DNS record",
+						},
+						cisDomainRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the DNS record, TXT or CNAME",
+						},
+						cisDomainRecordValue: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the DNS record",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -121,10 +145,17 @@ func dataSourceIBMCISDomainRead(d *schema.ResourceData, meta interface{}) error
 			d.Set(cisDomainID, *zone.ID)
 			d.Set(cisDomainType, *zone.Type)
 
-			if cisDomainType == "partial" {
-				d.Set(cisDomainVerificationKey, *zone.VerificationKey)
-				d.Set(cisDomainCnameSuffix, *zone.CnameSuffix)
+			records := []map[string]interface{}{}
+			if *zone.Type == cisDomainTypePartial {
+				d.Set(cisDomainVerificationKey, zone.VerificationKey)
+				d.Set(cisDomainCnameSuffix, zone.CnameSuffix)
+				hosts, err := listCISDomainProxiedHosts(meta, crn, *zone.ID)
+				if err != nil {
+					return err
+				}
+				records = flattenCISDomainVerificationRecords(zone.Name, zone.VerificationKey, zone.CnameSuffix, hosts)
 			}
+			d.Set(cisDomainVerificationRecords, records)
 			zoneFound = true
 		}
 	}
@@ -6,6 +6,7 @@ package cis
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -15,6 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	cisFirewallrulesFilterExpression = "filter_expression"
+	cisFirewallrulesFilterID         = "filter_id"
+)
+
 func DataSourceIBMCISFirewallRules() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMCISFirewallRulesRead,
@@ -33,6 +39,24 @@ func DataSourceIBMCISFirewallRules() *schema.Resource {
 				Required:    true,
 				Description: "Zone identifier of the zone for which firewall rules are listed.",
 			},
+			cisFirewallrulesAction: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the firewall rules with this action.",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_firewall_rules",
+					cisFirewallrulesAction),
+			},
+			cisFirewallrulesPaused: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the firewall rules that are paused, or not paused.",
+			},
+			cisFirewallrulesDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the firewall rules whose description contains this text, ignoring case.",
+			},
 			cisFirewallrulesList: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -59,6 +83,21 @@ func DataSourceIBMCISFirewallRules() *schema.Resource {
 							Computed:    true,
 							Description: "The firewall action to perform, \"log\" action is only available for enterprise plans instances.",
 						},
+						cisFirewallrulesPriority: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority of the firewall rule.",
+						},
+						cisFirewallrulesFilterID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the filter of the firewall rule.",
+						},
+						cisFirewallrulesFilterExpression: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expression of the filter of the firewall rule.",
+						},
 						cisFilter: {
 							Type:        schema.TypeMap,
 							Computed:    true,
@@ -82,6 +121,13 @@ func DataSourceIBMCISFirewallRulesValidator() *validate.ResourceValidator {
 			CloudDataType:              "resource_instance",
 			CloudDataRange:             []string{"service:internet-svcs"},
 			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisFirewallrulesAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "log, allow, challenge, js_challenge, block"})
 
 	iBMCISFirewallRulesValidator := validate.ResourceValidator{
 		ResourceName: "ibm_cis_firewall_rules",
@@ -108,7 +154,13 @@ func dataSourceIBMCISFirewallRulesRead(context context.Context, d *schema.Resour
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
 
-	result, resp, err := cisClient.ListAllFirewallRules(cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID))
+	action, filterAction := d.GetOk(cisFirewallrulesAction)
+	paused, filterPaused := d.GetOkExists(cisFirewallrulesPaused)
+	description := strings.ToLower(d.Get(cisFirewallrulesDescription).(string))
+
+	// The firewall rules API has no paging parameters, a single call returns all rules of the zone
+	opt := cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID)
+	result, resp, err := cisClient.ListAllFirewallRulesWithContext(context, opt)
 	if err != nil || result == nil {
 		tfErr := flex.TerraformErrorf(err,
 			fmt.Sprintf("dataSourceIBMCISFirewallRulesRead ListAllFirewallRules failed with error: %s and response:\n%s", err, resp),
@@ -117,23 +169,39 @@ func dataSourceIBMCISFirewallRulesRead(context context.Context, d *schema.Resour
 	}
 
 	fwrList := make([]map[string]interface{}, 0)
-
 	for _, instance := range result.Result {
+		if filterAction && (instance.Action == nil || *instance.Action != action.(string)) {
+			continue
+		}
+		if filterPaused && (instance.Paused == nil || *instance.Paused != paused.(bool)) {
+			continue
+		}
+		if description != "" && (instance.Description == nil || !strings.Contains(strings.ToLower(*instance.Description), description)) {
+			continue
+		}
+
 		firewallrules := map[string]interface{}{}
-		fr_filters := map[string]interface{}{}
-		firewallrules[cisFirewallrulesID] = *instance.ID
-		firewallrules[cisFirewallrulesPaused] = *instance.Paused
+		firewallrules[cisFirewallrulesID] = flex.StringValue(instance.ID)
+		firewallrules[cisFirewallrulesPaused] = instance.Paused != nil && *instance.Paused
 		firewallrules[cisFirewallrulesDescription] = instance.Description
-		firewallrules[cisFirewallrulesAction] = *instance.Action
-		fr_filters[cisFilterID] = *instance.Filter.ID
-		if *instance.Filter.Paused {
-			fr_filters[cisFilterPaused] = "true"
-		} else {
-			fr_filters[cisFilterPaused] = "false"
+		firewallrules[cisFirewallrulesAction] = flex.StringValue(instance.Action)
+		if instance.Priority != nil {
+			firewallrules[cisFirewallrulesPriority] = *instance.Priority
+		}
+		if instance.Filter != nil {
+			fr_filters := map[string]interface{}{}
+			fr_filters[cisFilterID] = flex.StringValue(instance.Filter.ID)
+			if instance.Filter.Paused != nil && *instance.Filter.Paused {
+				fr_filters[cisFilterPaused] = "true"
+			} else {
+				fr_filters[cisFilterPaused] = "false"
+			}
+			fr_filters[cisFilterExpression] = flex.StringValue(instance.Filter.Expression)
+			fr_filters[cisFilterDescription] = instance.Filter.Description
+			firewallrules[cisFilter] = fr_filters
+			firewallrules[cisFirewallrulesFilterID] = flex.StringValue(instance.Filter.ID)
+			firewallrules[cisFirewallrulesFilterExpression] = flex.StringValue(instance.Filter.Expression)
 		}
-		fr_filters[cisFilterExpression] = *instance.Filter.Expression
-		fr_filters[cisFilterDescription] = instance.Filter.Description
-		firewallrules[cisFilter] = fr_filters
 		fwrList = append(fwrList, firewallrules)
 	}
 	d.SetId(dataSourceCISFirewallrulesCheckID(d))
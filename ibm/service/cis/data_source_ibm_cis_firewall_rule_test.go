@@ -28,6 +28,25 @@ func TestAccIBMCisFirewallRulesDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisFirewallRulesDataSource_Filter(t *testing.T) {
+	name := "data.ibm_cis_firewall_rules.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmFirewallRulesDataSourceConfigFilter("test", acc.CisDomainStatic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "firewall_rules.0.action", "allow"),
+					resource.TestCheckResourceAttr(name, "firewall_rules.0.paused", "true"),
+					resource.TestCheckResourceAttrSet(name, "firewall_rules.0.filter_expression"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmFirewallRulesDataSourceConfigBasic(id, CisDomainStatic string) string {
 	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
 	data "ibm_cis_firewall_rules" "%[1]s" {
@@ -36,3 +55,15 @@ func testAccCheckIbmFirewallRulesDataSourceConfigBasic(id, CisDomainStatic strin
 	  }
 `, id, acc.CisDomainStatic)
 }
+
+func testAccCheckIbmFirewallRulesDataSourceConfigFilter(id, CisDomainStatic string) string {
+	return testAccCheckCisFirewallrules_basic() + fmt.Sprintf(`
+	data "ibm_cis_firewall_rules" "%[1]s" {
+		cis_id      = data.ibm_cis.cis.id
+		domain_id   = data.ibm_cis_domain.cis_domain.domain_id
+		action      = "allow"
+		paused      = true
+		depends_on  = [ibm_cis_firewall_rule.firewall_rules_instance]
+	  }
+`, id)
+}
@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancereventsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ibmCISHealthCheckEvents                = "ibm_cis_healthcheck_events"
+	cisHealthCheckEvents                   = "events"
+	cisHealthCheckEventsPoolID             = "pool_id"
+	cisHealthCheckEventsOriginName         = "origin_name"
+	cisHealthCheckEventsOriginHealthy      = "origin_healthy"
+	cisHealthCheckEventsPoolHealthy        = "pool_healthy"
+	cisHealthCheckEventsSince              = "since"
+	cisHealthCheckEventsUntil              = "until"
+	cisHealthCheckEventID                  = "id"
+	cisHealthCheckEventTimestamp           = "timestamp"
+	cisHealthCheckEventPools               = "pools"
+	cisHealthCheckEventOrigins             = "origins"
+	cisHealthCheckEventName                = "name"
+	cisHealthCheckEventHealthy             = "healthy"
+	cisHealthCheckEventChanged             = "changed"
+	cisHealthCheckEventPoolMinimumOrigins  = "minimum_origins"
+	cisHealthCheckEventOriginAddress       = "address"
+	cisHealthCheckEventOriginIP            = "ip"
+	cisHealthCheckEventOriginEnabled       = "enabled"
+	cisHealthCheckEventOriginFailureReason = "failure_reason"
+)
+
+// DataSourceIBMCISHealthCheckEvents lists the health changes of the origins and the pools of the
+// global load balancers, to find the origins that flap between healthy and unhealthy.
+func DataSourceIBMCISHealthCheckEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCISHealthCheckEventsRead,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(ibmCISHealthCheckEvents,
+					"cis_id"),
+			},
+			cisHealthCheckEventsPoolID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the events of this origin pool",
+			},
+			cisHealthCheckEventsOriginName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the events of the origins with this name",
+			},
+			cisHealthCheckEventsOriginHealthy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the events where the origin became healthy, true, or unhealthy, false",
+			},
+			cisHealthCheckEventsPoolHealthy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the events where the pool became healthy, true, or unhealthy, false",
+			},
+			cisHealthCheckEventsSince: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the events after this time in the RFC 3339 format",
+			},
+			cisHealthCheckEventsUntil: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the events before this time in the RFC 3339 format",
+			},
+			cisHealthCheckEvents: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health changes of the origins and pools",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisHealthCheckEventID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Event ID",
+						},
+						cisHealthCheckEventTimestamp: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the event",
+						},
+						cisHealthCheckEventPools: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Pools of the event",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									cisHealthCheckEventsPoolID: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Pool ID",
+									},
+									cisHealthCheckEventName: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the pool",
+									},
+									cisHealthCheckEventHealthy: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the pool is healthy",
+									},
+									cisHealthCheckEventChanged: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the health of the pool changed with the event",
+									},
+									cisHealthCheckEventPoolMinimumOrigins: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of healthy origins the pool needs to be healthy",
+									},
+								},
+							},
+						},
+						cisHealthCheckEventOrigins: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Origins of the event",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									cisHealthCheckEventName: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the origin",
+									},
+									cisHealthCheckEventOriginAddress: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Address of the origin",
+									},
+									cisHealthCheckEventOriginIP: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "IP address the health check connected to",
+									},
+									cisHealthCheckEventOriginEnabled: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the origin is enabled",
+									},
+									cisHealthCheckEventHealthy: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the origin is healthy",
+									},
+									cisHealthCheckEventOriginFailureReason: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Why the health check of the origin failed",
+									},
+									cisHealthCheckEventChanged: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the health of the origin changed with the event",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISHealthCheckEventsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	iBMCISHealthCheckEventsValidator := validate.ResourceValidator{
+		ResourceName: ibmCISHealthCheckEvents,
+		Schema:       validateSchema}
+	return &iBMCISHealthCheckEventsValidator
+}
+
+func dataSourceIBMCISHealthCheckEventsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).CisGLBEventsClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("dataSourceIBMCISHealthCheckEventsRead CisGLBEventsClientSession initialization failed: %s", err.Error()),
+			ibmCISHealthCheckEvents, "read")
+		return tfErr.GetDiag()
+	}
+	crn := d.Get(cisID).(string)
+	sess.Crn = core.StringPtr(crn)
+
+	since, until, err := dataSourceIBMCISHealthCheckEventsTimeRange(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), ibmCISHealthCheckEvents, "read")
+		return tfErr.GetDiag()
+	}
+
+	opt := sess.NewGetLoadBalancerEventsOptions()
+	result, resp, err := sess.GetLoadBalancerEventsWithContext(context, opt)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("dataSourceIBMCISHealthCheckEventsRead GetLoadBalancerEvents failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISHealthCheckEvents, "read")
+		return tfErr.GetDiag()
+	}
+
+	events := make([]map[string]interface{}, 0, len(result.Result))
+	for _, e := range result.Result {
+		if !dataSourceIBMCISHealthCheckEventMatches(d, e, since, until) {
+			continue
+		}
+		pools := make([]map[string]interface{}, 0, len(e.Pool))
+		for _, p := range e.Pool {
+			pool := map[string]interface{}{
+				cisHealthCheckEventsPoolID: flex.StringValue(p.ID),
+				cisHealthCheckEventName:    flex.StringValue(p.Name),
+				cisHealthCheckEventHealthy: p.Healthy != nil && *p.Healthy,
+				cisHealthCheckEventChanged: p.Changed != nil && *p.Changed,
+			}
+			if p.MinimumOrigins != nil {
+				pool[cisHealthCheckEventPoolMinimumOrigins] = *p.MinimumOrigins
+			}
+			pools = append(pools, pool)
+		}
+		origins := make([]map[string]interface{}, 0, len(e.Origins))
+		for _, o := range e.Origins {
+			origins = append(origins, map[string]interface{}{
+				cisHealthCheckEventName:                flex.StringValue(o.Name),
+				cisHealthCheckEventOriginAddress:       flex.StringValue(o.Address),
+				cisHealthCheckEventOriginIP:            flex.StringValue(o.Ip),
+				cisHealthCheckEventOriginEnabled:       o.Enabled != nil && *o.Enabled,
+				cisHealthCheckEventHealthy:             o.Healthy != nil && *o.Healthy,
+				cisHealthCheckEventOriginFailureReason: flex.StringValue(o.FailureReason),
+				cisHealthCheckEventChanged:             o.Changed != nil && *o.Changed,
+			})
+		}
+		timestamp := ""
+		if e.Timestamp != nil {
+			timestamp = e.Timestamp.String()
+		}
+		events = append(events, map[string]interface{}{
+			cisHealthCheckEventID:        flex.StringValue(e.ID),
+			cisHealthCheckEventTimestamp: timestamp,
+			cisHealthCheckEventPools:     pools,
+			cisHealthCheckEventOrigins:   origins,
+		})
+	}
+
+	d.SetId(crn)
+	d.Set(cisHealthCheckEvents, events)
+	return nil
+}
+
+// dataSourceIBMCISHealthCheckEventsTimeRange returns the since and until filters, the zero time
+// when a filter is not set.
+func dataSourceIBMCISHealthCheckEventsTimeRange(d *schema.ResourceData) (since, until time.Time, err error) {
+	if v, ok := d.GetOk(cisHealthCheckEventsSince); ok {
+		if since, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return since, until, err
+		}
+	}
+	if v, ok := d.GetOk(cisHealthCheckEventsUntil); ok {
+		if until, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return since, until, err
+		}
+	}
+	return since, until, nil
+}
+
+// dataSourceIBMCISHealthCheckEventMatches reports whether an event matches the filters of the data
+// source. The load balancer events API of the networking SDK has no filters, the events are
+// filtered after they are listed.
+func dataSourceIBMCISHealthCheckEventMatches(d *schema.ResourceData, e globalloadbalancereventsv1.ListEventsRespResultItem, since, until time.Time) bool {
+	if !since.IsZero() || !until.IsZero() {
+		if e.Timestamp == nil {
+			return false
+		}
+		timestamp := time.Time(*e.Timestamp)
+		if (!since.IsZero() && timestamp.Before(since)) || (!until.IsZero() && timestamp.After(until)) {
+			return false
+		}
+	}
+
+	poolID, filterPoolID := d.GetOk(cisHealthCheckEventsPoolID)
+	poolHealthy, filterPoolHealthy := d.GetOkExists(cisHealthCheckEventsPoolHealthy)
+	if filterPoolID || filterPoolHealthy {
+		found := false
+		for _, p := range e.Pool {
+			if filterPoolID && flex.StringValue(p.ID) != poolID.(string) {
+				continue
+			}
+			if filterPoolHealthy && (p.Healthy == nil || *p.Healthy != poolHealthy.(bool)) {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+
+	originName, filterOriginName := d.GetOk(cisHealthCheckEventsOriginName)
+	originHealthy, filterOriginHealthy := d.GetOkExists(cisHealthCheckEventsOriginHealthy)
+	if filterOriginName || filterOriginHealthy {
+		found := false
+		for _, o := range e.Origins {
+			if filterOriginName && flex.StringValue(o.Name) != originName.(string) {
+				continue
+			}
+			if filterOriginHealthy && (o.Healthy == nil || *o.Healthy != originHealthy.(bool)) {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisHealthCheckEventsDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_healthcheck_events.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisHealthCheckEventsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisHealthCheckEventsDataSourceConfig() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + `
+	data "ibm_cis_healthcheck_events" "test" {
+		cis_id         = data.ibm_cis.cis.id
+		origin_healthy = false
+		since          = "2025-01-01T00:00:00Z"
+	}`
+}
@@ -4,12 +4,17 @@
 package cis
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,8 +31,21 @@ const (
 	cisAdvancedCertificatePackCloudflareBranding  = "cloudflare_branding"
 	cisAdvancedCertificatePackOrderTypeAdvanced   = "advanced"
 	cisOriginCertificateList                      = "origin_certificate_list"
+	cisAdvancedCertificatePackWaitForActive       = "wait_for_active"
+	cisAdvancedCertificatePackValidationRecords   = "validation_records"
+	cisAdvancedCertificatePackValidationName      = "record_name"
+	cisAdvancedCertificatePackValidationTarget    = "record_target"
+	cisAdvancedCertificatePackValidationType      = "verification_type"
+	cisAdvancedCertificatePackValidationVerified  = "verification_status"
+	cisAdvancedCertificatePackStatusActive        = "active"
+	cisAdvancedCertificatePackStatusInitializing  = "initializing"
 )
 
+// certificate pack states between the validation and the deployment of the certificates
+var cisAdvancedCertificatePackPendingStatus = []string{
+	"pending_issuance", "pending_deployment", "pending_validation",
+}
+
 func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 	return &schema.Resource{
 		Create:   ResourceIBMCISAdvancedCertificatePackOrderCreate,
@@ -35,11 +53,15 @@ func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 		Read:     ResourceIBMCISAdvancedCertificatePackOrderRead,
 		Delete:   ResourceIBMCISAdvancedCertificatePackOrderDelete,
 		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
 				Description: "CIS object ID or CRN",
 				Required:    true,
+				ForceNew:    true,
 				ValidateFunc: validate.InvokeValidator(ibmCISAdvancedCertificatePackOrder,
 					"cis_id"),
 			},
@@ -47,6 +69,7 @@ func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 				Type:             schema.TypeString,
 				Description:      "Associated CIS domain",
 				Required:         true,
+				ForceNew:         true,
 				DiffSuppressFunc: suppressDomainIDDiff,
 			},
 			cisAdvancedCertificatePackOrderID: {
@@ -59,6 +82,7 @@ func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 				Description: "Certificate type",
 				Optional:    true,
 				Default:     cisAdvancedCertificatePackOrderTypeAdvanced,
+				ForceNew:    true,
 				ValidateFunc: validate.InvokeValidator(ibmCISAdvancedCertificatePackOrder,
 					cisAdvancedCertificatePackOrderType),
 			},
@@ -66,6 +90,7 @@ func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: "Hosts for which certificates need to be ordered",
 				Required:    true,
+				ForceNew:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			cisAdvancedCertificatePackOrderStatus: {
@@ -77,23 +102,67 @@ func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Validation method",
 				Required:    true,
+				ForceNew:    true,
 			},
 			cisAdvancedCertificatePackValidityDays: {
 				Type:        schema.TypeInt,
 				Description: "Validity days",
 				Required:    true,
+				ForceNew:    true,
 			},
 			cisAdvancedCertificatePackCertificateAthority: {
 				Type:        schema.TypeString,
 				Description: "Certificate authority",
 				Required:    true,
+				ForceNew:    true,
 			},
 			cisAdvancedCertificatePackCloudflareBranding: {
 				Type:        schema.TypeBool,
 				Description: "Cloudflare branding",
 				Optional:    true,
+				ForceNew:    true,
 				Default:     false,
 			},
+			cisAdvancedCertificatePackWaitForActive: {
+				Type:        schema.TypeBool,
+				Description: "Wait until the certificates are issued and deployed",
+				Optional:    true,
+				Default:     false,
+			},
+			cisAdvancedCertificatePackValidationRecords: {
+				Type:        schema.TypeList,
+				Description: "Records to validate the ownership of the hosts",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisAdvancedCertificatePackValidationName: {
+							Type:        schema.TypeString,
+							Description: "Name of the validation record",
+							Computed:    true,
+						},
+						cisAdvancedCertificatePackValidationTarget: {
+							Type:        schema.TypeString,
+							Description: "Target of the validation record",
+							Computed:    true,
+						},
+						cisAdvancedCertificatePackValidationType: {
+							Type:        schema.TypeString,
+							Description: "Method of the certificate verification",
+							Computed:    true,
+						},
+						cisAdvancedCertificatePackValidationVerified: {
+							Type:        schema.TypeBool,
+							Description: "Whether the validation record was verified",
+							Computed:    true,
+						},
+						cisAdvancedCertificatePackOrderStatus: {
+							Type:        schema.TypeString,
+							Description: "Status of the certificate",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -158,14 +227,191 @@ func ResourceIBMCISAdvancedCertificatePackOrderCreate(d *schema.ResourceData, me
 	d.Set(cisAdvancedCertificatePackOrderID, *result.Result.ID)
 	d.Set(cisAdvancedCertificatePackOrderStatus, *result.Result.Status)
 
-	return nil
+	// the validation records are known once the pack leaves the initializing state, the certificates
+	// are only issued after the records are published
+	target := append([]string{cisAdvancedCertificatePackStatusActive}, cisAdvancedCertificatePackPendingStatus...)
+	if d.Get(cisAdvancedCertificatePackWaitForActive).(bool) {
+		target = []string{cisAdvancedCertificatePackStatusActive}
+	}
+	_, err = waitForCISAdvancedCertificatePackStatus(d, meta, target)
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error waiting for the advanced certificate pack %s: %s", *result.Result.ID, err)
+	}
+
+	return ResourceIBMCISAdvancedCertificatePackOrderRead(d, meta)
 }
 
 func ResourceIBMCISAdvancedCertificatePackOrderRead(d *schema.ResourceData, meta interface{}) error {
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		log.Println("Error in reading certificate ID")
+		return err
+	}
+	pack, err := getCISAdvancedCertificatePack(meta, certificateID, zoneID, crn)
+	if err != nil {
+		return err
+	}
+	if pack == nil {
+		log.Printf("[WARN] Advanced certificate pack %s not found", certificateID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisAdvancedCertificatePackOrderID, certificateID)
+	if v, ok := pack["type"].(string); ok {
+		d.Set(cisAdvancedCertificatePackOrderType, v)
+	}
+	// The pack can hold more hosts than ordered, the zone apex is always added. The hosts are only
+	// read when one of the ordered hosts is missing from the pack, so that the pack is not replaced
+	// for the hosts added by the API.
+	if v, ok := pack["hosts"].([]interface{}); ok && cisAdvancedCertificatePackHostsMissing(d.Get(cisAdvancedCertificatePackOrderHosts).([]interface{}), v) {
+		d.Set(cisAdvancedCertificatePackOrderHosts, v)
+	}
+	if v, ok := pack["status"].(string); ok {
+		d.Set(cisAdvancedCertificatePackOrderStatus, v)
+	}
+	if v, ok := pack["validation_method"].(string); ok {
+		d.Set(cisAdvancedCertificatePackValidationMethod, v)
+	}
+	if v, ok := pack["validity_days"].(float64); ok {
+		d.Set(cisAdvancedCertificatePackValidityDays, int(v))
+	}
+	if v, ok := pack["certificate_authority"].(string); ok {
+		d.Set(cisAdvancedCertificatePackCertificateAthority, v)
+	}
+	if v, ok := pack["cloudflare_branding"].(bool); ok {
+		d.Set(cisAdvancedCertificatePackCloudflareBranding, v)
+	}
+	validationRecords, err := getCISAdvancedCertificatePackValidationRecords(meta, certificateID, zoneID, crn)
+	if err != nil {
+		return err
+	}
+	d.Set(cisAdvancedCertificatePackValidationRecords, validationRecords)
 
 	return nil
 }
 
+// cisAdvancedCertificatePackHostsMissing returns true when one of the hosts is not in the hosts of the
+// pack, or when there are no hosts as after an import.
+func cisAdvancedCertificatePackHostsMissing(hosts, packHosts []interface{}) bool {
+	if len(hosts) == 0 {
+		return true
+	}
+	for _, host := range hosts {
+		found := false
+		for _, packHost := range packHosts {
+			if strings.EqualFold(host.(string), fmt.Sprint(packHost)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
+// getCISAdvancedCertificatePack returns the certificate pack with the given ID as a map of its JSON
+// fields, so the validation details are read as returned by the API. A missing pack returns nil.
+func getCISAdvancedCertificatePack(meta interface{}, certificateID, zoneID, crn string) (map[string]interface{}, error) {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	result, resp, err := cisClient.ListCertificates(cisClient.NewListCertificatesOptions())
+	if err != nil {
+		log.Printf("List certificates failed: %v", resp)
+		return nil, err
+	}
+	for _, instance := range result.Result {
+		if instance.ID == nil || *instance.ID != certificateID {
+			continue
+		}
+		pack := map[string]interface{}{}
+		res, err := json.Marshal(instance)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(res, &pack)
+		if err != nil {
+			return nil, err
+		}
+		return pack, nil
+	}
+	return nil, nil
+}
+
+// getCISAdvancedCertificatePackValidationRecords returns the validation records of the pack. The
+// certificate list does not return them, they are read from the SSL verification of the zone.
+func getCISAdvancedCertificatePackValidationRecords(meta interface{}, certificateID, zoneID, crn string) ([]map[string]interface{}, error) {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	result, resp, err := cisClient.GetSslVerification(cisClient.NewGetSslVerificationOptions())
+	if err != nil {
+		log.Printf("Get SSL verification failed: %v", resp)
+		return nil, err
+	}
+	validationRecords := make([]map[string]interface{}, 0)
+	for _, verification := range result.Result {
+		if verification.CertPackUUID == nil || *verification.CertPackUUID != certificateID {
+			continue
+		}
+		validationRecord := map[string]interface{}{
+			cisAdvancedCertificatePackValidationType:     flex.StringValue(verification.VerificationType),
+			cisAdvancedCertificatePackValidationVerified: verification.VerificationStatus != nil && *verification.VerificationStatus,
+			cisAdvancedCertificatePackOrderStatus:        flex.StringValue(verification.CertificateStatus),
+		}
+		if verification.VerificationInfo != nil {
+			validationRecord[cisAdvancedCertificatePackValidationName] = flex.StringValue(verification.VerificationInfo.RecordName)
+			validationRecord[cisAdvancedCertificatePackValidationTarget] = flex.StringValue(verification.VerificationInfo.RecordTarget)
+		}
+		validationRecords = append(validationRecords, validationRecord)
+	}
+	return validationRecords, nil
+}
+
+func waitForCISAdvancedCertificatePackStatus(d *schema.ResourceData, meta interface{}, target []string) (interface{}, error) {
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		log.Println("Error in reading certificate ID")
+		return nil, err
+	}
+	pending := []string{cisAdvancedCertificatePackStatusInitializing}
+	if len(target) == 1 {
+		pending = append(pending, cisAdvancedCertificatePackPendingStatus...)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			pack, err := getCISAdvancedCertificatePack(meta, certificateID, zoneID, crn)
+			if err != nil {
+				return nil, "", err
+			}
+			if pack == nil {
+				return nil, "", flex.FmtErrorf("[ERROR] Advanced certificate pack %s not found", certificateID)
+			}
+			status, _ := pack["status"].(string)
+			return pack, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func ResourceIBMCISAdvancedCertificatePackOrderDelete(d *schema.ResourceData, meta interface{}) error {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
@@ -22,6 +22,9 @@ func TestAccIBMCisAdvancedCertificatepackOrder_Basic(t *testing.T) {
 				Config: testAccCheckCisAdvancedCertificatePackOrderConfigBasic(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "hosts.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "validation_records.0.record_name"),
+					resource.TestCheckResourceAttrSet(name, "validation_records.0.record_target"),
 				),
 			},
 		},
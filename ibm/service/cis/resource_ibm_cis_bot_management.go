@@ -4,6 +4,8 @@
 package cis
 
 import (
+	"log"
+
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -13,7 +15,7 @@ import (
 
 func ResourceIBMCISBotManagement() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISBotAnalyticsRead,
+		Read:     ResourceIBMCISBotManagementRead,
 		Create:   ResourceIBMCISBotManagementCreate,
 		Update:   ResourceIBMCISBotManagementUpdate,
 		Delete:   ResourceIBMCISBotManagementDelete,
@@ -23,6 +25,7 @@ func ResourceIBMCISBotManagement() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "CIS instance crn",
 				Required:    true,
+				ForceNew:    true,
 				ValidateFunc: validate.InvokeValidator("ibm_cis_bot_management",
 					"cis_id"),
 			},
@@ -30,30 +33,36 @@ func ResourceIBMCISBotManagement() *schema.Resource {
 				Type:             schema.TypeString,
 				Description:      "Associated CIS domain",
 				Required:         true,
+				ForceNew:         true,
 				DiffSuppressFunc: suppressDomainIDDiff,
 			},
 			cisBotManagementFightMode: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Fight Mode",
 			},
 			cisBotManagementSessionScore: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Session Score",
 			},
 			cisBotManagementEnableJs: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Enable JS",
 			},
 			cisBotManagementAuthIdLogging: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Auth ID Logging",
 			},
 			cisBotManagementUseLatestModel: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Use Latest Model",
 			},
@@ -62,58 +71,91 @@ func ResourceIBMCISBotManagement() *schema.Resource {
 }
 
 func ResourceIBMCISBotManagementCreate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
 
 	return ResourceIBMCISBotManagementUpdate(d, meta)
 }
 
 func ResourceIBMCISBotManagementUpdate(d *schema.ResourceData, meta interface{}) error {
-	sess, err := meta.(conns.ClientSession).CisBotManagementSession()
-	if err != nil {
-		return flex.FmtErrorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err)
-	}
-	crn := d.Get(cisID).(string)
-	sess.Crn = core.StringPtr(crn)
-
 	cisClient, err := meta.(conns.ClientSession).CisBotManagementSession()
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error while getting the CisBotManagementSession %s", err)
 	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
-	if d.HasChange(cisBotManagementFightMode) ||
-		d.HasChange(cisBotManagementSessionScore) ||
-		d.HasChange(cisBotManagementEnableJs) ||
-		d.HasChange(cisBotManagementAuthIdLogging) ||
-		d.HasChange(cisBotManagementUseLatestModel) {
-
-		opt := cisClient.NewUpdateBotManagementOptions()
-
-		if f, ok := d.GetOk(cisBotManagementFightMode); ok {
-			fightmode := f.(bool)
-			opt.SetFightMode(fightmode)
-		}
-		if s, ok := d.GetOk(cisBotManagementSessionScore); ok {
-			sessionscore := s.(bool)
-			opt.SetSessionScore(sessionscore)
-		}
-		if e, ok := d.GetOk(cisBotManagementEnableJs); ok {
-			enablejs := e.(bool)
-			opt.SetEnableJs(enablejs)
-		}
-		if a, ok := d.GetOk(cisBotManagementAuthIdLogging); ok {
-			authidlogging := a.(bool)
-			opt.SetAuthIdLogging(authidlogging)
-		}
-		if sl, ok := d.GetOk(cisBotManagementUseLatestModel); ok {
-			uselatestmodel := sl.(bool)
-			opt.SetUseLatestModel(uselatestmodel)
-		}
+	// only the settings given in the configuration are sent, the others keep their values
+	opt := cisClient.NewUpdateBotManagementOptions()
+	update := false
+	if f, ok := d.GetOkExists(cisBotManagementFightMode); ok {
+		opt.SetFightMode(f.(bool))
+		update = true
+	}
+	if s, ok := d.GetOkExists(cisBotManagementSessionScore); ok {
+		opt.SetSessionScore(s.(bool))
+		update = true
+	}
+	if e, ok := d.GetOkExists(cisBotManagementEnableJs); ok {
+		opt.SetEnableJs(e.(bool))
+		update = true
+	}
+	if a, ok := d.GetOkExists(cisBotManagementAuthIdLogging); ok {
+		opt.SetAuthIdLogging(a.(bool))
+		update = true
+	}
+	if sl, ok := d.GetOkExists(cisBotManagementUseLatestModel); ok {
+		opt.SetUseLatestModel(sl.(bool))
+		update = true
+	}
 
+	if update {
 		_, resp, err := cisClient.UpdateBotManagement(opt)
 		if err != nil {
 			return flex.FmtErrorf("[ERROR] Error updating BotManagement with error: %s %s", err, resp)
 		}
 	}
-	return dataSourceIBMCISBotManagementRead(d, meta)
+	return ResourceIBMCISBotManagementRead(d, meta)
+}
+
+func ResourceIBMCISBotManagementRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisBotManagementSession()
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while getting the CisBotManagementSession %s", err)
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	result, resp, err := cisClient.GetBotManagement(cisClient.NewGetBotManagementOptions())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Bot Management settings of %s are not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return flex.FmtErrorf("[ERROR] Error getting BotManagement with error: %s %s", err, resp)
+	}
+
+	res := result.Result
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	if res != nil {
+		d.Set(cisBotManagementFightMode, res.FightMode)
+		d.Set(cisBotManagementSessionScore, res.SessionScore)
+		d.Set(cisBotManagementEnableJs, res.EnableJs)
+		d.Set(cisBotManagementAuthIdLogging, res.AuthIdLogging)
+		d.Set(cisBotManagementUseLatestModel, res.UseLatestModel)
+	}
+	return nil
 }
 
 func ResourceIBMCISBotManagementValidator() *validate.ResourceValidator {
@@ -131,6 +173,9 @@ func ResourceIBMCISBotManagementValidator() *validate.ResourceValidator {
 	return &ibmCISBotManagementResourceValidator
 }
 
+// ResourceIBMCISBotManagementDelete only removes the resource from the state, the Bot Management
+// settings of the domain can not be deleted.
 func ResourceIBMCISBotManagementDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
 	return nil
 }
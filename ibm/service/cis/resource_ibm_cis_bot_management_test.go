@@ -20,7 +20,7 @@ func TestAccIBMCisBotManagement_Basic(t *testing.T) {
 		Providers: acc.TestAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckCisBotManagementBasic1("test", acc.CisDomainStatic),
+				Config: testAccCheckCisBotManagementBasic1("test", acc.CisDomainStatic, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "fight_mode", "false"),
 					resource.TestCheckResourceAttr(name, "session_score", "false"),
@@ -29,20 +29,32 @@ func TestAccIBMCisBotManagement_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "use_latest_model", "false"),
 				),
 			},
+			{
+				Config: testAccCheckCisBotManagementBasic1("test", acc.CisDomainStatic, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enable_js", "true"),
+					resource.TestCheckResourceAttr(name, "use_latest_model", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func testAccCheckCisBotManagementBasic1(id string, CisDomainStatic string) string {
+func testAccCheckCisBotManagementBasic1(id string, CisDomainStatic string, enabled bool) string {
 	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
 	resource "ibm_cis_bot_management" "%[1]s" {
 		cis_id                    = data.ibm_cis.cis.id
-		domain_id                 = data.ibm_cis_domain.cis_domain.domain
-		fight_mode				= false
-		session_score			= false
-		enable_js				= false
-		auth_id_logging			= false
-		use_latest_model 		= false
+		domain_id                 = data.ibm_cis_domain.cis_domain.domain_id
+		fight_mode                = false
+		session_score             = false
+		enable_js                 = %[2]t
+		auth_id_logging           = false
+		use_latest_model          = %[2]t
 	  }
-`, id)
+`, id, enabled)
 }
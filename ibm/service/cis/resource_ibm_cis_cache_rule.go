@@ -0,0 +1,568 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ibmCISCacheRule                        = "ibm_cis_cache_rule"
+	cisCacheRulePhase                      = "http_request_cache_settings"
+	cisCacheRuleAction                     = "set_cache_settings"
+	cisCacheRuleID                         = "rule_id"
+	cisCacheRuleRulesetID                  = "ruleset_id"
+	cisCacheRuleExpression                 = "expression"
+	cisCacheRuleDescription                = "description"
+	cisCacheRuleEnabled                    = "enabled"
+	cisCacheRuleCache                      = "cache"
+	cisCacheRuleEdgeTTL                    = "edge_ttl"
+	cisCacheRuleBrowserTTL                 = "browser_ttl"
+	cisCacheRuleTTLMode                    = "mode"
+	cisCacheRuleTTLDefault                 = "default"
+	cisCacheRuleCacheKey                   = "cache_key"
+	cisCacheRuleCacheKeyDeceptionArmor     = "cache_deception_armor"
+	cisCacheRuleCacheKeyIgnoreQueryOrder   = "ignore_query_strings_order"
+	cisCacheRuleCacheKeyQueryStringInclude = "query_string_include"
+	cisCacheRuleCacheKeyQueryStringExclude = "query_string_exclude"
+	cisCacheRuleCacheKeyHeaderInclude      = "header_include"
+	cisCacheRuleCacheKeyCookieInclude      = "cookie_include"
+	cisCacheRuleCacheKeyHostResolved       = "host_resolved"
+	cisCacheRuleTTLModeOverrideOrigin      = "override_origin"
+)
+
+// ResourceIBMCISCacheRule manages a rule of the entry point ruleset of the cache settings phase of a
+// domain. The rule sets the cache settings of the requests matching its expression.
+func ResourceIBMCISCacheRule() *schema.Resource {
+	ttlSchema := func(description string, modes []string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					cisCacheRuleTTLMode: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ValidateAllowedStringValues(modes),
+						Description:  "How the TTL is determined",
+					},
+					cisCacheRuleTTLDefault: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "TTL in seconds, used with the override_origin mode",
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIBMCISCacheRuleCreate,
+		ReadContext:   resourceIBMCISCacheRuleRead,
+		UpdateContext: resourceIBMCISCacheRuleUpdate,
+		DeleteContext: resourceIBMCISCacheRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCISCacheRuleCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCacheRule,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisCacheRuleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cache rule ID",
+			},
+			cisCacheRuleRulesetID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the entry point ruleset of the cache settings phase",
+			},
+			cisCacheRuleExpression: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The expression of the requests the rule applies to",
+			},
+			cisCacheRuleDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the rule",
+			},
+			cisCacheRuleEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the rule is enabled",
+			},
+			cisCacheRuleCache: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the matching requests are eligible for caching, false bypasses the cache",
+			},
+			cisCacheRuleEdgeTTL: ttlSchema("How long the responses are cached at the edge",
+				[]string{"respect_origin", "bypass_by_default", cisCacheRuleTTLModeOverrideOrigin}),
+			cisCacheRuleBrowserTTL: ttlSchema("How long the browsers cache the responses",
+				[]string{"respect_origin", "bypass", cisCacheRuleTTLModeOverrideOrigin}),
+			cisCacheRuleCacheKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Customization of the cache key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisCacheRuleCacheKeyDeceptionArmor: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the extension of the URL is part of the cache key, to protect against cache deception attacks",
+						},
+						cisCacheRuleCacheKeyIgnoreQueryOrder: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the order of the query string parameters is ignored",
+						},
+						cisCacheRuleCacheKeyQueryStringInclude: {
+							Type:          schema.TypeList,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{cisCacheRuleCacheKey + ".0." + cisCacheRuleCacheKeyQueryStringExclude},
+							Description:   "Query string parameters that are part of the cache key, * includes all",
+						},
+						cisCacheRuleCacheKeyQueryStringExclude: {
+							Type:          schema.TypeList,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{cisCacheRuleCacheKey + ".0." + cisCacheRuleCacheKeyQueryStringInclude},
+							Description:   "Query string parameters that are not part of the cache key, * excludes all",
+						},
+						cisCacheRuleCacheKeyHeaderInclude: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Request headers whose values are part of the cache key",
+						},
+						cisCacheRuleCacheKeyCookieInclude: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Cookies whose values are part of the cache key",
+						},
+						cisCacheRuleCacheKeyHostResolved: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the resolved host instead of the Host header is part of the cache key",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISCacheRuleValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISCacheRuleValidator := validate.ResourceValidator{
+		ResourceName: ibmCISCacheRule,
+		Schema:       validateSchema}
+	return &ibmCISCacheRuleValidator
+}
+
+// resourceIBMCISCacheRuleCustomizeDiff rejects TTLs that are ignored by the API: a TTL needs the
+// override_origin mode, and a bypassed cache has no edge TTL or cache key.
+func resourceIBMCISCacheRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	for _, key := range []string{cisCacheRuleEdgeTTL, cisCacheRuleBrowserTTL} {
+		ttl, ok := diff.GetOk(key)
+		if !ok || len(ttl.([]interface{})) == 0 || ttl.([]interface{})[0] == nil {
+			continue
+		}
+		t := ttl.([]interface{})[0].(map[string]interface{})
+		mode := t[cisCacheRuleTTLMode].(string)
+		if mode == cisCacheRuleTTLModeOverrideOrigin && t[cisCacheRuleTTLDefault].(int) == 0 {
+			return flex.FmtErrorf("%s.%s is required with the %s mode", key, cisCacheRuleTTLDefault, mode)
+		}
+		if mode != cisCacheRuleTTLModeOverrideOrigin && t[cisCacheRuleTTLDefault].(int) != 0 {
+			return flex.FmtErrorf("%s.%s can only be set with the %s mode", key, cisCacheRuleTTLDefault,
+				cisCacheRuleTTLModeOverrideOrigin)
+		}
+	}
+	if !diff.Get(cisCacheRuleCache).(bool) {
+		for _, key := range []string{cisCacheRuleEdgeTTL, cisCacheRuleCacheKey} {
+			if _, ok := diff.GetOk(key); ok {
+				return flex.FmtErrorf("%s can not be set when %s is false", key, cisCacheRuleCache)
+			}
+		}
+	}
+	return nil
+}
+
+type cisCacheRuleTTL struct {
+	Mode    *string `json:"mode,omitempty"`
+	Default *int64  `json:"default,omitempty"`
+}
+
+type cisCacheRuleInclude struct {
+	Include []string `json:"include,omitempty"`
+}
+
+type cisCacheRuleQueryString struct {
+	Include interface{} `json:"include,omitempty"`
+	Exclude interface{} `json:"exclude,omitempty"`
+}
+
+type cisCacheRuleCustomKey struct {
+	QueryString *cisCacheRuleQueryString `json:"query_string,omitempty"`
+	Header      *cisCacheRuleInclude     `json:"header,omitempty"`
+	Cookie      *cisCacheRuleInclude     `json:"cookie,omitempty"`
+	Host        *cisCacheRuleHost        `json:"host,omitempty"`
+}
+
+type cisCacheRuleHost struct {
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+type cisCacheRuleKey struct {
+	CacheDeceptionArmor     *bool                  `json:"cache_deception_armor,omitempty"`
+	IgnoreQueryStringsOrder *bool                  `json:"ignore_query_strings_order,omitempty"`
+	CustomKey               *cisCacheRuleCustomKey `json:"custom_key,omitempty"`
+}
+
+type cisCacheRuleActionParameters struct {
+	Cache      *bool            `json:"cache,omitempty"`
+	EdgeTTL    *cisCacheRuleTTL `json:"edge_ttl,omitempty"`
+	BrowserTTL *cisCacheRuleTTL `json:"browser_ttl,omitempty"`
+	CacheKey   *cisCacheRuleKey `json:"cache_key,omitempty"`
+}
+
+type cisCacheRuleRule struct {
+	ID               *string                       `json:"id,omitempty"`
+	Action           *string                       `json:"action,omitempty"`
+	ActionParameters *cisCacheRuleActionParameters `json:"action_parameters,omitempty"`
+	Description      *string                       `json:"description,omitempty"`
+	Enabled          *bool                         `json:"enabled,omitempty"`
+	Expression       *string                       `json:"expression,omitempty"`
+}
+
+type cisCacheRuleRuleset struct {
+	ID    *string            `json:"id,omitempty"`
+	Rules []cisCacheRuleRule `json:"rules"`
+}
+
+type cisCacheRuleRulesetResp struct {
+	Result *cisCacheRuleRuleset `json:"result"`
+}
+
+func expandCISCacheRuleTTL(ttl []interface{}) *cisCacheRuleTTL {
+	if len(ttl) == 0 || ttl[0] == nil {
+		return nil
+	}
+	t := ttl[0].(map[string]interface{})
+	result := &cisCacheRuleTTL{Mode: core.StringPtr(t[cisCacheRuleTTLMode].(string))}
+	if def := t[cisCacheRuleTTLDefault].(int); def != 0 {
+		result.Default = core.Int64Ptr(int64(def))
+	}
+	return result
+}
+
+// expandCISCacheRuleQueryStringList returns the all parameters value of the API for a list of "*"
+func expandCISCacheRuleQueryStringList(list []interface{}) interface{} {
+	values := flex.ExpandStringList(list)
+	if len(values) == 1 && values[0] == "*" {
+		return map[string]bool{"all": true}
+	}
+	return values
+}
+
+func expandCISCacheRuleKey(cacheKey []interface{}) *cisCacheRuleKey {
+	if len(cacheKey) == 0 || cacheKey[0] == nil {
+		return nil
+	}
+	k := cacheKey[0].(map[string]interface{})
+	result := &cisCacheRuleKey{
+		CacheDeceptionArmor:     core.BoolPtr(k[cisCacheRuleCacheKeyDeceptionArmor].(bool)),
+		IgnoreQueryStringsOrder: core.BoolPtr(k[cisCacheRuleCacheKeyIgnoreQueryOrder].(bool)),
+	}
+	customKey := &cisCacheRuleCustomKey{}
+	if include := k[cisCacheRuleCacheKeyQueryStringInclude].([]interface{}); len(include) > 0 {
+		customKey.QueryString = &cisCacheRuleQueryString{Include: expandCISCacheRuleQueryStringList(include)}
+	}
+	if exclude := k[cisCacheRuleCacheKeyQueryStringExclude].([]interface{}); len(exclude) > 0 {
+		customKey.QueryString = &cisCacheRuleQueryString{Exclude: expandCISCacheRuleQueryStringList(exclude)}
+	}
+	if headers := k[cisCacheRuleCacheKeyHeaderInclude].([]interface{}); len(headers) > 0 {
+		customKey.Header = &cisCacheRuleInclude{Include: flex.ExpandStringList(headers)}
+	}
+	if cookies := k[cisCacheRuleCacheKeyCookieInclude].([]interface{}); len(cookies) > 0 {
+		customKey.Cookie = &cisCacheRuleInclude{Include: flex.ExpandStringList(cookies)}
+	}
+	if k[cisCacheRuleCacheKeyHostResolved].(bool) {
+		customKey.Host = &cisCacheRuleHost{Resolved: core.BoolPtr(true)}
+	}
+	result.CustomKey = customKey
+	return result
+}
+
+func expandCISCacheRule(d *schema.ResourceData) *cisCacheRuleRule {
+	rule := &cisCacheRuleRule{
+		Action:      core.StringPtr(cisCacheRuleAction),
+		Description: core.StringPtr(d.Get(cisCacheRuleDescription).(string)),
+		Enabled:     core.BoolPtr(d.Get(cisCacheRuleEnabled).(bool)),
+		Expression:  core.StringPtr(d.Get(cisCacheRuleExpression).(string)),
+		ActionParameters: &cisCacheRuleActionParameters{
+			Cache: core.BoolPtr(d.Get(cisCacheRuleCache).(bool)),
+		},
+	}
+	rule.ActionParameters.EdgeTTL = expandCISCacheRuleTTL(d.Get(cisCacheRuleEdgeTTL).([]interface{}))
+	rule.ActionParameters.BrowserTTL = expandCISCacheRuleTTL(d.Get(cisCacheRuleBrowserTTL).([]interface{}))
+	rule.ActionParameters.CacheKey = expandCISCacheRuleKey(d.Get(cisCacheRuleCacheKey).([]interface{}))
+	return rule
+}
+
+func flattenCISCacheRuleTTL(ttl *cisCacheRuleTTL) []interface{} {
+	if ttl == nil || ttl.Mode == nil {
+		return []interface{}{}
+	}
+	t := map[string]interface{}{cisCacheRuleTTLMode: *ttl.Mode}
+	if ttl.Default != nil {
+		t[cisCacheRuleTTLDefault] = int(*ttl.Default)
+	}
+	return []interface{}{t}
+}
+
+func flattenCISCacheRuleQueryStringList(list interface{}) []string {
+	switch v := list.(type) {
+	case []interface{}:
+		values := []string{}
+		for _, value := range v {
+			values = append(values, fmt.Sprint(value))
+		}
+		return values
+	case map[string]interface{}:
+		if all, ok := v["all"].(bool); ok && all {
+			return []string{"*"}
+		}
+	}
+	return nil
+}
+
+func flattenCISCacheRuleKey(cacheKey *cisCacheRuleKey) []interface{} {
+	if cacheKey == nil {
+		return []interface{}{}
+	}
+	k := map[string]interface{}{
+		cisCacheRuleCacheKeyDeceptionArmor:   cacheKey.CacheDeceptionArmor != nil && *cacheKey.CacheDeceptionArmor,
+		cisCacheRuleCacheKeyIgnoreQueryOrder: cacheKey.IgnoreQueryStringsOrder != nil && *cacheKey.IgnoreQueryStringsOrder,
+	}
+	if customKey := cacheKey.CustomKey; customKey != nil {
+		if customKey.QueryString != nil {
+			k[cisCacheRuleCacheKeyQueryStringInclude] = flattenCISCacheRuleQueryStringList(customKey.QueryString.Include)
+			k[cisCacheRuleCacheKeyQueryStringExclude] = flattenCISCacheRuleQueryStringList(customKey.QueryString.Exclude)
+		}
+		if customKey.Header != nil {
+			k[cisCacheRuleCacheKeyHeaderInclude] = customKey.Header.Include
+		}
+		if customKey.Cookie != nil {
+			k[cisCacheRuleCacheKeyCookieInclude] = customKey.Cookie.Include
+		}
+		k[cisCacheRuleCacheKeyHostResolved] = customKey.Host != nil && customKey.Host.Resolved != nil && *customKey.Host.Resolved
+	}
+	return []interface{}{k}
+}
+
+func resourceIBMCISCacheRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	client, err := getCISZoneClient(meta, crn, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleCreate CisRulesetsSession initialization failed: %s", err.Error()),
+			ibmCISCacheRule, "create")
+		return tfErr.GetDiag()
+	}
+
+	// the rules created in parallel must not both create the entry point ruleset, and the new rule is
+	// read as the last rule of the ruleset
+	mk := fmt.Sprintf("%s_%s_%s", ibmCISCacheRule, zoneID, cisCacheRulePhase)
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	entrypoint := &cisCacheRuleRulesetResp{}
+	resp, err := client.request(context, core.GET, "/rulesets/phases/{phase}/entrypoint",
+		map[string]string{"phase": cisCacheRulePhase}, nil, entrypoint)
+	if err != nil && !isCISZoneClientNotFound(resp) {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleCreate GetZoneEntrypointRuleset failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISCacheRule, "create")
+		return tfErr.GetDiag()
+	}
+
+	// the first cache rule of the domain creates the entry point ruleset of the phase, the other
+	// rules are appended to it
+	result := &cisCacheRuleRulesetResp{}
+	if isCISZoneClientNotFound(resp) || entrypoint.Result == nil || entrypoint.Result.ID == nil {
+		resp, err = client.request(context, core.PUT, "/rulesets/phases/{phase}/entrypoint",
+			map[string]string{"phase": cisCacheRulePhase},
+			&cisCacheRuleRuleset{Rules: []cisCacheRuleRule{*expandCISCacheRule(d)}}, result)
+	} else {
+		resp, err = client.request(context, core.POST, "/rulesets/{ruleset_id}/rules",
+			map[string]string{"ruleset_id": *entrypoint.Result.ID}, expandCISCacheRule(d), result)
+	}
+	if err != nil || result.Result == nil || result.Result.ID == nil || len(result.Result.Rules) == 0 {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleCreate CreateZoneRulesetRule failed: %v \nResponse: %v", err, resp),
+			ibmCISCacheRule, "create")
+		return tfErr.GetDiag()
+	}
+
+	rule := result.Result.Rules[len(result.Result.Rules)-1]
+	d.SetId(flex.ConvertCisToTfFourVar(*rule.ID, *result.Result.ID, zoneID, crn))
+	return resourceIBMCISCacheRuleRead(context, d, meta)
+}
+
+func resourceIBMCISCacheRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ruleID, rulesetID, zoneID, crn, err := flex.ConvertTfToCisFourVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleRead ConvertTfToCisFourVar failed: %s", err.Error()),
+			ibmCISCacheRule, "read")
+		return tfErr.GetDiag()
+	}
+	client, err := getCISZoneClient(meta, crn, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleRead CisRulesetsSession initialization failed: %s", err.Error()),
+			ibmCISCacheRule, "read")
+		return tfErr.GetDiag()
+	}
+
+	result := &cisCacheRuleRulesetResp{}
+	resp, err := client.request(context, core.GET, "/rulesets/{ruleset_id}",
+		map[string]string{"ruleset_id": rulesetID}, nil, result)
+	if err != nil || result.Result == nil {
+		if isCISZoneClientNotFound(resp) {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleRead GetZoneRuleset failed: %v \nResponse: %v", err, resp),
+			ibmCISCacheRule, "read")
+		return tfErr.GetDiag()
+	}
+
+	var rule *cisCacheRuleRule
+	for i := range result.Result.Rules {
+		if result.Result.Rules[i].ID != nil && *result.Result.Rules[i].ID == ruleID {
+			rule = &result.Result.Rules[i]
+			break
+		}
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisCacheRuleID, ruleID)
+	d.Set(cisCacheRuleRulesetID, rulesetID)
+	d.Set(cisCacheRuleExpression, rule.Expression)
+	d.Set(cisCacheRuleDescription, rule.Description)
+	d.Set(cisCacheRuleEnabled, rule.Enabled == nil || *rule.Enabled)
+	if params := rule.ActionParameters; params != nil {
+		d.Set(cisCacheRuleCache, params.Cache == nil || *params.Cache)
+		d.Set(cisCacheRuleEdgeTTL, flattenCISCacheRuleTTL(params.EdgeTTL))
+		d.Set(cisCacheRuleBrowserTTL, flattenCISCacheRuleTTL(params.BrowserTTL))
+		d.Set(cisCacheRuleCacheKey, flattenCISCacheRuleKey(params.CacheKey))
+	}
+	return nil
+}
+
+func resourceIBMCISCacheRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ruleID, rulesetID, zoneID, crn, err := flex.ConvertTfToCisFourVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleUpdate ConvertTfToCisFourVar failed: %s", err.Error()),
+			ibmCISCacheRule, "update")
+		return tfErr.GetDiag()
+	}
+	client, err := getCISZoneClient(meta, crn, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleUpdate CisRulesetsSession initialization failed: %s", err.Error()),
+			ibmCISCacheRule, "update")
+		return tfErr.GetDiag()
+	}
+
+	resp, err := client.request(context, core.PATCH, "/rulesets/{ruleset_id}/rules/{rule_id}",
+		map[string]string{"ruleset_id": rulesetID, "rule_id": ruleID}, expandCISCacheRule(d), nil)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleUpdate UpdateZoneRulesetRule failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISCacheRule, "update")
+		return tfErr.GetDiag()
+	}
+	return resourceIBMCISCacheRuleRead(context, d, meta)
+}
+
+func resourceIBMCISCacheRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ruleID, rulesetID, zoneID, crn, err := flex.ConvertTfToCisFourVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleDelete ConvertTfToCisFourVar failed: %s", err.Error()),
+			ibmCISCacheRule, "delete")
+		return tfErr.GetDiag()
+	}
+	client, err := getCISZoneClient(meta, crn, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleDelete CisRulesetsSession initialization failed: %s", err.Error()),
+			ibmCISCacheRule, "delete")
+		return tfErr.GetDiag()
+	}
+
+	resp, err := client.request(context, core.DELETE, "/rulesets/{ruleset_id}/rules/{rule_id}",
+		map[string]string{"ruleset_id": rulesetID, "rule_id": ruleID}, nil, nil)
+	if err != nil && !isCISZoneClientNotFound(resp) {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISCacheRuleDelete DeleteZoneRulesetRule failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISCacheRule, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
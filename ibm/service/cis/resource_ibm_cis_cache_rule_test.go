@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisCacheRule_Basic(t *testing.T) {
+	name := "ibm_cis_cache_rule." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisCacheRuleBasic1("test", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache", "true"),
+					resource.TestCheckResourceAttr(name, "edge_ttl.0.mode", "override_origin"),
+					resource.TestCheckResourceAttr(name, "edge_ttl.0.default", "3600"),
+					resource.TestCheckResourceAttr(name, "cache_key.0.query_string_exclude.0", "utm_source"),
+					resource.TestCheckResourceAttrSet(name, "rule_id"),
+					resource.TestCheckResourceAttrSet(name, "ruleset_id"),
+				),
+			},
+			{
+				Config: testAccCheckCisCacheRuleBasic1("test", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "edge_ttl.0.default", "7200"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCisCacheRule_Bypass(t *testing.T) {
+	name := "ibm_cis_cache_rule." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisCacheRuleBypass("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache", "false"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisCacheRuleBasic1(id string, ttl int) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_cache_rule" "%[1]s" {
+		cis_id      = data.ibm_cis.cis.id
+		domain_id   = data.ibm_cis_domain.cis_domain.domain_id
+		description = "Cache static assets"
+		expression  = "http.request.uri.path.extension in {\"css\" \"js\" \"png\"}"
+		edge_ttl {
+			mode    = "override_origin"
+			default = %[2]d
+		}
+		browser_ttl {
+			mode = "respect_origin"
+		}
+		cache_key {
+			ignore_query_strings_order = true
+			query_string_exclude       = ["utm_source"]
+		}
+	}
+`, id, ttl)
+}
+
+func testAccCheckCisCacheRuleBypass(id string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_cache_rule" "%[1]s" {
+		cis_id     = data.ibm_cis.cis.id
+		domain_id  = data.ibm_cis_domain.cis_domain.domain_id
+		expression = "starts_with(http.request.uri.path, \"/api/\")"
+		cache      = false
+	}
+`, id)
+}
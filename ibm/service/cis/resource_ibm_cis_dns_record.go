@@ -831,10 +831,10 @@ func ResourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	delOpt := sess.NewDeleteDnsRecordOptions(recordID)
-	result, response, err := sess.DeleteDnsRecord(delOpt)
+	_, response, err := sess.DeleteDnsRecord(delOpt)
 
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
-		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
+		log.Printf("Error deleting dns record %s: %s", recordID, response)
 		return err
 	}
 	d.SetId("")
@@ -4,12 +4,16 @@
 package cis
 
 import (
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,6 +26,15 @@ const (
 	cisDomainType                = "type"
 	cisDomainVerificationKey     = "verification_key"
 	cisDomainCnameSuffix         = "cname_suffix"
+	cisDomainVerificationRecords = "verification_records"
+	cisDomainRecordName          = "name"
+	cisDomainRecordType          = "type"
+	cisDomainRecordValue         = "value"
+	cisDomainWaitForActive       = "wait_for_active"
+	cisDomainTypePartial         = "partial"
+	cisDomainStatusActive        = "active"
+	cisDomainStatusPending       = "pending"
+	cisDomainStatusInitializing  = "initializing"
 	ibmCISDomain                 = "ibm_cis_domain"
 )
 
@@ -78,6 +91,40 @@ func ResourceIBMCISDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			cisDomainVerificationRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "DNS records to publish at the authoritative DNS provider of a partial domain, to verify the ownership and to route the traffic through CIS",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDomainRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the DNS record",
+						},
+						cisDomainRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the DNS record, TXT or CNAME",
+						},
+						cisDomainRecordValue: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the DNS record",
+						},
+					},
+				},
+			},
+			cisDomainWaitForActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until the domain is active, after the name servers or the verification records are published",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 		Create:   resourceCISdomainCreate,
 		Read:     resourceCISdomainRead,
@@ -109,6 +156,13 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
+
+	if d.Get(cisDomainWaitForActive).(bool) {
+		_, err = waitForCISDomainActive(d, cisClient, *result.Result.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceCISdomainRead(d, meta)
 }
 
@@ -138,13 +192,82 @@ func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(cisDomainOriginalNameServers, result.Result.OriginalNameServers)
 	d.Set(cisDomainType, result.Result.Type)
 
-	if cisDomainType == "partial" {
+	records := []map[string]interface{}{}
+	if result.Result.Type != nil && *result.Result.Type == cisDomainTypePartial {
 		d.Set(cisDomainVerificationKey, result.Result.VerificationKey)
 		d.Set(cisDomainCnameSuffix, result.Result.CnameSuffix)
+		hosts, err := listCISDomainProxiedHosts(meta, crn, zoneID)
+		if err != nil {
+			return err
+		}
+		records = flattenCISDomainVerificationRecords(result.Result.Name, result.Result.VerificationKey, result.Result.CnameSuffix, hosts)
 	}
+	d.Set(cisDomainVerificationRecords, records)
 
 	return nil
 }
+
+// listCISDomainProxiedHosts returns the names of the proxied DNS records of the domain, in the order
+// of the records
+func listCISDomainProxiedHosts(meta interface{}, crn, zoneID string) ([]string, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	hosts := []string{}
+	seen := map[string]bool{}
+	opt := sess.NewListAllDnsRecordsOptions()
+	opt.SetPerPage(1000)
+	for page := int64(1); ; page++ {
+		opt.SetPage(page)
+		result, resp, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			log.Printf("[WARN] Error listing the DNS records of zone %s: %v", zoneID, resp)
+			return nil, err
+		}
+		for _, record := range result.Result {
+			if record.Name == nil || record.Proxied == nil || !*record.Proxied || seen[*record.Name] {
+				continue
+			}
+			seen[*record.Name] = true
+			hosts = append(hosts, *record.Name)
+		}
+		if result.ResultInfo == nil || result.ResultInfo.TotalCount == nil || page*1000 >= *result.ResultInfo.TotalCount {
+			break
+		}
+	}
+	return hosts, nil
+}
+
+// flattenCISDomainVerificationRecords returns the records of a partial domain, the TXT record that
+// proves the ownership of the domain and a CNAME record per proxied host that routes the host
+// through CIS.
+func flattenCISDomainVerificationRecords(name, verificationKey, cnameSuffix *string, hosts []string) []map[string]interface{} {
+	records := []map[string]interface{}{}
+	if name == nil {
+		return records
+	}
+	if verificationKey != nil && *verificationKey != "" {
+		records = append(records, map[string]interface{}{
+			cisDomainRecordName:  fmt.Sprintf("cloudflare-verify.%s", *name),
+			cisDomainRecordType:  "TXT",
+			cisDomainRecordValue: *verificationKey,
+		})
+	}
+	if cnameSuffix != nil && *cnameSuffix != "" {
+		for _, host := range hosts {
+			records = append(records, map[string]interface{}{
+				cisDomainRecordName:  host,
+				cisDomainRecordType:  "CNAME",
+				cisDomainRecordValue: fmt.Sprintf("%s.%s", host, *cnameSuffix),
+			})
+		}
+	}
+	return records
+}
 func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
 	if err != nil {
@@ -172,9 +295,55 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 }
 
 func resourceCISdomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.Get(cisDomainWaitForActive).(bool) && d.Get(cisDomainStatus).(string) != cisDomainStatusActive {
+		cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+		if err != nil {
+			return err
+		}
+		zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+		if err != nil {
+			return err
+		}
+		cisClient.Crn = core.StringPtr(crn)
+		_, err = waitForCISDomainActive(d, cisClient, zoneID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceCISdomainRead(d, meta)
 }
 
+// waitForCISDomainActive requests an activation check of the domain, so that CIS looks up the
+// name servers or the verification records right away, and waits until the domain is active.
+func waitForCISDomainActive(d *schema.ResourceData, cisClient *zonesv1.ZonesV1, zoneID string, timeout time.Duration) (interface{}, error) {
+	checkOpt := cisClient.NewZoneActivationCheckOptions(zoneID)
+	_, resp, err := cisClient.ZoneActivationCheck(checkOpt)
+	if err != nil {
+		// The activation check is rate limited, the zone is checked periodically anyway
+		log.Printf("[WARN] Error requesting the activation check of zone %s: %s %v", zoneID, err, resp)
+	}
+
+	opt := cisClient.NewGetZoneOptions(zoneID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cisDomainStatusPending, cisDomainStatusInitializing},
+		Target:  []string{cisDomainStatusActive},
+		Refresh: func() (interface{}, string, error) {
+			result, resp, err := cisClient.GetZone(opt)
+			if err != nil {
+				log.Printf("[WARN] Error getting zone %v\n", resp)
+				return nil, "", err
+			}
+			return result, *result.Result.Status, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
 	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
 	if err != nil {
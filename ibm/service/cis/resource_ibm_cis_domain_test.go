@@ -56,6 +56,12 @@ func TestAccIBMCisPartialDomain_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "domain", testPartialDomain),
 					resource.TestCheckResourceAttr(name, "type", "partial"),
+					resource.TestCheckResourceAttrSet(name, "verification_key"),
+					// a new domain has no proxied hosts to route, only the TXT record is exported
+					resource.TestCheckResourceAttr(name, "verification_records.#", "1"),
+					resource.TestCheckResourceAttr(name, "verification_records.0.name", "cloudflare-verify."+testPartialDomain),
+					resource.TestCheckResourceAttr(name, "verification_records.0.type", "TXT"),
+					resource.TestCheckResourceAttrPair(name, "verification_records.0.value", name, "verification_key"),
 				),
 			},
 		},
@@ -0,0 +1,513 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISFirewallRuleSet      = "ibm_cis_firewall_rule_set"
+	cisFirewallRuleSetRules    = "rules"
+	cisFirewallRuleSetRulesIDs = "firewall_rule_ids"
+)
+
+// ResourceIBMCISFirewallRuleSet manages an ordered list of firewall rules and their filters of a domain.
+// The rules and filters are created and updated in bulk and the priority of each rule follows its
+// position in the list.
+func ResourceIBMCISFirewallRuleSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceIBMCISFirewallRuleSetCreate,
+		ReadContext:   ResourceIBMCISFirewallRuleSetRead,
+		UpdateContext: ResourceIBMCISFirewallRuleSetUpdate,
+		DeleteContext: ResourceIBMCISFirewallRuleSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCISFirewallRuleSetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISFirewallRuleSet,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisFirewallRuleSetRules: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Ordered list of firewall rules, the first rule has the highest priority",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisFilterExpression: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Filter expression of the firewall rule",
+						},
+						cisFirewallrulesAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator(ibmCISFirewallRuleSet, cisFirewallrulesAction),
+							Description:  "Firewall rule action",
+						},
+						cisFirewallrulesDescription: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Firewall rule description",
+						},
+						cisFirewallrulesPaused: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Firewall rule paused",
+						},
+						cisFirewallrulesPriority: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Firewall rule priority, the position of the rule in the list",
+						},
+						cisFirewallrulesID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Firewall rule ID",
+						},
+						cisFilterID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Filter ID of the firewall rule",
+						},
+					},
+				},
+			},
+			cisFirewallRuleSetRulesIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the firewall rules in priority order",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISFirewallRuleSetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisFirewallrulesAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "log, allow, challenge, js_challenge, block"})
+	ibmCISFirewallRuleSetResourceValidator := validate.ResourceValidator{ResourceName: ibmCISFirewallRuleSet, Schema: validateSchema}
+	return &ibmCISFirewallRuleSetResourceValidator
+}
+
+func ResourceIBMCISFirewallRuleSetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetCreate BluemixSession initialization failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "create")
+		return tfErr.GetDiag()
+	}
+	xAuthtoken := sess.Config.IAMAccessToken
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	rules := d.Get(cisFirewallRuleSetRules).([]interface{})
+
+	ruleIDs, err := createCISFirewallRuleSetRules(context, meta, xAuthtoken, crn, zoneID, rules, 0)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetCreate failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "create")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisFirewallRuleSetRulesIDs, ruleIDs)
+
+	return ResourceIBMCISFirewallRuleSetRead(context, d, meta)
+}
+
+func ResourceIBMCISFirewallRuleSetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetRead BluemixSession initialization failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "read")
+		return tfErr.GetDiag()
+	}
+	xAuthtoken := sess.Config.IAMAccessToken
+
+	cisClient, err := meta.(conns.ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetRead CisFirewallRulesSession initialization failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "read")
+		return tfErr.GetDiag()
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetRead ConvertTftoCisTwoVar failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "read")
+		return tfErr.GetDiag()
+	}
+
+	// all rules of the domain are listed in a single call instead of reading every rule of the set,
+	// the API has no paging parameters
+	opt := cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID)
+	result, resp, err := cisClient.ListAllFirewallRulesWithContext(context, opt)
+	if err != nil || result == nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetRead ListAllFirewallRulesWithContext failed with error: %s and response:\n%s", err, resp),
+			ibmCISFirewallRuleSet, "read")
+		return tfErr.GetDiag()
+	}
+	allRules := result.Result
+	found := map[string]int{}
+	for i, rule := range allRules {
+		if rule.ID != nil {
+			found[*rule.ID] = i
+		}
+	}
+
+	ids := flex.ExpandStringList(d.Get(cisFirewallRuleSetRulesIDs).([]interface{}))
+
+	ruleIDs := []string{}
+	rules := []map[string]interface{}{}
+	for _, id := range ids {
+		i, ok := found[id]
+		if !ok {
+			// rules deleted outside of terraform are recreated by the next apply
+			continue
+		}
+		rule := allRules[i]
+		r := map[string]interface{}{
+			cisFirewallrulesID:          id,
+			cisFirewallrulesAction:      flex.StringValue(rule.Action),
+			cisFirewallrulesDescription: rule.Description,
+			cisFirewallrulesPaused:      rule.Paused != nil && *rule.Paused,
+			cisFirewallrulesPriority:    len(rules) + 1,
+		}
+		if rule.Filter != nil {
+			r[cisFilterID] = flex.StringValue(rule.Filter.ID)
+			r[cisFilterExpression] = flex.StringValue(rule.Filter.Expression)
+		}
+		ruleIDs = append(ruleIDs, id)
+		rules = append(rules, r)
+	}
+	if len(rules) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisFirewallRuleSetRules, rules)
+	d.Set(cisFirewallRuleSetRulesIDs, ruleIDs)
+
+	return nil
+}
+
+// resourceIBMCISFirewallRuleSetImport takes the IDs of the rules of the set, in order and separated by
+// commas, in front of the domain ID and the CRN. The other rules of the domain are left alone.
+func resourceIBMCISFirewallRuleSetImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil || ids == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of firewallRuleIDs:domainID:crn, with the rule IDs separated by commas", d.Id())
+	}
+	ruleIDs := strings.Split(ids, ",")
+	for _, id := range ruleIDs {
+		if id == "" {
+			return nil, fmt.Errorf("[ERROR] Incorrect ID %s: empty firewall rule ID", d.Id())
+		}
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisFirewallRuleSetRulesIDs, ruleIDs)
+	return []*schema.ResourceData{d}, nil
+}
+
+func ResourceIBMCISFirewallRuleSetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetUpdate BluemixSession initialization failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "update")
+		return tfErr.GetDiag()
+	}
+	xAuthtoken := sess.Config.IAMAccessToken
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetUpdate ConvertTftoCisTwoVar failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "update")
+		return tfErr.GetDiag()
+	}
+
+	if d.HasChange(cisFirewallRuleSetRules) {
+		oldRules, newRules := d.GetChange(cisFirewallRuleSetRules)
+		existing := oldRules.([]interface{})
+		rules := newRules.([]interface{})
+
+		// the rules at the positions that exist before and after the change keep their IDs and are
+		// updated in place, the rules beyond are created or deleted
+		kept := len(existing)
+		if len(rules) < kept {
+			kept = len(rules)
+		}
+		ruleIDs := []string{}
+		if kept > 0 {
+			err = updateCISFirewallRuleSetRules(context, meta, xAuthtoken, crn, zoneID, existing[:kept], rules[:kept])
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err,
+					fmt.Sprintf("ResourceIBMCISFirewallRuleSetUpdate failed: %s", err.Error()),
+					ibmCISFirewallRuleSet, "update")
+				return tfErr.GetDiag()
+			}
+			for _, rule := range existing[:kept] {
+				ruleIDs = append(ruleIDs, rule.(map[string]interface{})[cisFirewallrulesID].(string))
+			}
+		}
+		if len(existing) > kept {
+			err = deleteCISFirewallRuleSetRules(context, meta, xAuthtoken, crn, zoneID, existing[kept:])
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err,
+					fmt.Sprintf("ResourceIBMCISFirewallRuleSetUpdate failed: %s", err.Error()),
+					ibmCISFirewallRuleSet, "update")
+				return tfErr.GetDiag()
+			}
+		}
+		if len(rules) > kept {
+			createdIDs, err := createCISFirewallRuleSetRules(context, meta, xAuthtoken, crn, zoneID, rules[kept:], kept)
+			if err != nil {
+				d.Set(cisFirewallRuleSetRulesIDs, ruleIDs)
+				tfErr := flex.TerraformErrorf(err,
+					fmt.Sprintf("ResourceIBMCISFirewallRuleSetUpdate failed: %s", err.Error()),
+					ibmCISFirewallRuleSet, "update")
+				return tfErr.GetDiag()
+			}
+			ruleIDs = append(ruleIDs, createdIDs...)
+		}
+		d.Set(cisFirewallRuleSetRulesIDs, ruleIDs)
+	}
+
+	return ResourceIBMCISFirewallRuleSetRead(context, d, meta)
+}
+
+func ResourceIBMCISFirewallRuleSetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetDelete BluemixSession initialization failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "delete")
+		return tfErr.GetDiag()
+	}
+	xAuthtoken := sess.Config.IAMAccessToken
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetDelete ConvertTftoCisTwoVar failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "delete")
+		return tfErr.GetDiag()
+	}
+
+	err = deleteCISFirewallRuleSetRules(context, meta, xAuthtoken, crn, zoneID, d.Get(cisFirewallRuleSetRules).([]interface{}))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("ResourceIBMCISFirewallRuleSetDelete failed: %s", err.Error()),
+			ibmCISFirewallRuleSet, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createCISFirewallRuleSetRules creates the filters of the rules in one call and the rules in a second
+// call. offset is the number of rules before the given rules, their priorities start after it.
+func createCISFirewallRuleSetRules(context context.Context, meta interface{}, xAuthtoken, crn, zoneID string, rules []interface{}, offset int) ([]string, error) {
+	filterClient, err := meta.(conns.ClientSession).CisFiltersSession()
+	if err != nil {
+		return nil, err
+	}
+	cisClient, err := meta.(conns.ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]filtersv1.FilterInput, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		expression := rule[cisFilterExpression].(string)
+		filters = append(filters, filtersv1.FilterInput{Expression: &expression})
+	}
+	filterOpt := filterClient.NewCreateFilterOptions(xAuthtoken, crn, zoneID)
+	filterOpt.SetFilterInput(filters)
+	filterResult, resp, err := filterClient.CreateFilterWithContext(context, filterOpt)
+	if err != nil || filterResult == nil {
+		return nil, fmt.Errorf("error creating the filters for zone %q: %s %s", zoneID, err, resp)
+	}
+	if len(filterResult.Result) != len(rules) {
+		return nil, fmt.Errorf("error creating the filters for zone %q: %d filters created for %d rules", zoneID, len(filterResult.Result), len(rules))
+	}
+
+	firewallRules := make([]firewallrulesv1.FirewallRuleInput, 0, len(rules))
+	for i, r := range rules {
+		rule := r.(map[string]interface{})
+		action := rule[cisFirewallrulesAction].(string)
+		paused := rule[cisFirewallrulesPaused].(bool)
+		priority := int64(offset + i + 1)
+		firewallRule := firewallrulesv1.FirewallRuleInput{
+			Action:   &action,
+			Filter:   &firewallrulesv1.FirewallRuleInputFilter{ID: filterResult.Result[i].ID},
+			Paused:   &paused,
+			Priority: &priority,
+		}
+		if description := rule[cisFirewallrulesDescription].(string); description != "" {
+			firewallRule.Description = &description
+		}
+		firewallRules = append(firewallRules, firewallRule)
+	}
+	opt := cisClient.NewCreateFirewallRulesOptions(xAuthtoken, crn, zoneID)
+	opt.SetFirewallRuleInput(firewallRules)
+	result, resp, err := cisClient.CreateFirewallRulesWithContext(context, opt)
+	if err != nil || result == nil {
+		// the filters are not referenced by any rule, remove them again
+		for _, filter := range filterResult.Result {
+			filterClient.DeleteFiltersWithContext(context, filterClient.NewDeleteFiltersOptions(xAuthtoken, crn, zoneID, *filter.ID))
+		}
+		return nil, fmt.Errorf("error creating the firewall rules for zone %q: %s %s", zoneID, err, resp)
+	}
+
+	ruleIDs := make([]string, 0, len(result.Result))
+	for _, rule := range result.Result {
+		ruleIDs = append(ruleIDs, *rule.ID)
+	}
+	return ruleIDs, nil
+}
+
+// updateCISFirewallRuleSetRules updates the filters and rules that changed between the existing and the
+// planned rules in one call each. The planned rules take the IDs of the existing rules at the same position.
+func updateCISFirewallRuleSetRules(context context.Context, meta interface{}, xAuthtoken, crn, zoneID string, existing, rules []interface{}) error {
+	filterClient, err := meta.(conns.ClientSession).CisFiltersSession()
+	if err != nil {
+		return err
+	}
+	cisClient, err := meta.(conns.ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return err
+	}
+
+	filters := []filtersv1.FilterUpdateInput{}
+	firewallRules := []firewallrulesv1.FirewallRulesUpdateInputItem{}
+	for i, r := range rules {
+		old := existing[i].(map[string]interface{})
+		rule := r.(map[string]interface{})
+		ruleID := old[cisFirewallrulesID].(string)
+		filterID := old[cisFilterID].(string)
+
+		if rule[cisFilterExpression].(string) != old[cisFilterExpression].(string) {
+			expression := rule[cisFilterExpression].(string)
+			filters = append(filters, filtersv1.FilterUpdateInput{ID: &filterID, Expression: &expression})
+		}
+		if rule[cisFirewallrulesAction] != old[cisFirewallrulesAction] ||
+			rule[cisFirewallrulesDescription] != old[cisFirewallrulesDescription] ||
+			rule[cisFirewallrulesPaused] != old[cisFirewallrulesPaused] ||
+			old[cisFirewallrulesPriority].(int) != i+1 {
+			action := rule[cisFirewallrulesAction].(string)
+			description := rule[cisFirewallrulesDescription].(string)
+			paused := rule[cisFirewallrulesPaused].(bool)
+			priority := int64(i + 1)
+			filter, _ := cisClient.NewFirewallRulesUpdateInputItemFilter(filterID)
+			firewallRules = append(firewallRules, firewallrulesv1.FirewallRulesUpdateInputItem{
+				ID:          &ruleID,
+				Action:      &action,
+				Description: &description,
+				Filter:      filter,
+				Paused:      &paused,
+				Priority:    &priority,
+			})
+		}
+	}
+
+	if len(filters) > 0 {
+		filterOpt := filterClient.NewUpdateFiltersOptions(xAuthtoken, crn, zoneID)
+		filterOpt.SetFilterUpdateInput(filters)
+		_, resp, err := filterClient.UpdateFiltersWithContext(context, filterOpt)
+		if err != nil {
+			return fmt.Errorf("error updating the filters for zone %q: %s %s", zoneID, err, resp)
+		}
+	}
+	if len(firewallRules) > 0 {
+		opt := cisClient.NewUpdateFirewllRulesOptions(xAuthtoken, crn, zoneID)
+		opt.SetFirewallRulesUpdateInputItem(firewallRules)
+		_, resp, err := cisClient.UpdateFirewllRulesWithContext(context, opt)
+		if err != nil {
+			return fmt.Errorf("error updating the firewall rules for zone %q: %s %s", zoneID, err, resp)
+		}
+	}
+	return nil
+}
+
+// deleteCISFirewallRuleSetRules deletes the given rules and then their filters, rules and filters that
+// are already gone are skipped.
+func deleteCISFirewallRuleSetRules(context context.Context, meta interface{}, xAuthtoken, crn, zoneID string, rules []interface{}) error {
+	filterClient, err := meta.(conns.ClientSession).CisFiltersSession()
+	if err != nil {
+		return err
+	}
+	cisClient, err := meta.(conns.ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		if id := rule[cisFirewallrulesID].(string); id != "" {
+			_, resp, err := cisClient.DeleteFirewallRulesWithContext(context, cisClient.NewDeleteFirewallRulesOptions(xAuthtoken, crn, zoneID, id))
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
+				return fmt.Errorf("error deleting the firewall rule %s: %s %s", id, err, resp)
+			}
+		}
+		if id := rule[cisFilterID].(string); id != "" {
+			_, resp, err := filterClient.DeleteFiltersWithContext(context, filterClient.NewDeleteFiltersOptions(xAuthtoken, crn, zoneID, id))
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
+				return fmt.Errorf("error deleting the filter %s: %s %s", id, err, resp)
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package cis_test
 
 import (
+	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -47,3 +48,49 @@ func testAccCheckCisFirewallrules_basic() string {
 	  }
 `
 }
+
+func TestAccIBMCisFirewallRuleSet_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisFirewallRuleSet_basic(`"allow"`, `"block"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.0.action", "allow"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.0.priority", "1"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.1.action", "block"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.1.priority", "2"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "firewall_rule_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckCisFirewallRuleSet_basic(`"challenge"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "rules.0.action", "challenge"),
+					resource.TestCheckResourceAttr("ibm_cis_firewall_rule_set.rules", "firewall_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckCisFirewallRuleSet_basic(actions ...string) string {
+	rules := ""
+	for i, action := range actions {
+		rules += fmt.Sprintf(`
+		rules {
+			expression  = "(ip.src eq 156.25.53.%d)"
+			action      = %s
+			description = "Firewall-rule-set-%d"
+		}`, 180+i, action, i)
+	}
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_firewall_rule_set" "rules" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		%s
+	}
+`, rules)
+}
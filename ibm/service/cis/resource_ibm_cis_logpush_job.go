@@ -6,14 +6,22 @@ package cis
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/networking-go-sdk/logpushjobsapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,6 +43,9 @@ const (
 	cisLogpushLastComplete          = "last_complete"
 	cisLogpushLastError             = "last_error"
 	cisLogpushErrorMessage          = "error_message"
+
+	// CIS writes the ownership challenge file into the bucket after the challenge is requested
+	cisLogpushOwnershipChallengeTimeout = 5 * time.Minute
 )
 
 func ResourceIBMCISLogPushJob() *schema.Resource {
@@ -77,7 +88,6 @@ func ResourceIBMCISLogPushJob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				RequiredWith:  []string{cisLogpushCosOwnershipChallenge},
 				ConflictsWith: []string{cisLogdna, cisLogPushIbmCl, cisLogpushDestConf},
 				StateFunc: func(v interface{}) string {
 					json, err := flex.NormalizeJSONString(v)
@@ -91,7 +101,8 @@ func ResourceIBMCISLogPushJob() *schema.Resource {
 			cisLogpushCosOwnershipChallenge: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "Ownership challenge token to prove destination ownership.",
+				Computed:     true,
+				Description:  "Ownership challenge token to prove destination ownership. Fetched from the COS bucket when not set.",
 				Sensitive:    true,
 				RequiredWith: []string{cisLogPushCos},
 			},
@@ -228,6 +239,13 @@ func ResourceIBMCISLogpushJobCreate(d *schema.ResourceData, meta interface{}) er
 		var logCos map[string]interface{}
 		json.Unmarshal([]byte(cos.(string)), &logCos)
 		logpushJob.Cos = logCos
+		if _, ok := d.GetOk(cisLogpushCosOwnershipChallenge); !ok {
+			ownChallenge, err := getCISLogpushCosOwnershipChallenge(sess, meta, logCos)
+			if err != nil {
+				return err
+			}
+			d.Set(cisLogpushCosOwnershipChallenge, ownChallenge)
+		}
 	}
 	if d, ok := d.GetOk(cisLogpushDataset); ok {
 		dataset := d.(string)
@@ -353,6 +371,14 @@ func ResourceIBMCISLogpushJobUpdate(d *schema.ResourceData, meta interface{}) er
 			var logCos map[string]interface{}
 			json.Unmarshal([]byte(cos.(string)), &logCos)
 			updateLogpushJob.Cos = logCos
+			// a new bucket needs a new challenge unless one is configured with it
+			if d.HasChange(cisLogPushCos) && !d.HasChange(cisLogpushCosOwnershipChallenge) {
+				ownChallenge, err := getCISLogpushCosOwnershipChallenge(sess, meta, logCos)
+				if err != nil {
+					return err
+				}
+				d.Set(cisLogpushCosOwnershipChallenge, ownChallenge)
+			}
 		}
 		if f, ok := d.GetOk(cisLogpushCosOwnershipChallenge); ok {
 			ownChallenge := f.(string)
@@ -435,3 +461,80 @@ func extractLogPushUpdateIbmClValues(cisLogPushIbmClList []interface{}) *logpush
 
 	return &logPushIbmclReq
 }
+
+// getCISLogpushCosOwnershipChallenge requests an ownership challenge for the COS bucket and reads the
+// challenge token from the file that CIS writes into the bucket.
+func getCISLogpushCosOwnershipChallenge(sess *logpushjobsapiv1.LogpushJobsApiV1, meta interface{}, logCos map[string]interface{}) (string, error) {
+	bucket, _ := logCos["bucket_name"].(string)
+	region, _ := logCos["region"].(string)
+	instanceID, _ := logCos["id"].(string)
+	if bucket == "" || region == "" || instanceID == "" {
+		return "", flex.FmtErrorf("[ERROR] The cos bucket_name, id and region are required to get the ownership challenge")
+	}
+
+	opt := sess.NewGetLogpushOwnershipV2Options()
+	opt.SetCos(logCos)
+	result, response, err := sess.GetLogpushOwnershipV2(opt)
+	if err != nil || result == nil || result.Result == nil || result.Result.Filename == nil {
+		return "", flex.FmtErrorf("[ERROR] Error requesting the ownership challenge for bucket %s: %v %s", bucket, err, response)
+	}
+	fileName := *result.Result.Filename
+
+	s3Client, err := getCISLogpushCosClient(meta, region, instanceID)
+	if err != nil {
+		return "", err
+	}
+	var ownChallenge string
+	err = resource.Retry(cisLogpushOwnershipChallengeTimeout, func() *resource.RetryError {
+		object, err := s3Client.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(fileName),
+		})
+		if err != nil {
+			return resource.RetryableError(fmt.Errorf("ownership challenge file %s not found in bucket %s: %s", fileName, bucket, err))
+		}
+		defer object.Body.Close()
+		body, err := io.ReadAll(object.Body)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		ownChallenge = strings.TrimSpace(string(body))
+		return nil
+	})
+	if err != nil {
+		return "", flex.FmtErrorf("[ERROR] Error reading the ownership challenge: %s", err)
+	}
+
+	// the challenge file is only needed to create the job
+	_, err = s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(fileName),
+	})
+	if err != nil {
+		log.Printf("[WARN] Error deleting the ownership challenge file %s from bucket %s: %s", fileName, bucket, err)
+	}
+
+	return ownChallenge, nil
+}
+
+// getCISLogpushCosClient returns a COS client for the public endpoint of the region that authenticates
+// with the credentials of the provider.
+func getCISLogpushCosClient(meta interface{}, region, instanceID string) (*s3.S3, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+	apiEndpoint := conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region))
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey == "" {
+		return nil, flex.FmtErrorf("[ERROR] An IBM Cloud API key is required to read the ownership challenge, set ownership_challenge instead")
+	}
+	s3Conf := aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceID)).WithS3ForcePathStyle(true)
+	s3Sess := session.Must(session.NewSession())
+	return s3.New(s3Sess, s3Conf), nil
+}
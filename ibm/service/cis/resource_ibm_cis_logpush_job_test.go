@@ -4,6 +4,7 @@
 package cis_test
 
 import (
+	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -55,3 +56,38 @@ func testAccCheckCisLogpushJobs_basic() string {
 	}
 `
 }
+
+func TestAccIBMCisLogpushJobs_Cos(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisLogpushJobs_cos(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cis_logpush_job.test", "dataset", "http_requests"),
+					resource.TestCheckResourceAttrSet("ibm_cis_logpush_job.test", "cos"),
+					resource.TestCheckResourceAttrSet("ibm_cis_logpush_job.test", "ownership_challenge"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckCisLogpushJobs_cos() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	  resource "ibm_cis_logpush_job" "test" {
+		cis_id          = data.ibm_cis.cis.id
+		domain_id       = data.ibm_cis_domain.cis_domain.domain_id
+		name            = "MylogpushJobCos"
+		enabled         = false
+		logpull_options = "timestamps=rfc3339&timestamps=rfc3339"
+		dataset         = "http_requests"
+		frequency       = "low"
+		cos = jsonencode({
+			bucket_name = "%s"
+			id          = "%s"
+			region      = "us-south"
+		})
+	}
+`, acc.BucketName, acc.CosCRN)
+}
@@ -6,6 +6,8 @@ package cis
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -20,6 +22,10 @@ const (
 	cisMtlsCert         = "certificate"
 	cisMtlsHostNames    = "associated_hostnames"
 	cisMtlsCertExpireOn = "expires_on"
+
+	cisMtlsRotationStrategy                   = "rotation_strategy"
+	cisMtlsRotationStrategyCreateBeforeDelete = "create_before_delete"
+	cisMtlsRotationStrategyDeleteBeforeCreate = "delete_before_create"
 )
 
 func ResourceIBMCISMtls() *schema.Resource {
@@ -54,6 +60,14 @@ func ResourceIBMCISMtls() *schema.Resource {
 				Description: "Certificate contents",
 				Sensitive:   true,
 			},
+			cisMtlsRotationStrategy: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     cisMtlsRotationStrategyCreateBeforeDelete,
+				Description: "How the certificate is replaced when its contents change",
+				ValidateFunc: validate.InvokeValidator("ibm_cis_mtls",
+					cisMtlsRotationStrategy),
+			},
 			cisMtlsCertName: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -98,6 +112,13 @@ func ResourceIBMCISMtlsValidator() *validate.ResourceValidator {
 			CloudDataType:              "resource_instance",
 			CloudDataRange:             []string{"service:internet-svcs"},
 			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisMtlsRotationStrategy,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              cisMtlsRotationStrategyCreateBeforeDelete + ", " + cisMtlsRotationStrategyDeleteBeforeCreate})
 	ibmCISMtlsValidator := validate.ResourceValidator{
 		ResourceName: "ibm_cis_mtls",
 		Schema:       validateSchema}
@@ -194,7 +215,22 @@ func resourceIBMCISMtlsUpdate(context context.Context, d *schema.ResourceData, m
 
 	certID, zoneID, _, _ := flex.ConvertTfToCisThreeVar(d.Id())
 
-	if d.HasChange(cisMtlsCertName) ||
+	var diags diag.Diagnostics
+	if d.HasChange(cisMtlsCert) {
+		// the contents of a certificate can not be updated, it is replaced by a new certificate
+		// with the planned name and hostnames
+		var newCertID string
+		newCertID, diags, err = rotateCISMtlsCertificate(d, meta, zoneID, certID)
+		if newCertID != "" {
+			d.SetId(flex.ConvertCisToTfThreeVar(newCertID, zoneID, crn))
+		}
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err,
+				fmt.Sprintf("resourceIBMCISMtlsUpdate certificate rotation failed: %s", err.Error()),
+				"ibm_cis_mtls", "update")
+			return tfErr.GetDiag()
+		}
+	} else if d.HasChange(cisMtlsCertName) ||
 		d.HasChange(cisMtlsHostNames) {
 
 		updateOption := sess.NewUpdateAccessCertificateOptions(zoneID, certID)
@@ -216,7 +252,7 @@ func resourceIBMCISMtlsUpdate(context context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	return resourceIBMCISMtlsRead(context, d, meta)
+	return append(diags, resourceIBMCISMtlsRead(context, d, meta)...)
 }
 
 func resourceIBMCISMtlsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -245,3 +281,141 @@ func resourceIBMCISMtlsDelete(context context.Context, d *schema.ResourceData, m
 	return nil
 
 }
+
+// rotateCISMtlsCertificate replaces the certificate with the given ID by a new certificate with the
+// planned contents, name and hostnames and returns the ID of the new certificate. A failure to delete
+// the old certificate once the new one serves the hostnames is returned as a warning.
+//
+// With create_before_delete the new certificate is created without hostnames, the hostnames are moved
+// from the old to the new certificate and the old certificate is deleted once the new certificate serves
+// them. With delete_before_create the old certificate is deleted first, which is required when the
+// certificate limit of the zone is reached.
+func rotateCISMtlsCertificate(d *schema.ResourceData, meta interface{}, zoneID, oldCertID string) (string, diag.Diagnostics, error) {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		return "", nil, err
+	}
+	sess.Crn = core.StringPtr(d.Get(cisID).(string))
+
+	name := d.Get(cisMtlsCertName).(string)
+	hostnames := flex.ExpandStringList(d.Get(cisMtlsHostNames).([]interface{}))
+
+	deleteOldCert := func() error {
+		_, resp, err := sess.DeleteAccessCertificate(sess.NewDeleteAccessCertificateOptions(zoneID, oldCertID))
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("deleting the certificate %s failed: %s \nResponse: %v", oldCertID, err, resp)
+		}
+		return nil
+	}
+
+	if d.Get(cisMtlsRotationStrategy).(string) == cisMtlsRotationStrategyDeleteBeforeCreate {
+		if err := deleteOldCert(); err != nil {
+			return "", nil, err
+		}
+		options := sess.NewCreateAccessCertificateOptions(zoneID)
+		options.SetName(name)
+		options.SetCertificate(d.Get(cisMtlsCert).(string))
+		options.SetAssociatedHostnames(hostnames)
+		result, resp, err := sess.CreateAccessCertificate(options)
+		if err != nil || result == nil {
+			return "", nil, fmt.Errorf("creating the new certificate failed: %v \nResponse: %v", err, resp)
+		}
+		return *result.Result.ID, nil, nil
+	}
+
+	options := sess.NewCreateAccessCertificateOptions(zoneID)
+	options.SetName(name)
+	options.SetCertificate(d.Get(cisMtlsCert).(string))
+	options.SetAssociatedHostnames([]string{})
+	result, resp, err := sess.CreateAccessCertificate(options)
+	if err != nil || result == nil {
+		return "", nil, fmt.Errorf("creating the new certificate failed: %v \nResponse: %v", err, resp)
+	}
+	newCertID := *result.Result.ID
+
+	oldHostnames, _ := d.GetChange(cisMtlsHostNames)
+	hostnamesMoved := false
+
+	// the old certificate stays in use when the rotation fails: the new certificate is removed again,
+	// which releases the hostnames it was given, and the old certificate gets its hostnames back. If the
+	// new certificate can not be removed it is returned, so that the resource follows it.
+	abort := func(err error) (string, diag.Diagnostics, error) {
+		_, resp, delErr := sess.DeleteAccessCertificate(sess.NewDeleteAccessCertificateOptions(zoneID, newCertID))
+		if delErr != nil && (resp == nil || resp.StatusCode != 404) {
+			log.Printf("[WARN] Deleting the new certificate %s failed: %s \nResponse: %v", newCertID, delErr, resp)
+			if hostnamesMoved {
+				return newCertID, nil, fmt.Errorf("%s, the new certificate %s is kept", err, newCertID)
+			}
+			return "", nil, err
+		}
+		if hostnamesMoved {
+			restore := sess.NewUpdateAccessCertificateOptions(zoneID, oldCertID)
+			restore.SetAssociatedHostnames(flex.ExpandStringList(oldHostnames.([]interface{})))
+			if _, restoreResp, restoreErr := sess.UpdateAccessCertificate(restore); restoreErr != nil {
+				return "", nil, fmt.Errorf("%s, restoring the hostnames of certificate %s failed: %s \nResponse: %v", err, oldCertID, restoreErr, restoreResp)
+			}
+		}
+		return "", nil, err
+	}
+
+	associate := sess.NewUpdateAccessCertificateOptions(zoneID, newCertID)
+	associate.SetName(name)
+	associate.SetAssociatedHostnames(hostnames)
+	_, resp, err = sess.UpdateAccessCertificate(associate)
+	if err != nil {
+		// a hostname can be associated with one certificate only, release the hostnames of the old
+		// certificate and associate them again
+		log.Printf("[DEBUG] Associating the hostnames with certificate %s failed, releasing them from certificate %s: %s", newCertID, oldCertID, err)
+		release := sess.NewUpdateAccessCertificateOptions(zoneID, oldCertID)
+		release.SetAssociatedHostnames([]string{})
+		_, resp, err = sess.UpdateAccessCertificate(release)
+		if err != nil {
+			return abort(fmt.Errorf("releasing the hostnames of certificate %s failed: %s \nResponse: %v", oldCertID, err, resp))
+		}
+		hostnamesMoved = true
+		_, resp, err = sess.UpdateAccessCertificate(associate)
+		if err != nil {
+			return abort(fmt.Errorf("associating the hostnames with the new certificate %s failed: %s \nResponse: %v", newCertID, err, resp))
+		}
+	}
+	hostnamesMoved = true
+
+	// only delete the old certificate once the new certificate serves the hostnames
+	getResult, resp, err := sess.GetAccessCertificate(sess.NewGetAccessCertificateOptions(zoneID, newCertID))
+	if err != nil || getResult == nil {
+		return abort(fmt.Errorf("reading the new certificate %s failed, the old certificate %s is kept: %v \nResponse: %v", newCertID, oldCertID, err, resp))
+	}
+	if !equalCISMtlsHostnames(getResult.Result.AssociatedHostnames, hostnames) {
+		return abort(fmt.Errorf("the new certificate %s serves %v instead of %v, the old certificate %s is kept", newCertID, getResult.Result.AssociatedHostnames, hostnames, oldCertID))
+	}
+
+	if err := deleteOldCert(); err != nil {
+		// the new certificate serves the hostnames, the old certificate is left for manual cleanup
+		return newCertID, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The certificate %s was rotated to %s but the old certificate was not deleted, delete it manually", oldCertID, newCertID),
+			Detail:   err.Error(),
+		}}, nil
+	}
+	return newCertID, nil, nil
+}
+
+// equalCISMtlsHostnames reports whether both lists hold the same hostnames, in any order
+func equalCISMtlsHostnames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, hostname := range a {
+		count[strings.ToLower(hostname)]++
+	}
+	for _, hostname := range b {
+		count[strings.ToLower(hostname)]--
+	}
+	for _, c := range count {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/mtlsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISMtlsHostnameSettings                   = "ibm_cis_mtls_hostname_settings"
+	cisMtlsHostnameSettings                      = "settings"
+	cisMtlsHostnameSettingsHostname              = "hostname"
+	cisMtlsHostnameSettingsCertificateForwarding = "client_certificate_forwarding"
+	cisMtlsHostnameSettingsChinaNetwork          = "china_network"
+)
+
+// ResourceIBMCISMtlsHostnameSettings manages the mTLS settings of the given hostnames of a domain. The
+// hostnames of the domain that are not listed keep their settings.
+func ResourceIBMCISMtlsHostnameSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISMtlsHostnameSettingsUpdate,
+		ReadContext:   resourceIBMCISMtlsHostnameSettingsRead,
+		UpdateContext: resourceIBMCISMtlsHostnameSettingsUpdate,
+		DeleteContext: resourceIBMCISMtlsHostnameSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISMtlsHostnameSettings,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisMtlsHostnameSettings: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "mTLS settings of the hostnames",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisMtlsHostnameSettingsHostname: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Hostname of the domain",
+						},
+						cisMtlsHostnameSettingsCertificateForwarding: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the client certificate is forwarded to the origin in the Cf-Client-Cert-Der-Base64 header",
+						},
+						cisMtlsHostnameSettingsChinaNetwork: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the hostname is served by the China network",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISMtlsHostnameSettingsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISMtlsHostnameSettingsValidator := validate.ResourceValidator{
+		ResourceName: ibmCISMtlsHostnameSettings,
+		Schema:       validateSchema}
+	return &ibmCISMtlsHostnameSettingsValidator
+}
+
+func resourceIBMCISMtlsHostnameSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsUpdate CisMtlsSession initialization failed: %s", err.Error()),
+			ibmCISMtlsHostnameSettings, "update")
+		return tfErr.GetDiag()
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+
+	settings := []mtlsv1.AccessCertSettingsInputArray{}
+	for _, s := range d.Get(cisMtlsHostnameSettings).([]interface{}) {
+		setting := s.(map[string]interface{})
+		settings = append(settings, mtlsv1.AccessCertSettingsInputArray{
+			Hostname:                    core.StringPtr(setting[cisMtlsHostnameSettingsHostname].(string)),
+			ClientCertificateForwarding: core.BoolPtr(setting[cisMtlsHostnameSettingsCertificateForwarding].(bool)),
+		})
+	}
+	// hostnames removed from the configuration get the default settings again
+	if d.HasChange(cisMtlsHostnameSettings) && !d.IsNewResource() {
+		oldSettings, _ := d.GetChange(cisMtlsHostnameSettings)
+		configured := map[string]bool{}
+		for _, setting := range settings {
+			configured[*setting.Hostname] = true
+		}
+		for _, s := range oldSettings.([]interface{}) {
+			hostname := s.(map[string]interface{})[cisMtlsHostnameSettingsHostname].(string)
+			if !configured[hostname] {
+				settings = append(settings, mtlsv1.AccessCertSettingsInputArray{
+					Hostname:                    core.StringPtr(hostname),
+					ClientCertificateForwarding: core.BoolPtr(false),
+				})
+			}
+		}
+	}
+
+	opt := sess.NewUpdateAccessCertSettingsOptions(zoneID)
+	opt.SetSettings(settings)
+	_, resp, err := sess.UpdateAccessCertSettingsWithContext(context, opt)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsUpdate UpdateAccessCertSettings failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISMtlsHostnameSettings, "update")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return resourceIBMCISMtlsHostnameSettingsRead(context, d, meta)
+}
+
+func resourceIBMCISMtlsHostnameSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsRead CisMtlsSession initialization failed: %s", err.Error()),
+			ibmCISMtlsHostnameSettings, "read")
+		return tfErr.GetDiag()
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsRead ConvertTftoCisTwoVar failed: %s", err.Error()),
+			ibmCISMtlsHostnameSettings, "read")
+		return tfErr.GetDiag()
+	}
+	sess.Crn = core.StringPtr(crn)
+
+	result, resp, err := sess.GetAccessCertSettingsWithContext(context, sess.NewGetAccessCertSettingsOptions(zoneID))
+	if err != nil || result == nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsRead GetAccessCertSettings failed: %v \nResponse: %v", err, resp),
+			ibmCISMtlsHostnameSettings, "read")
+		return tfErr.GetDiag()
+	}
+	found := map[string]map[string]interface{}{}
+	for _, setting := range result.Result {
+		if setting.Hostname == nil {
+			continue
+		}
+		found[*setting.Hostname] = map[string]interface{}{
+			cisMtlsHostnameSettingsHostname:              *setting.Hostname,
+			cisMtlsHostnameSettingsCertificateForwarding: setting.ClientCertificateForwarding != nil && *setting.ClientCertificateForwarding,
+			cisMtlsHostnameSettingsChinaNetwork:          setting.ChinaNetwork != nil && *setting.ChinaNetwork,
+		}
+	}
+
+	// the configured hostnames are kept in their order, on import all hostnames of the domain are read
+	settings := []map[string]interface{}{}
+	configured, ok := d.GetOk(cisMtlsHostnameSettings)
+	if ok {
+		for _, s := range configured.([]interface{}) {
+			hostname := s.(map[string]interface{})[cisMtlsHostnameSettingsHostname].(string)
+			if setting, ok := found[hostname]; ok {
+				settings = append(settings, setting)
+			}
+		}
+	} else {
+		for _, setting := range result.Result {
+			if setting.Hostname != nil {
+				settings = append(settings, found[*setting.Hostname])
+			}
+		}
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisMtlsHostnameSettings, settings)
+	return nil
+}
+
+func resourceIBMCISMtlsHostnameSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsDelete CisMtlsSession initialization failed: %s", err.Error()),
+			ibmCISMtlsHostnameSettings, "delete")
+		return tfErr.GetDiag()
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsDelete ConvertTftoCisTwoVar failed: %s", err.Error()),
+			ibmCISMtlsHostnameSettings, "delete")
+		return tfErr.GetDiag()
+	}
+	sess.Crn = core.StringPtr(crn)
+
+	// the settings of a hostname can not be deleted, they are reset to the defaults
+	settings := []mtlsv1.AccessCertSettingsInputArray{}
+	for _, s := range d.Get(cisMtlsHostnameSettings).([]interface{}) {
+		settings = append(settings, mtlsv1.AccessCertSettingsInputArray{
+			Hostname:                    core.StringPtr(s.(map[string]interface{})[cisMtlsHostnameSettingsHostname].(string)),
+			ClientCertificateForwarding: core.BoolPtr(false),
+		})
+	}
+	opt := sess.NewUpdateAccessCertSettingsOptions(zoneID)
+	opt.SetSettings(settings)
+	_, resp, err := sess.UpdateAccessCertSettingsWithContext(context, opt)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err,
+			fmt.Sprintf("resourceIBMCISMtlsHostnameSettingsDelete UpdateAccessCertSettings failed: %s \nResponse: %v", err.Error(), resp),
+			ibmCISMtlsHostnameSettings, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
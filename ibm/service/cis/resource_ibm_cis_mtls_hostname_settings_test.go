@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisMtlsHostnameSettings_Basic(t *testing.T) {
+	name := "ibm_cis_mtls_hostname_settings." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisMtlsHostnameSettingsBasic1("test", acc.CisDomainStatic, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.#", "1"),
+					resource.TestCheckResourceAttr(name, "settings.0.hostname", acc.CisDomainStatic),
+					resource.TestCheckResourceAttr(name, "settings.0.client_certificate_forwarding", "true"),
+				),
+			},
+			{
+				Config: testAccCheckCisMtlsHostnameSettingsBasic1("test", acc.CisDomainStatic, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.client_certificate_forwarding", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func testAccCheckCisMtlsHostnameSettingsBasic1(id string, CisDomainStatic string, forwarding bool) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_mtls_hostname_settings" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		settings {
+			hostname                      = "%[2]s"
+			client_certificate_forwarding = %[3]t
+		}
+	  }
+`, id, CisDomainStatic, forwarding)
+}
@@ -10,6 +10,7 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMCisMtls_Basic(t *testing.T) {
@@ -42,3 +43,73 @@ func testAccCheckCisMtlsBasic1(id string, CisDomainStatic string) string {
 	  }
 `, id)
 }
+
+const testAccCisMtlsRotatedCert = "-----BEGIN CERTIFICATE-----\nMIID2zCCAsOgAwIBAgIUDnaP5cDdj8xUzWVbudTC7IAJW/MwDQYJKoZIhvcNAQEL\nBQAwfTELMAkGA1UEBhMCaW4xEjAQBgNVBAgMCWthcm5hdGFrYTESMBAGA1UEBwwJ\nYmFuZ2Fsb3JlMQwwCgYDVQQKDANpYm0xDDAKBgNVBAsMA2NpczEqMCgGA1UEAwwh\nbXRsczguYXVzdGVzdC0xMC5jaXN0ZXN0LWxvYWQuY29tMB4XDTI2MTAxNjEzMjky\nNVoXDTM2MTAxMzEzMjkyNVowfTELMAkGA1UEBhMCaW4xEjAQBgNVBAgMCWthcm5h\ndGFrYTESMBAGA1UEBwwJYmFuZ2Fsb3JlMQwwCgYDVQQKDANpYm0xDDAKBgNVBAsM\nA2NpczEqMCgGA1UEAwwhbXRsczguYXVzdGVzdC0xMC5jaXN0ZXN0LWxvYWQuY29t\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAsqYWVPtt2Ff6uhMV463j\nj0lg5eNa7Nk0543l13W18lFi5HE6wxq4Y1uQv+7cqlfU4Fh0jg99ia63ca63nCln\nIlqMoqgI50DpQQccHZeLiG5FKzaYT//duRLw/VVk61XI7I7e3HE2ZBLZ1sV4hy12\nSepGpxg1et+sZYvdAHy7wKvNT/bgIxvRFuOON0gF4b5fONOdflBtpkObhj/V+5Pp\nSdMF/qw/wZhA0T77sNpReOZvalSRSqddTFkHvZYuhmgZlykmlOc0CJe4lHLYRwJ8\ndHf1DOzaHXWo5Wu2irglDhsK0ystLd92gOz9zCk2wl0AE1x7WuyIiNPtKjM7Uf68\n4QIDAQABo1MwUTAdBgNVHQ4EFgQULrMHow/Zu2PwxqnsG/mXa6uuIFYwHwYDVR0j\nBBgwFoAULrMHow/Zu2PwxqnsG/mXa6uuIFYwDwYDVR0TAQH/BAUwAwEB/zANBgkq\nhkiG9w0BAQsFAAOCAQEAIFa1tidJjKeKQq2IMZqOBZDm9edU/hUJz1wHlv95nnoR\nCgDBUF/UmF3ThKZ9kZ6r3eXMkDvOqB5cQ6RcRVpzxWcUjUBDnDFywzvZgp/ZXkv+\nHyOlT8K69Dj+T2spQEZudOhBET6NER+IL8aXptMtE16Rm+XexMcSOuVA9SreiIis\nnbbj8RoTigd9xgL6h3IXkBQaFu3tblc/UXgZkyzmhwHTNtVN+I5rxbsAB3QMU4/c\nqadyiZ2WB2MkjBuELXVTPurFYf7gbmhQpiumBi8oa7CXEU/IbmrjDkrzrXZLczrd\nOeNAphMACGBd6QjhgFNNIXDwivXuPwXO6TKtNzhGFg==\n-----END CERTIFICATE-----\n"
+
+func TestAccIBMCisMtls_Rotation(t *testing.T) {
+	name := "ibm_cis_mtls." + "test"
+	var certID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisMtlsBasic1("test", acc.CisDomainStatic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCisMtlsCertID(name, &certID, false),
+				),
+			},
+			{
+				// the certificate is replaced by a new certificate before the old one is deleted
+				Config: testAccCheckCisMtlsRotation("test", testAccCisMtlsRotatedCert, "create_before_delete"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCisMtlsCertID(name, &certID, true),
+					resource.TestCheckResourceAttr(name, "certificate", testAccCisMtlsRotatedCert),
+					resource.TestCheckResourceAttr(name, "name", "MTLS-Cert"),
+				),
+			},
+			{
+				// the old certificate is deleted before the new certificate is created
+				Config: testAccCheckCisMtlsRotation("test", "-----BEGIN CERTIFICATE-----\nMIIEFzCCAv+gAwIBAgIJAMhhsP5Ubtu2MA0GCSqGSIb3DQEBCwUAMIGhMQswCQYD\nVQQGEwJpbjESMBAGA1UECAwJa2FybmF0YWthMRIwEAYDVQQHDAliYW5nYWxvcmUx\nDDAKBgNVBAoMA2libTEMMAoGA1UECwwDY2lzMSowKAYDVQQDDCFtdGxzNy5hdXN0\nZXN0LTEwLmNpc3Rlc3QtbG9hZC5jb20xIjAgBgkqhkiG9w0BCQEWE2RhcnVueWEu\nZC5jQGlibS5jb20wHhcNMjIwNDIyMTEwMzU3WhcNMzIwNDE5MTEwMzU3WjCBoTEL\nMAkGA1UEBhMCaW4xEjAQBgNVBAgMCWthcm5hdGFrYTESMBAGA1UEBwwJYmFuZ2Fs\nb3JlMQwwCgYDVQQKDANpYm0xDDAKBgNVBAsMA2NpczEqMCgGA1UEAwwhbXRsczcu\nYXVzdGVzdC0xMC5jaXN0ZXN0LWxvYWQuY29tMSIwIAYJKoZIhvcNAQkBFhNkYXJ1\nbnlhLmQuY0BpYm0uY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA\n3tjgNpucsvwNFPNWl1DXkWGFLzvdMKDdk3PTAJ3AAYFG4jLVDtZurf3qCLZ8fcz+\nnukYdDKhRZYSP9QvGwDTS4mHOTV/6FAYsb7qfke+V8+v0okmCca07KgTUKFR5F9e\nw1NPYW9yRjoVpy/Kgs983WigDBRQeo50wcLYG7APml0ceqsBKZaXOiTVrf2xDSvd\nNn6Qchgd5dmxiP+drypt7BGIf9j8QlN5HvEETfUQQybwJfq9G6KhNKIKcw+IKGIy\nbI03RmItC+eVhwja/t1UldlXt/L3JduwEkq9QNQe080toAZyaQ/9Vymk80DTrffN\njb1YG224XLlflSSdzbUC0QIDAQABo1AwTjAdBgNVHQ4EFgQUs5QUMLmjPfNutr8U\n2zcjT/yH1pYwHwYDVR0jBBgwFoAUs5QUMLmjPfNutr8U2zcjT/yH1pYwDAYDVR0T\nBAUwAwEB/zANBgkqhkiG9w0BAQsFAAOCAQEAPCqm4rXm0ptf0iSp+u4X60A3U3ON\ntSpKq5BU1KGF0i5/ZB1ia1we2ORdOzeoNIhoffmRCg/a//Ba5fLRhktzXMcT/zwC\nDVxH9OAtFoj6/rfEko6s+NP/WtWMd7YF1w4wVvK189YWSUDKbE4MijeDLvEfBi3T\nStNu14p4gN8hkSLX/3Rn9ZmI2wDIpqsYRF5KPfvNZ0iIpvJoBWjS6bbVYGd3yNs+\nrXez+Q36oEFfMcM35EEt3qo2EGu4mljqZxhIae5Hy4sKe4c6s0AfpYA4wTQ97cAg\nQ0Sdw3p+PIqPMOcY1sjRLbvPDHGbzc60LvKhHgt/7Cc5ntvxIjJ9ZUt5Ng==\n-----END CERTIFICATE-----\n", "delete_before_create"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCisMtlsCertID(name, &certID, true),
+					resource.TestCheckResourceAttr(name, "rotation_strategy", "delete_before_create"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCisMtlsCertID records the certificate ID of the resource, with changed it also checks that
+// the ID differs from the one recorded before
+func testAccCheckCisMtlsCertID(n string, certID *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		id := rs.Primary.Attributes["mtls_id"]
+		if id == "" {
+			return fmt.Errorf("No certificate ID is set")
+		}
+		if changed && id == *certID {
+			return fmt.Errorf("The certificate %s was not rotated", id)
+		}
+		*certID = id
+		return nil
+	}
+}
+
+func testAccCheckCisMtlsRotation(id, certificate, rotationStrategy string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_mtls" "%[1]s" {
+		cis_id                    = data.ibm_cis.cis.id
+		domain_id                 = data.ibm_cis_domain.cis_domain.domain_id
+		certificate               = "%[2]s"
+		name                      = "MTLS-Cert"
+		associated_hostnames      = ""
+		rotation_strategy         = "%[3]s"
+	  }
+`, id, certificate, rotationStrategy)
+}
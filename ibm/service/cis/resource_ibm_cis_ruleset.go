@@ -294,7 +294,7 @@ func ResourceIBMCISRuleset() *schema.Resource {
 		Read:     ResourceIBMCISRulesetRead,
 		Update:   ResourceIBMCISRulesetUpdate,
 		Delete:   ResourceIBMCISRulesetDelete,
-		Create:   ResourceIBMCISRulesetRead,
+		Create:   ResourceIBMCISRulesetCreate,
 		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -341,11 +341,15 @@ func ResourceIBMCISRulesetValidator() *validate.ResourceValidator {
 }
 
 func ResourceIBMCISRulesetCreate(d *schema.ResourceData, meta interface{}) error {
-	// check if it is a new resource, if true then return error that user need to import it first
-	if d.IsNewResource() {
-		return flex.FmtErrorf("[ERROR] You can not create a new resource. Please import the resource first. Check documentation for import usage")
+	// rulesets can not be created, the resource takes over the existing ruleset with the given ID
+	if d.Get(CISRulesetsId).(string) == "" {
+		return flex.FmtErrorf("[ERROR] You can not create a new ruleset. Please provide the ruleset_id of an existing ruleset or import the resource. Check documentation for import usage")
 	}
-	return nil
+	if len(d.Get(CISRulesetsObjectOutput).([]interface{})) == 0 {
+		return flex.FmtErrorf("[ERROR] The rulesets block is required to update the ruleset %s", d.Get(CISRulesetsId).(string))
+	}
+	d.SetId(dataSourceCISRulesetsCheckID(d))
+	return ResourceIBMCISRulesetUpdate(d, meta)
 }
 
 func ResourceIBMCISRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -375,7 +379,7 @@ func ResourceIBMCISRulesetUpdate(d *schema.ResourceData, meta interface{}) error
 		_, resp, err := sess.UpdateZoneRuleset(opt)
 
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error while updating the zone Ruleset %s", resp)
+			return flex.FmtErrorf("[ERROR] Error while updating the zone Ruleset %s %s", err, resp)
 		}
 
 		d.SetId(dataSourceCISRulesetsCheckID(d))
@@ -394,7 +398,7 @@ func ResourceIBMCISRulesetUpdate(d *schema.ResourceData, meta interface{}) error
 		_, _, err := sess.UpdateInstanceRuleset(opt)
 
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error while updating the zone Ruleset %s", err)
+			return flex.FmtErrorf("[ERROR] Error while updating the instance Ruleset %s", err)
 		}
 
 		d.SetId(dataSourceCISRulesetsCheckID(d))
@@ -440,7 +444,7 @@ func ResourceIBMCISRulesetRead(d *schema.ResourceData, meta interface{}) error {
 
 		rulesetObj := flattenCISRulesets(*result.Result)
 
-		d.Set(CISRulesetsListOutput, rulesetObj)
+		d.Set(CISRulesetsObjectOutput, rulesetObj)
 		d.Set(CISRulesetsId, rulesetId)
 		d.Set(cisID, crn)
 		d.SetId(dataSourceCISRulesetsCheckID(d))
@@ -576,12 +580,8 @@ func expandCISRulesetsRulesActionParameters(obj interface{}) rulesetsv1.ActionPa
 	if version != "" {
 		actionParameterRespObj.Version = &version
 	}
-	ruleListInterface := actionParameterObj[CISRulesetList].([]interface{})
-
-	ruleList := make([]string, 0)
-	for i, v := range ruleListInterface {
-		ruleList[i] = fmt.Sprint(v)
-	}
+	// skip rules list the rulesets, phases or products that are skipped for the matching requests
+	ruleList := flex.ExpandStringList(actionParameterObj[CISRulesetList].([]interface{}))
 	actionParameterRespObj.Rulesets = ruleList
 
 	ruleset := actionParameterObj[CISRuleset].(string)
@@ -121,7 +121,7 @@ func ResourceIBMCISRulesetEntryPointVersionUpdate(d *schema.ResourceData, meta i
 
 		opt := sess.NewUpdateZoneEntrypointRulesetOptions(ruleset_phase)
 
-		cis_ruleset_object := d.Get(CISRulesetsObjectOutput)
+		cis_ruleset_object := d.Get(CISRulesetsEntryPointOutput)
 
 		rulesetsObject := cis_ruleset_object.(*schema.Set).List()[0].(map[string]interface{})
 		opt.SetDescription(rulesetsObject[CISRulesetsDescription].(string))
@@ -138,7 +138,7 @@ func ResourceIBMCISRulesetEntryPointVersionUpdate(d *schema.ResourceData, meta i
 	} else {
 		opt := sess.NewUpdateInstanceEntrypointRulesetOptions(ruleset_phase)
 
-		rulesetsObject := d.Get(CISRulesetsObjectOutput).([]interface{})[0].(map[string]interface{})
+		rulesetsObject := d.Get(CISRulesetsEntryPointOutput).(*schema.Set).List()[0].(map[string]interface{})
 		opt.SetDescription(rulesetsObject[CISRulesetsDescription].(string))
 		opt.SetName(rulesetsObject[CISRulesetsName].(string))
 
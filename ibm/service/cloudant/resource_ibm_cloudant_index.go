@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantIndexCreate,
+		ReadContext:   resourceIBMCloudantIndexRead,
+		DeleteContext: resourceIBMCloudantIndexDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database that is indexed.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the index.",
+			},
+			"ddoc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The name of the design document, without the `_design/` prefix, that holds the index. Generated by the server when not set.",
+			},
+			"fields": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The fields to index, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the field.",
+						},
+						"order": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "asc",
+							ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+							Description:  "The sort order of the field, `asc` or `desc`. All fields of an index must use the same order.",
+						},
+					},
+				},
+			},
+			"partial_filter_selector": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "A JSON selector that limits the index to the documents that match it.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Whether the index is partitioned. Only supported on partitioned databases.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantIndexCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "create", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "create", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	fields := []cloudantv1.IndexField{}
+	for _, f := range d.Get("fields").([]interface{}) {
+		fieldMap := f.(map[string]interface{})
+		field := cloudantv1.IndexField{}
+		field.SetProperty(fieldMap["name"].(string), flex.PtrToString(fieldMap["order"].(string)))
+		fields = append(fields, field)
+	}
+
+	indexDefinition := &cloudantv1.IndexDefinition{
+		Fields: fields,
+	}
+	if selector, ok := d.GetOk("partial_filter_selector"); ok {
+		selectorMap := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(selector.(string)), &selectorMap); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "create", "parse-partial-filter-selector")
+			return tfErr.GetDiag()
+		}
+		indexDefinition.PartialFilterSelector = selectorMap
+	}
+
+	dbName := d.Get("db").(string)
+	postIndexOptions := cloudantClient.NewPostIndexOptions(dbName, indexDefinition)
+	postIndexOptions.SetName(d.Get("name").(string))
+	postIndexOptions.SetType("json")
+	if ddoc, ok := d.GetOk("ddoc"); ok {
+		postIndexOptions.SetDdoc(ddoc.(string))
+	}
+	if partitioned, ok := d.GetOkExists("partitioned"); ok {
+		postIndexOptions.SetPartitioned(partitioned.(bool))
+	}
+
+	indexResult, response, err := cloudantClient.PostIndexWithContext(context, postIndexOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PostIndexWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_index", "create", "post-index")
+		return tfErr.GetDiag()
+	}
+
+	ddoc := strings.TrimPrefix(*indexResult.ID, "_design/")
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", instanceCRN, dbName, ddoc, *indexResult.Name))
+
+	return resourceIBMCloudantIndexRead(context, d, meta)
+}
+
+func resourceIBMCloudantIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "id-parts")
+		return tfErr.GetDiag()
+	}
+	if len(parts) < 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceCRN/db/ddoc/name", d.Id())
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "id-parts")
+		return tfErr.GetDiag()
+	}
+
+	instanceCRN := strings.Join(parts[:len(parts)-3], "/")
+	dbName, ddoc, name := parts[len(parts)-3], parts[len(parts)-2], parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	getIndexesInformationOptions := cloudantClient.NewGetIndexesInformationOptions(dbName)
+
+	indexesInformation, response, err := cloudantClient.GetIndexesInformationWithContext(context, getIndexesInformationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetIndexesInformationWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_index", "read", "get-indexes-information")
+		return tfErr.GetDiag()
+	}
+
+	var index *cloudantv1.IndexInformation
+	for i, indexInformation := range indexesInformation.Indexes {
+		if indexInformation.Ddoc != nil && *indexInformation.Ddoc == "_design/"+ddoc && indexInformation.Name != nil && *indexInformation.Name == name {
+			index = &indexesInformation.Indexes[i]
+			break
+		}
+	}
+	if index == nil {
+		log.Printf("[WARN] Removing cloudant index (%s) from state because it is not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+	d.Set("ddoc", ddoc)
+	d.Set("name", name)
+
+	if index.Def != nil {
+		if err = d.Set("fields", flattenCloudantIndexFields(index.Def.Fields)); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "set-fields-property")
+			return tfErr.GetDiag()
+		}
+		if index.Def.PartialFilterSelector != nil {
+			selector, err := json.Marshal(index.Def.PartialFilterSelector)
+			if err != nil {
+				tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "marshal-partial-filter-selector")
+				return tfErr.GetDiag()
+			}
+			if err = d.Set("partial_filter_selector", string(selector)); err != nil {
+				tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "set-partial-filter-selector-property")
+				return tfErr.GetDiag()
+			}
+		}
+	}
+	if err = d.Set("partitioned", index.Partitioned != nil && *index.Partitioned); err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "read", "set-partitioned-property")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantIndexDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "delete", "id-parts")
+		return tfErr.GetDiag()
+	}
+	if len(parts) < 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceCRN/db/ddoc/name", d.Id())
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "delete", "id-parts")
+		return tfErr.GetDiag()
+	}
+
+	instanceCRN := strings.Join(parts[:len(parts)-3], "/")
+	dbName, ddoc, name := parts[len(parts)-3], parts[len(parts)-2], parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "delete", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_index", "delete", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	deleteIndexOptions := cloudantClient.NewDeleteIndexOptions(dbName, ddoc, "json", name)
+
+	_, response, err := cloudantClient.DeleteIndexWithContext(context, deleteIndexOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteIndexWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_index", "delete", "delete-index")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// flattenCloudantIndexFields converts the `{"<field>": "<order>"}` entries of a JSON index definition
+func flattenCloudantIndexFields(fields []cloudantv1.IndexField) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, field := range fields {
+		for name, order := range field.GetProperties() {
+			fieldMap := map[string]interface{}{
+				"name":  name,
+				"order": "asc",
+			}
+			if order != nil {
+				fieldMap["order"] = *order
+			}
+			result = append(result, fieldMap)
+		}
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantIndexBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantIndexConfig(instanceName, db),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "name", "by-type-and-date"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "ddoc", "orders"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.1.order", "desc"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_index.cloudant_index",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantIndexConfig(instanceName, db string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db           = "%s"
+		}
+
+		resource "ibm_cloudant_index" "cloudant_index" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db           = ibm_cloudant_database.cloudant_database.db
+			ddoc         = "orders"
+			name         = "by-type-and-date"
+			fields {
+				name  = "type"
+				order = "desc"
+			}
+			fields {
+				name  = "date"
+				order = "desc"
+			}
+			partial_filter_selector = jsonencode({ status = { "$ne" = "archived" } })
+		}
+	`, instanceName, db)
+}
@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantReplicationCreate,
+		ReadContext:   resourceIBMCloudantReplicationRead,
+		DeleteContext: resourceIBMCloudantReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"doc_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the replication document in the `_replicator` database.",
+			},
+			"source_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the source database, such as `https://<account>.cloudant.com/<db>`.",
+			},
+			"source_iam_api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key that is used to authenticate to the source database.",
+			},
+			"target_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the target database, such as `https://<account>.cloudant.com/<db>`.",
+			},
+			"target_iam_api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key that is used to authenticate to the target database.",
+			},
+			"continuous": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the replication keeps running and replicates new changes, or stops after a one-shot run.",
+			},
+			"create_target": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to create the target database if it does not exist.",
+			},
+			"selector": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "A JSON selector that filters the documents that are replicated.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the replication job, such as `running`, `completed` or `failed`.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "create", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "create", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	source, err := expandCloudantReplicationDatabase(cloudantClient, d.Get("source_url").(string), d.Get("source_iam_api_key").(string))
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "create", "expand-source")
+		return tfErr.GetDiag()
+	}
+	target, err := expandCloudantReplicationDatabase(cloudantClient, d.Get("target_url").(string), d.Get("target_iam_api_key").(string))
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "create", "expand-target")
+		return tfErr.GetDiag()
+	}
+
+	replicationDocument := &cloudantv1.ReplicationDocument{
+		Source:       source,
+		Target:       target,
+		Continuous:   flex.PtrToBool(d.Get("continuous").(bool)),
+		CreateTarget: flex.PtrToBool(d.Get("create_target").(bool)),
+	}
+	if selector, ok := d.GetOk("selector"); ok {
+		selectorMap := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(selector.(string)), &selectorMap); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "create", "parse-selector")
+			return tfErr.GetDiag()
+		}
+		replicationDocument.Selector = selectorMap
+	}
+
+	docID := d.Get("doc_id").(string)
+	putReplicationDocumentOptions := cloudantClient.NewPutReplicationDocumentOptions(docID, replicationDocument)
+
+	_, response, err := cloudantClient.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutReplicationDocumentWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_replication", "create", "put-replication-document")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, docID))
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "id-parts")
+		return tfErr.GetDiag()
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(docID)
+
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReplicationDocumentWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_replication", "read", "get-replication-document")
+		return tfErr.GetDiag()
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("doc_id", docID)
+
+	if replicationDocument.Source != nil && replicationDocument.Source.URL != nil {
+		if err = d.Set("source_url", *replicationDocument.Source.URL); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "set-source-url-property")
+			return tfErr.GetDiag()
+		}
+	}
+	if replicationDocument.Target != nil && replicationDocument.Target.URL != nil {
+		if err = d.Set("target_url", *replicationDocument.Target.URL); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "set-target-url-property")
+			return tfErr.GetDiag()
+		}
+	}
+	if err = d.Set("continuous", replicationDocument.Continuous != nil && *replicationDocument.Continuous); err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "set-continuous-property")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("create_target", replicationDocument.CreateTarget != nil && *replicationDocument.CreateTarget); err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "set-create-target-property")
+		return tfErr.GetDiag()
+	}
+	if replicationDocument.Selector != nil {
+		selector, err := json.Marshal(replicationDocument.Selector)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "marshal-selector")
+			return tfErr.GetDiag()
+		}
+		if err = d.Set("selector", string(selector)); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "read", "set-selector-property")
+			return tfErr.GetDiag()
+		}
+	}
+
+	// The scheduler only knows about a replication once the replicator picked it up
+	getSchedulerDocumentOptions := cloudantClient.NewGetSchedulerDocumentOptions(docID)
+	schedulerDocument, response, err := cloudantClient.GetSchedulerDocumentWithContext(context, getSchedulerDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSchedulerDocumentWithContext failed %s\n%s", err, response)
+	} else if schedulerDocument.State != nil {
+		d.Set("state", *schedulerDocument.State)
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "delete", "id-parts")
+		return tfErr.GetDiag()
+	}
+
+	instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "delete", "get-instance-url")
+		return tfErr.GetDiag()
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_replication", "delete", "get-client")
+		return tfErr.GetDiag()
+	}
+
+	// The replicator updates the document while it runs, so the latest revision is needed to delete it
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(docID)
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReplicationDocumentWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_replication", "delete", "get-replication-document")
+		return tfErr.GetDiag()
+	}
+
+	deleteReplicationDocumentOptions := cloudantClient.NewDeleteReplicationDocumentOptions(docID)
+	deleteReplicationDocumentOptions.SetRev(*replicationDocument.Rev)
+
+	_, response, err = cloudantClient.DeleteReplicationDocumentWithContext(context, deleteReplicationDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteReplicationDocumentWithContext failed %s\n%s", err, response)
+		tfErr := flex.DiscriminatedTerraformErrorf(err, response.String(), "ibm_cloudant_replication", "delete", "delete-replication-document")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCloudantReplicationDatabase(cloudantClient *cloudantv1.CloudantV1, url string, iamAPIKey string) (*cloudantv1.ReplicationDatabase, error) {
+	database, err := cloudantClient.NewReplicationDatabase(url)
+	if err != nil {
+		return nil, err
+	}
+	if iamAPIKey != "" {
+		iam, err := cloudantClient.NewReplicationDatabaseAuthIam(iamAPIKey)
+		if err != nil {
+			return nil, err
+		}
+		database.Auth = &cloudantv1.ReplicationDatabaseAuth{
+			Iam: iam,
+		}
+	}
+	return database, nil
+}
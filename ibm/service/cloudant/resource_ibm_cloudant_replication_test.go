@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantReplicationBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	docID := fmt.Sprintf("tf_replication_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantReplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantReplicationConfig(instanceName, docID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "doc_id", docID),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "continuous", "false"),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "create_target", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_replication.cloudant_replication", "selector"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cloudant_replication.cloudant_replication",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_iam_api_key", "target_iam_api_key", "state"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantReplicationConfig(instanceName, docID string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db           = "tf_replication_source"
+		}
+
+		resource "ibm_resource_key" "cloudant_key" {
+			name                 = "tf_replication_key"
+			role                 = "Manager"
+			resource_instance_id = ibm_cloudant.cloudant_instance.id
+		}
+
+		resource "ibm_cloudant_replication" "cloudant_replication" {
+			instance_crn       = ibm_cloudant.cloudant_instance.crn
+			doc_id             = "%s"
+			source_url         = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/${ibm_cloudant_database.cloudant_database.db}"
+			source_iam_api_key = ibm_resource_key.cloudant_key.credentials["apikey"]
+			target_url         = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/tf_replication_target"
+			target_iam_api_key = ibm_resource_key.cloudant_key.credentials["apikey"]
+			create_target      = true
+			selector           = jsonencode({ type = "order" })
+		}
+	`, instanceName, docID)
+}
+
+func testAccCheckIBMCloudantReplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_replication" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, "/")
+		instanceCRN, docID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			// the instance is gone together with its replications
+			continue
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(docID)
+
+		_, _, err = cloudantClient.GetReplicationDocument(getReplicationDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_replication still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -31,6 +31,19 @@ func DataSourceIBMDatabaseBackups() *schema.Resource {
 					"ibm_database_backups",
 					"deployment_id"),
 			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return backups of this type.",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_database_backups",
+					"type"),
+			},
+			"is_restorable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return backups that can be used to restore an instance when true, or that cannot when false.",
+			},
 			"backups": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -94,7 +107,13 @@ func DataSourceIBMDatabaseBackupsValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Optional:                   true,
 			CloudDataType:              "cloud-database",
-			CloudDataRange:             []string{"resolved_to:id"}})
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "scheduled, on_demand"})
 
 	iBMDatabaseBackupsValidator := validate.ResourceValidator{ResourceName: "ibm_database_backups", Schema: validateSchema}
 	return &iBMDatabaseBackupsValidator
@@ -134,7 +153,7 @@ func DataSourceIBMDatabaseBackupsRead(context context.Context, d *schema.Resourc
 	} else {
 		matchBackups = backups.Backups
 	}
-	backups.Backups = matchBackups
+	backups.Backups = filterDatabaseBackups(d, matchBackups)
 
 	if suppliedFilter {
 		if len(backups.Backups) == 0 {
@@ -165,6 +184,29 @@ func DataSourceIBMDatabaseBackupsRead(context context.Context, d *schema.Resourc
 	return nil
 }
 
+func filterDatabaseBackups(d *schema.ResourceData, backups []clouddatabasesv5.Backup) []clouddatabasesv5.Backup {
+	backupType, typeOk := d.GetOk("type")
+	// GetOkExists so that is_restorable = false filters the backups that cannot be restored
+	restorable, restorableOk := d.GetOkExists("is_restorable")
+
+	if !typeOk && !restorableOk {
+		return backups
+	}
+
+	var filtered []clouddatabasesv5.Backup
+	for _, backup := range backups {
+		if typeOk && (backup.Type == nil || *backup.Type != backupType.(string)) {
+			continue
+		}
+		if restorableOk && (backup.IsRestorable == nil || *backup.IsRestorable != restorable.(bool)) {
+			continue
+		}
+		filtered = append(filtered, backup)
+	}
+
+	return filtered
+}
+
 // DataSourceIBMDatabaseBackupsID returns a reasonable ID for the list.
 func DataSourceIBMDatabaseBackupsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
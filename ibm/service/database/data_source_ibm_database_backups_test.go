@@ -34,3 +34,29 @@ func testAccCheckIBMDatabaseBackupsDataSourceConfigBasic() string {
 		}
 	`, acc.IcdDbDeploymentId)
 }
+
+func TestAccIBMDatabaseBackupsDataSourceFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseBackupsDataSourceConfigFilter(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_database_backups.database_backups", "backups.0.type", "scheduled"),
+					resource.TestCheckResourceAttr("data.ibm_database_backups.database_backups", "backups.0.is_restorable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupsDataSourceConfigFilter() string {
+	return fmt.Sprintf(`
+		data "ibm_database_backups" "database_backups" {
+			deployment_id = "%[1]s"
+			type          = "scheduled"
+			is_restorable = true
+		}
+	`, acc.IcdDbDeploymentId)
+}
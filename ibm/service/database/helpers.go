@@ -27,6 +27,11 @@ type TaskManager struct {
 	InstanceID string
 }
 
+// Allows mocking
+type DeploymentRemotesFetcher interface {
+	ListRemotes(opts *clouddatabasesv5.ListRemotesOptions) (*clouddatabasesv5.ListRemotesResponse, *core.DetailedResponse, error)
+}
+
 func (t *TimeoutHelper) isMoreThan24Hours(duration time.Duration) bool {
 	return duration > 24*time.Hour
 }
@@ -80,3 +85,21 @@ func (tm *TaskManager) matchingTaskInProgress(taskType string) (bool, *clouddata
 
 	return false, nil, nil
 }
+
+// A read replica has a leader, a replica promoted outside of Terraform no longer has one
+func hasRemoteLeader(client DeploymentRemotesFetcher, instanceID string) (bool, error) {
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: core.StringPtr(instanceID),
+	}
+
+	remotes, response, err := client.ListRemotes(listRemotesOptions)
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error getting the leader of read replica: %s\n%s", err, response)
+	}
+
+	if remotes.Remotes == nil || remotes.Remotes.Leader == nil || *remotes.Remotes.Leader == "" {
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -268,3 +268,77 @@ func TestMatchingTaskInProgress(t *testing.T) {
 		})
 	}
 }
+
+type MockRemotesClient struct {
+	Remotes *clouddatabasesv5.Remotes
+	Err     error
+}
+
+func (m *MockRemotesClient) ListRemotes(opts *clouddatabasesv5.ListRemotesOptions) (*clouddatabasesv5.ListRemotesResponse, *core.DetailedResponse, error) {
+	if m.Err != nil {
+		return nil, nil, m.Err
+	}
+	return &clouddatabasesv5.ListRemotesResponse{
+		Remotes: m.Remotes,
+	}, &core.DetailedResponse{}, nil
+}
+
+func TestHasRemoteLeader(t *testing.T) {
+	testcases := []struct {
+		description       string
+		mockRemotes       *clouddatabasesv5.Remotes
+		mockError         error
+		expectedHasLeader bool
+		expectError       bool
+	}{
+		{
+			description: "When the deployment has a leader, Expect true",
+			mockRemotes: &clouddatabasesv5.Remotes{
+				Leader:   core.StringPtr("crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc:leader::"),
+				Replicas: []string{},
+			},
+			expectedHasLeader: true,
+		},
+		{
+			description: "When the leader is empty, Expect false",
+			mockRemotes: &clouddatabasesv5.Remotes{
+				Leader: core.StringPtr(""),
+			},
+			expectedHasLeader: false,
+		},
+		{
+			description: "When the leader is not set, Expect false",
+			mockRemotes: &clouddatabasesv5.Remotes{
+				Replicas: []string{"crn:v1:bluemix:public:databases-for-postgresql:us-east:a/abc:replica::"},
+			},
+			expectedHasLeader: false,
+		},
+		{
+			description:       "When there are no remotes, Expect false",
+			expectedHasLeader: false,
+		},
+		{
+			description: "When there is an error getting remotes, Expect error",
+			mockError:   fmt.Errorf("API error"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			mockClient := &MockRemotesClient{
+				Remotes: tc.mockRemotes,
+				Err:     tc.mockError,
+			}
+
+			hasLeader, err := hasRemoteLeader(mockClient, "inst-1")
+
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedHasLeader, hasLeader)
+			}
+		})
+	}
+}
@@ -150,6 +150,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			validateUsersDiff,
 			validateRemoteLeaderIDDiff,
 			validateVersionDiff,
+			validatePITRDiff,
+			validatePrivateEndpointsDiff,
 		),
 
 		Importer: &schema.ResourceImporter{},
@@ -359,6 +361,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"private_endpoints_only": {
+				Description: "Fail the plan unless the deployment only uses private service endpoints and restricts access with an allowlist",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"allowlist": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -1113,6 +1121,16 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		if len(invalidFields) != 0 {
 			return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
 		}
+
+		// The configuration schema is only known once the deployment exists
+		if schemaJSON, ok := diff.GetOk("configuration_schema"); ok {
+			configSchema, err := expandConfigurationSchema(schemaJSON.(string))
+			if err != nil {
+				log.Printf("[WARN] Unable to parse the database configuration schema: %s", err)
+			} else if err = validateConfigurationValues(rawConfig, configSchema); err != nil {
+				return err
+			}
+		}
 	}
 
 	_, offlineRestoreOk := diff.GetOk("offline_restore")
@@ -1747,7 +1765,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	}
 	users = append(users, user)
 
-	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" {
+	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" || serviceOff == "databases-for-mysql" {
 		configSchema, err := icdClient.Configurations().GetConfiguration(icdId)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", icdId, err))
@@ -2192,7 +2210,20 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if d.HasChange("remote_leader_id") {
 		remoteLeaderId := d.Get("remote_leader_id").(string)
 
+		// A replica promoted outside of Terraform no longer has a leader, there is nothing left to promote
+		isReplica := true
 		if remoteLeaderId == "" {
+			hasLeader, err := hasRemoteLeader(cloudDatabasesClient, instanceID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !hasLeader {
+				log.Printf("[INFO] Database (%s) has no leader, it is already a standalone deployment", instanceID)
+				isReplica = false
+			}
+		}
+
+		if remoteLeaderId == "" && isReplica {
 			skipInitialBackup := false
 			if skip, ok := d.GetOk("skip_initial_backup"); ok {
 				skipInitialBackup = skip.(bool)
@@ -3102,20 +3133,7 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 				return err
 			}
 
-			// TODO: Use Capability API
-			// RBAC roles supported for Redis 6.0 and above
-			if (service == "databases-for-redis") && !(version > 0 && version < 6) {
-				err = change.New.ValidateRBACRole()
-			} else if service == "databases-for-mongodb" && change.New.Type == "ops_manager" {
-				err = change.New.ValidateOpsManagerRole()
-			} else {
-				if change.New.Role != nil {
-					if *change.New.Role != "" {
-						err = errors.New("role is not supported for this deployment or user type")
-						err = &databaseUserValidationError{user: change.New, errs: []error{err}}
-					}
-				}
-			}
+			err = change.New.ValidateRole(service, version)
 
 			if err != nil {
 				return err
@@ -3210,6 +3228,41 @@ func validateVersionDiff(_ context.Context, diff *schema.ResourceDiff, meta inte
 	return validateUpgradeVersion(instanceID, location, oldVersionStr, newVersionStr, skipBackup, meta)
 }
 
+func validatePITRDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	// Point in time recovery only applies when the instance is created
+	if diff.Id() != "" {
+		return nil
+	}
+
+	pitrTime, ok := diff.GetOk("point_in_time_recovery_time")
+	if !ok {
+		return nil
+	}
+
+	if !diff.NewValueKnown("point_in_time_recovery_time") || !diff.NewValueKnown("point_in_time_recovery_deployment_id") {
+		return nil
+	}
+
+	deploymentID := diff.Get("point_in_time_recovery_deployment_id").(string)
+
+	return validatePITRTime(deploymentID, pitrTime.(string), meta)
+}
+
+func validatePrivateEndpointsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if !diff.Get("private_endpoints_only").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("service_endpoints") || !diff.NewValueKnown("allowlist") {
+		return nil
+	}
+
+	serviceEndpoints := diff.Get("service_endpoints").(string)
+	allowlist := flex.ExpandAllowlist(diff.Get("allowlist").(*schema.Set))
+
+	return validatePrivateEndpointEnforcement(serviceEndpoints, allowlist)
+}
+
 func (c *userChange) isDelete() bool {
 	return c.Old != nil && c.New == nil
 }
@@ -3406,6 +3459,29 @@ func (u *DatabaseUser) ValidatePassword() (err error) {
 	return &databaseUserValidationError{user: u, errs: errs}
 }
 
+// ValidateRole checks the user role against the roles the deployment supports. Cloud Databases
+// only accepts a role for Redis 6.0 and above (ACL categories) and for MongoDB ops_manager users,
+// grants on individual databases or keyspaces cannot be set through the API.
+func (u *DatabaseUser) ValidateRole(service string, version int) (err error) {
+	// TODO: Use Capability API
+	// RBAC roles supported for Redis 6.0 and above
+	if (service == "databases-for-redis") && !(version > 0 && version < 6) {
+		return u.ValidateRBACRole()
+	}
+
+	if service == "databases-for-mongodb" && u.Type == "ops_manager" {
+		return u.ValidateOpsManagerRole()
+	}
+
+	if u.Role == nil || *u.Role == "" {
+		return
+	}
+
+	err = fmt.Errorf("role is not supported for %s users of %s, roles are only supported for ops_manager users of databases-for-mongodb and database users of databases-for-redis 6.0 and above. Per-database grants must be managed inside the database", u.Type, service)
+
+	return &databaseUserValidationError{user: u, errs: []error{err}}
+}
+
 func (u *DatabaseUser) ValidateRBACRole() (err error) {
 	var errs []error
 
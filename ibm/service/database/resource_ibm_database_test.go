@@ -200,6 +200,86 @@ func TestValidateRBACRole(t *testing.T) {
 	}
 }
 
+func TestValidateRole(t *testing.T) {
+	testcases := []struct {
+		service       string
+		version       int
+		user          DatabaseUser
+		expectedError string
+	}{
+		{
+			service: "databases-for-redis",
+			version: 0,
+			user: DatabaseUser{
+				Username: "redis_acl",
+				Type:     "database",
+				Role:     core.StringPtr("-@all +@read"),
+			},
+			expectedError: "",
+		},
+		{
+			service: "databases-for-mongodb",
+			version: 0,
+			user: DatabaseUser{
+				Username: "ops_manager_role",
+				Type:     "ops_manager",
+				Role:     core.StringPtr("group_read_only"),
+			},
+			expectedError: "",
+		},
+		{
+			service: "databases-for-mongodb",
+			version: 0,
+			user: DatabaseUser{
+				Username: "ops_manager_invalid",
+				Type:     "ops_manager",
+				Role:     core.StringPtr("readWrite"),
+			},
+			expectedError: "database user (ops_manager_invalid) validation error:\nrole must be a valid ops_manager role: group_read_only,group_data_access_admin",
+		},
+		{
+			service: "databases-for-postgresql",
+			version: 0,
+			user: DatabaseUser{
+				Username: "no_role",
+				Type:     "database",
+			},
+			expectedError: "",
+		},
+		{
+			service: "databases-for-postgresql",
+			version: 0,
+			user: DatabaseUser{
+				Username: "pg_grant",
+				Type:     "database",
+				Role:     core.StringPtr("SELECT ON mydb"),
+			},
+			expectedError: "database user (pg_grant) validation error:\nrole is not supported for database users of databases-for-postgresql, roles are only supported for ops_manager users of databases-for-mongodb and database users of databases-for-redis 6.0 and above. Per-database grants must be managed inside the database",
+		},
+		{
+			service: "databases-for-redis",
+			version: 5,
+			user: DatabaseUser{
+				Username: "old_redis",
+				Type:     "database",
+				Role:     core.StringPtr("+@all"),
+			},
+			expectedError: "database user (old_redis) validation error:\nrole is not supported for database users of databases-for-redis, roles are only supported for ops_manager users of databases-for-mongodb and database users of databases-for-redis 6.0 and above. Per-database grants must be managed inside the database",
+		},
+	}
+	for _, tc := range testcases {
+		err := tc.user.ValidateRole(tc.service, tc.version)
+
+		var errMsg string
+
+		if err != nil {
+			errMsg = err.Error()
+		}
+
+		assert.Equal(t, tc.expectedError, errMsg)
+	}
+}
+
 func TestPublicServiceEndpointsWarning(t *testing.T) {
 	diags := publicServiceEndpointsWarning()
 	warningNote := "IBM recommends using private endpoints only to improve security by restricting access to your database to the IBM Cloud private network. For more information, please refer to our security best practices, https://cloud.ibm.com/docs/cloud-databases?topic=cloud-databases-manage-security-compliance."
@@ -4,9 +4,14 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 )
 
@@ -142,3 +147,211 @@ func validateUpgradeVersion(instanceId string, location string, oldVersion strin
 }
 
 /* VERSION VALIDATOR END */
+
+/* POINT IN TIME RECOVERY VALIDATOR */
+
+var fetchEarliestPITRTimeFn = fetchEarliestPITRTime
+
+func fetchEarliestPITRTime(deploymentId string, meta interface{}) (string, error) {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return "", err
+	}
+
+	getPitrDataOptions := &clouddatabasesv5.GetPitrDataOptions{}
+	getPitrDataOptions.SetID(deploymentId)
+
+	pitrData, response, err := cloudDatabasesClient.GetPitrData(getPitrDataOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetPitrData failed %s\n%s", err, response)
+	}
+
+	if pitrData.PointInTimeRecoveryData == nil || pitrData.PointInTimeRecoveryData.EarliestPointInTimeRecoveryTime == nil {
+		return "", nil
+	}
+
+	return *pitrData.PointInTimeRecoveryData.EarliestPointInTimeRecoveryTime, nil
+}
+
+// validatePITRTime checks a requested restore point against the earliest point in time
+// recovery time reported for the source deployment. A blank restore time means the
+// latest available point and is always accepted.
+func validatePITRTime(deploymentId string, pitrTime string, meta interface{}) (err error) {
+	pitrTime = strings.TrimSpace(pitrTime)
+	if pitrTime == "" {
+		return nil
+	}
+
+	if deploymentId == "" {
+		return fmt.Errorf("point_in_time_recovery_time requires point_in_time_recovery_deployment_id to be set")
+	}
+
+	restoreTime, err := time.Parse(time.RFC3339, pitrTime)
+	if err != nil {
+		return fmt.Errorf("point_in_time_recovery_time %q is not a valid RFC3339 timestamp, for example 2020-04-20T05:27:36Z", pitrTime)
+	}
+
+	if restoreTime.After(time.Now()) {
+		return fmt.Errorf("point_in_time_recovery_time %s is in the future", pitrTime)
+	}
+
+	earliest, err := fetchEarliestPITRTimeFn(deploymentId, meta)
+	if err != nil {
+		return fmt.Errorf("Error fetching earliest point in time recovery time for %s: %s", deploymentId, err)
+	}
+
+	if earliest == "" {
+		return fmt.Errorf("Deployment %s has no point in time recovery data available", deploymentId)
+	}
+
+	earliestTime, err := time.Parse(time.RFC3339, earliest)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse earliest point in time recovery time %q: %s", earliest, err)
+		return nil
+	}
+
+	if restoreTime.Before(earliestTime) {
+		return fmt.Errorf("point_in_time_recovery_time %s is earlier than the earliest available restore point %s", pitrTime, earliest)
+	}
+
+	return nil
+}
+
+/* POINT IN TIME RECOVERY VALIDATOR END */
+
+/* CONFIGURATION VALIDATOR */
+
+type ConfigurationSchemaEntry struct {
+	Type    string        `json:"type"`
+	Minimum *float64      `json:"minimum,omitempty"`
+	Maximum *float64      `json:"maximum,omitempty"`
+	Choices []interface{} `json:"choices,omitempty"`
+}
+
+// expandConfigurationSchema parses the configuration_schema attribute, which holds the
+// configuration schema API response either wrapped in a "schema" key or as a bare map.
+func expandConfigurationSchema(schemaJSON string) (map[string]ConfigurationSchemaEntry, error) {
+	if strings.TrimSpace(schemaJSON) == "" {
+		return nil, nil
+	}
+
+	var wrapped struct {
+		Schema map[string]ConfigurationSchemaEntry `json:"schema"`
+	}
+	if err := json.Unmarshal([]byte(schemaJSON), &wrapped); err == nil && len(wrapped.Schema) > 0 {
+		return wrapped.Schema, nil
+	}
+
+	var entries map[string]ConfigurationSchemaEntry
+	if err := json.Unmarshal([]byte(schemaJSON), &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (e ConfigurationSchemaEntry) validate(name string, raw json.RawMessage) error {
+	if len(e.Choices) > 0 {
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+
+		allowed := make([]string, 0, len(e.Choices))
+		for _, choice := range e.Choices {
+			if fmt.Sprint(choice) == fmt.Sprint(value) {
+				return nil
+			}
+			allowed = append(allowed, fmt.Sprint(choice))
+		}
+
+		return fmt.Errorf("%s must be one of %s, got %s", name, strings.Join(allowed, ", "), string(raw))
+	}
+
+	if e.Type != "integer" && e.Type != "number" {
+		return nil
+	}
+
+	var value float64
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return fmt.Errorf("%s must be a number, got %s", name, string(raw))
+	}
+
+	if e.Type == "integer" && value != float64(int64(value)) {
+		return fmt.Errorf("%s must be an integer, got %s", name, string(raw))
+	}
+
+	if e.Minimum != nil && value < *e.Minimum {
+		return fmt.Errorf("%s must be at least %v, got %s", name, *e.Minimum, string(raw))
+	}
+
+	if e.Maximum != nil && value > *e.Maximum {
+		return fmt.Errorf("%s must be at most %v, got %s", name, *e.Maximum, string(raw))
+	}
+
+	return nil
+}
+
+// validateConfigurationValues checks configuration values against the type, range and
+// choices published by the deployment configuration schema. Parameters missing from the
+// schema are left to the field name check.
+func validateConfigurationValues(rawConfig map[string]json.RawMessage, configSchema map[string]ConfigurationSchemaEntry) error {
+	if len(configSchema) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(rawConfig))
+	for name := range rawConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	for _, name := range names {
+		entry, ok := configSchema[name]
+		if !ok {
+			continue
+		}
+
+		if err := entry.validate(name, rawConfig[name]); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("[ERROR] configuration contained invalid value(s):\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+/* CONFIGURATION VALIDATOR END */
+
+/* PRIVATE ENDPOINT VALIDATOR */
+
+// validatePrivateEndpointEnforcement checks that a deployment cannot be reached over a public
+// endpoint and that access is limited to the allowlisted addresses.
+func validatePrivateEndpointEnforcement(serviceEndpoints string, allowlist []clouddatabasesv5.AllowlistEntry) error {
+	if serviceEndpoints != "private" {
+		return fmt.Errorf("[ERROR] private_endpoints_only requires service_endpoints to be private, the %s endpoint would remain reachable", serviceEndpoints)
+	}
+
+	if len(allowlist) == 0 {
+		return fmt.Errorf("[ERROR] private_endpoints_only requires at least one allowlist entry, an empty allowlist allows connections from any address")
+	}
+
+	for _, entry := range allowlist {
+		if entry.Address == nil {
+			continue
+		}
+
+		address := strings.TrimSpace(*entry.Address)
+		if address == "0.0.0.0/0" || address == "::/0" {
+			return fmt.Errorf("[ERROR] private_endpoints_only does not allow the allowlist entry %s, it allows connections from any address", address)
+		}
+	}
+
+	return nil
+}
+
+/* PRIVATE ENDPOINT VALIDATOR END */
@@ -4,6 +4,7 @@
 package database
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
@@ -510,3 +511,185 @@ func TestValidateGroupScaling(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePITRTime(t *testing.T) {
+	tests := []struct {
+		description   string
+		deploymentID  string
+		pitrTime      string
+		earliest      string
+		expectedError string
+	}{
+		{
+			description:   "When the restore time is blank, Expect no error",
+			deploymentID:  "crn:v1:source",
+			pitrTime:      "",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "",
+		},
+		{
+			description:   "When the source deployment is missing, Expect deployment id error",
+			deploymentID:  "",
+			pitrTime:      "2020-04-20T05:27:36Z",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "requires point_in_time_recovery_deployment_id",
+		},
+		{
+			description:   "When the restore time is malformed, Expect timestamp error",
+			deploymentID:  "crn:v1:source",
+			pitrTime:      "2020-04-20 05:27:36",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "is not a valid RFC3339 timestamp",
+		},
+		{
+			description:   "When the restore time is in the future, Expect future error",
+			deploymentID:  "crn:v1:source",
+			pitrTime:      "2999-01-01T00:00:00Z",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "is in the future",
+		},
+		{
+			description:   "When the restore time is before the earliest restore point, Expect earliest error",
+			deploymentID:  "crn:v1:source",
+			pitrTime:      "2020-04-20T04:59:59Z",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "is earlier than the earliest available restore point 2020-04-20T05:00:00Z",
+		},
+		{
+			description:   "When the restore time is after the earliest restore point, Expect no error",
+			deploymentID:  "crn:v1:source",
+			pitrTime:      " 2020-04-20T05:27:36Z ",
+			earliest:      "2020-04-20T05:00:00Z",
+			expectedError: "",
+		},
+	}
+
+	originalFetchFunc := fetchEarliestPITRTimeFn
+	defer func() { fetchEarliestPITRTimeFn = originalFetchFunc }()
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fetchEarliestPITRTimeFn = func(deploymentID string, meta interface{}) (string, error) {
+				return tc.earliest, nil
+			}
+
+			err := validatePITRTime(tc.deploymentID, tc.pitrTime, &MockMeta{})
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateConfigurationValues(t *testing.T) {
+	schemaJSON := `{"schema": {
+		"max_connections": {"type": "integer", "minimum": 115, "maximum": 5000},
+		"synchronous_commit": {"type": "string", "choices": ["local", "off"]},
+		"archive_timeout": {"type": "integer", "minimum": 300, "maximum": 1073741823}
+	}}`
+
+	configSchema, err := expandConfigurationSchema(schemaJSON)
+	require.NoError(t, err)
+
+	tests := []struct {
+		description   string
+		config        string
+		expectedError string
+	}{
+		{
+			description:   "When all values are within the schema, Expect no error",
+			config:        `{"max_connections": 200, "synchronous_commit": "local", "wal_level": "logical"}`,
+			expectedError: "",
+		},
+		{
+			description:   "When a value is below the minimum, Expect minimum error",
+			config:        `{"max_connections": 100}`,
+			expectedError: "max_connections must be at least 115, got 100",
+		},
+		{
+			description:   "When a value is above the maximum, Expect maximum error",
+			config:        `{"max_connections": 5001}`,
+			expectedError: "max_connections must be at most 5000, got 5001",
+		},
+		{
+			description:   "When an integer value has a fraction, Expect integer error",
+			config:        `{"archive_timeout": 300.5}`,
+			expectedError: "archive_timeout must be an integer, got 300.5",
+		},
+		{
+			description:   "When a value is not one of the choices, Expect choices error",
+			config:        `{"synchronous_commit": "on"}`,
+			expectedError: `synchronous_commit must be one of local, off, got "on"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var rawConfig map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(tc.config), &rawConfig))
+
+			err := validateConfigurationValues(rawConfig, configSchema)
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePrivateEndpointEnforcement(t *testing.T) {
+	tests := []struct {
+		description      string
+		serviceEndpoints string
+		allowlist        []clouddatabasesv5.AllowlistEntry
+		expectedError    string
+	}{
+		{
+			description:      "When a public endpoint is enabled, Expect endpoint error",
+			serviceEndpoints: "public-and-private",
+			allowlist:        []clouddatabasesv5.AllowlistEntry{{Address: core.StringPtr("10.0.0.0/8")}},
+			expectedError:    "the public-and-private endpoint would remain reachable",
+		},
+		{
+			description:      "When the allowlist is empty, Expect allowlist error",
+			serviceEndpoints: "private",
+			allowlist:        []clouddatabasesv5.AllowlistEntry{},
+			expectedError:    "requires at least one allowlist entry",
+		},
+		{
+			description:      "When the allowlist allows any address, Expect allowlist entry error",
+			serviceEndpoints: "private",
+			allowlist: []clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("10.0.0.0/8")},
+				{Address: core.StringPtr("0.0.0.0/0")},
+			},
+			expectedError: "does not allow the allowlist entry 0.0.0.0/0",
+		},
+		{
+			description:      "When private endpoints and a restricted allowlist are set, Expect no error",
+			serviceEndpoints: "private",
+			allowlist:        []clouddatabasesv5.AllowlistEntry{{Address: core.StringPtr("10.0.0.0/8")}},
+			expectedError:    "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			err := validatePrivateEndpointEnforcement(tc.serviceEndpoints, tc.allowlist)
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
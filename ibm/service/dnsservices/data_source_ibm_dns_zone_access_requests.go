@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmDNSZoneAccessRequests = "ibm_dns_zone_access_requests"
+	pdnsAccessRequests       = "access_requests"
+)
+
+// DataSourceIBMDNSZoneAccessRequests lists the access requests sent to a zone by linked zones.
+func DataSourceIBMDNSZoneAccessRequests() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDNSZoneAccessRequestsRead,
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GUID of the DNS Services instance that owns the zone",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the zone",
+			},
+			pdnsAccessRequests: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The access requests of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsAccessRequestID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the access request",
+						},
+						pdnsAccessRequestState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the access request",
+						},
+						pdnsAccessRequestRequestorAccountID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account of the linked zone that sent the access request",
+						},
+						pdnsAccessRequestRequestorInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the DNS Services instance of the linked zone that sent the access request",
+						},
+						pdnsAccessRequestRequestorLinkedZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the linked zone that sent the access request",
+						},
+						pdnsAccessRequestCreatedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the access request was created",
+						},
+						pdnsAccessRequestModifiedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the access request was modified",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDNSZoneAccessRequestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMDNSZoneAccessRequestsRead Client initialization failed: %s", err.Error()), ibmDNSZoneAccessRequests, "read")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+
+	result, response, err := sess.ListDnszoneAccessRequestsWithContext(ctx, sess.NewListDnszoneAccessRequestsOptions(instanceID, zoneID))
+	if err != nil || result == nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListDnszoneAccessRequests failed with error: %s and response:\n%s", err, response), ibmDNSZoneAccessRequests, "read")
+		return tfErr.GetDiag()
+	}
+
+	accessRequests := make([]interface{}, 0, len(result.AccessRequests))
+	for _, accessRequest := range result.AccessRequests {
+		r := flattenDNSZoneAccessRequestRequestor(accessRequest.Requestor)
+		r[pdnsAccessRequestID] = flex.StringValue(accessRequest.ID)
+		r[pdnsAccessRequestState] = flex.StringValue(accessRequest.State)
+		if accessRequest.CreatedOn != nil {
+			r[pdnsAccessRequestCreatedOn] = accessRequest.CreatedOn.String()
+		}
+		if accessRequest.ModifiedOn != nil {
+			r[pdnsAccessRequestModifiedOn] = accessRequest.ModifiedOn.String()
+		}
+		accessRequests = append(accessRequests, r)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, zoneID))
+	d.Set(pdnsAccessRequests, accessRequests)
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ibmDNSZoneExport = "ibm_dns_zone_export"
+
+// DataSourceIBMDNSZoneExport exports the records of a zone as a BIND zone file.
+func DataSourceIBMDNSZoneExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDNSZoneExportRead,
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Zone ID",
+			},
+			pdnsZoneImportContent: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content of the BIND zone file of the zone",
+			},
+		},
+	}
+}
+
+func dataSourceIBMDNSZoneExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMDNSZoneExportRead Client initialization failed: %s", err.Error()), ibmDNSZoneExport, "read")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+
+	result, response, err := sess.ExportResourceRecordsWithContext(ctx, sess.NewExportResourceRecordsOptions(instanceID, zoneID))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ExportResourceRecords failed with error: %s and response:\n%s", err, response), ibmDNSZoneExport, "read")
+		return tfErr.GetDiag()
+	}
+	defer result.Close()
+	content, err := io.ReadAll(result)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Reading the exported zone file failed with error: %s", err), ibmDNSZoneExport, "read")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, zoneID))
+	d.Set(pdnsZoneImportContent, string(content))
+	return nil
+}
@@ -55,6 +55,11 @@ func DataSourceIBMPrivateDNSGLBPools() *schema.Resource {
 							Computed:    true,
 							Description: "The minimum number of origins that must be healthy for this pool to serve traffic",
 						},
+						pdnsGlbPoolHealthyOriginsCount: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of enabled origins that pass the health check",
+						},
 						pdnsGlbPoolCreatedOn: {
 							Type:        schema.TypeString,
 							Description: "The time when a load balancer pool is created.",
@@ -161,6 +166,7 @@ func dataSourceIBMPrivateDNSGLBPoolsRead(d *schema.ResourceData, meta interface{
 		dnsPool[pdnsGlbPoolEnabled] = *instance.Enabled
 		dnsPool[pdnsGlbPoolHealth] = *instance.Health
 		dnsPool[pdnsGlbPoolHealthyOriginsThreshold] = *instance.HealthyOriginsThreshold
+		dnsPool[pdnsGlbPoolHealthyOriginsCount] = pdnsGlbPoolHealthyOrigins(instance.Origins)
 		dnsPool[pdnsGlbPoolCreatedOn] = (*instance.CreatedOn).String()
 		dnsPool[pdnsGlbPoolModifiedOn] = (*instance.ModifiedOn).String()
 		dnsPool[pdnsGlbPoolMonitor] = *instance.Monitor
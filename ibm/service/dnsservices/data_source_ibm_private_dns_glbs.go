@@ -67,6 +67,35 @@ func DataSourceIBMPrivateDNSGLBs() *schema.Resource {
 							Computed:    true,
 							Description: "Healthy state of the load balancer.",
 						},
+						pdnsGLBPoolsHealth: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The health of the default pools, in failover order, and of the fallback pool",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									pdnsGlbPoolID: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The pool ID",
+									},
+									pdnsGlbPoolHealth: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The health of the pool",
+									},
+									pdnsGlbPoolHealthyOriginsCount: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of enabled origins of the pool that pass the health check",
+									},
+								},
+							},
+						},
+						pdnsGLBActivePool: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pool that serves the traffic: the first default pool that is not DOWN, or the fallback pool",
+						},
 						pdnsGLBFallbackPool: {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -133,6 +162,10 @@ func dataSourceIBMPrivateDNSGLBsRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error reading list of dns services GLB load balancers:%s\n%s", err, detail)
 	}
+	pools, err := listPDNSGLBPools(sess, instanceID)
+	if err != nil {
+		return err
+	}
 
 	dnslbs := make([]interface{}, 0)
 	for _, instance := range availableGLBs.LoadBalancers {
@@ -148,6 +181,7 @@ func dataSourceIBMPrivateDNSGLBsRead(d *schema.ResourceData, meta interface{}) e
 		dnsLoadbalancer[pdnsGLBModifiedOn] = (*instance.ModifiedOn).String()
 		dnsLoadbalancer[pdnsGLBDefaultPool] = instance.DefaultPools
 		dnsLoadbalancer[pdnsGLBAZPools] = flattenPDNSGlbAZpool(instance.AzPools)
+		dnsLoadbalancer[pdnsGLBPoolsHealth], dnsLoadbalancer[pdnsGLBActivePool] = flattenPDNSGLBPoolsHealth(instance.DefaultPools, *instance.FallbackPool, pools)
 
 		dnslbs = append(dnslbs, dnsLoadbalancer)
 	}
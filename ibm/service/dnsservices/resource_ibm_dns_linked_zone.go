@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,6 +30,14 @@ const (
 	DnsLinkedZoneApprovalRequiredBefore = "approval_required_before"
 	DnsLinkedZoneCreatedOn              = "created_on"
 	DnsLinkedZoneModifiedOn             = "modified_on"
+	DnsLinkedZoneID                     = "linked_zone_id"
+	DnsLinkedZoneWaitForApproval        = "wait_for_approval"
+
+	// A linked zone waits for the approval of its access request by the owner of the zone, then
+	// for a permitted network, and is active once it has one
+	DnsLinkedZoneStatePendingApproval   = dnssvcsv1.LinkedDnszone_State_PendingApproval
+	DnsLinkedZoneStatePendingNetworkAdd = dnssvcsv1.LinkedDnszone_State_PendingNetworkAdd
+	DnsLinkedZoneStateActive            = dnssvcsv1.LinkedDnszone_State_Active
 
 // DnsLinkedZoneOwnerInstanceID        = "owner_instance_id"
 )
@@ -41,7 +52,22 @@ func ResourceIBMDNSLinkedZone() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			DnsLinkedZoneID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the DNS Linked zone",
+			},
+			DnsLinkedZoneWaitForApproval: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait on create for the owner of the zone to approve the access request of the DNS Linked zone",
+			},
 			DnsLinkedZoneInstanceID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -137,6 +163,13 @@ func resourceIBMDNSLinkedZoneCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *resource.ID))
+
+	if d.Get(DnsLinkedZoneWaitForApproval).(bool) {
+		if err := waitForDNSLinkedZoneApproval(ctx, sess, instanceID, *resource.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_dns_linked_zone", "create")
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMDNSLinkedZoneRead(ctx, d, meta)
 }
 
@@ -167,6 +200,8 @@ func resourceIBMDNSLinkedZoneRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.Set(DnsLinkedZoneInstanceID, idSet[0])
+	d.Set(DnsLinkedZoneID, idSet[1])
+	d.Set(DnsLinkedZoneState, flex.StringValue(resource.State))
 	d.Set(DnsLinkedZoneDescription, *resource.Description)
 	d.Set(DnsLinkedZoneLabel, *resource.Label)
 	d.Set(DnsLinkedZoneCreatedOn, resource.CreatedOn.String())
@@ -255,3 +290,38 @@ func resourceIBMDNSLinkedZoneDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// waitForDNSLinkedZoneApproval waits for the owner of the zone to approve the access request of a
+// linked zone, and explains what is missing when it is not approved.
+func waitForDNSLinkedZoneApproval(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, linkedZoneID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DnsLinkedZoneStatePendingApproval},
+		Target:  []string{DnsLinkedZoneStatePendingNetworkAdd, DnsLinkedZoneStateActive},
+		Refresh: func() (interface{}, string, error) {
+			getLinkedZoneOptions := sess.NewGetLinkedZoneOptions(instanceID, linkedZoneID)
+			linkedZone, response, err := sess.GetLinkedZoneWithContext(ctx, getLinkedZoneOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil, "", fmt.Errorf("[ERROR] Linked zone %s does not exist in instance %s", linkedZoneID, instanceID)
+				}
+				return nil, "", fmt.Errorf("[ERROR] GetLinkedZone failed with error: %s and response:\n%s", err, response)
+			}
+			state := flex.StringValue(linkedZone.State)
+			switch state {
+			case DnsLinkedZoneStatePendingApproval, DnsLinkedZoneStatePendingNetworkAdd, DnsLinkedZoneStateActive:
+				return linkedZone, state, nil
+			}
+			return linkedZone, state, fmt.Errorf("[ERROR] Linked zone %s is in state %s: its access request was rejected, revoked or expired, the owner of the zone must approve the access request of a new linked zone", linkedZoneID, state)
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return fmt.Errorf("[ERROR] The access request of linked zone %s was not approved in %s: the owner of the zone must approve it in their account", linkedZoneID, timeout)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,648 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmDNSResourceRecords  = "ibm_dns_resource_records"
+	pdnsRecords            = "record"
+	pdnsRecordsCount       = "records_count"
+	pdnsRecordsListLimit   = 1000
+	pdnsRecordsTXTMaxChunk = 255
+)
+
+// PTR records are left out, they point to the A and AAAA records of the zone and keep being
+// managed with ibm_dns_resource_record.
+var allowedPrivateDNSBulkRecordTypes = []string{
+	"A", "AAAA", "CNAME", "MX", "SRV", "TXT",
+}
+
+// ResourceIBMDNSResourceRecords manages a set of resource records of a zone. The records are added
+// with the import API, all of them with a single zone file, instead of one call per record.
+func ResourceIBMDNSResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDNSResourceRecordsCreate,
+		ReadContext:   resourceIBMDNSResourceRecordsRead,
+		UpdateContext: resourceIBMDNSResourceRecordsUpdate,
+		DeleteContext: resourceIBMDNSResourceRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance ID",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Zone ID",
+			},
+			pdnsRecords: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Resource records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsRecordName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "DNS record name, relative to the zone, @ for the zone itself",
+						},
+						pdnsRecordType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues(allowedPrivateDNSBulkRecordTypes),
+							Description:  "DNS record Type",
+						},
+						pdnsRdata: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "DNS record Data",
+						},
+						pdnsRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     900,
+							Description: "DNS record TTL",
+						},
+						pdnsMxPreference: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "DNS maximum preference",
+						},
+						pdnsSrvPort: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "DNS server Port",
+						},
+						pdnsSrvPriority: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "DNS server Priority",
+						},
+						pdnsSrvWeight: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "DNS server weight",
+						},
+						pdnsSrvService: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service info",
+						},
+						pdnsSrvProtocol: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Protocol",
+						},
+					},
+				},
+			},
+			pdnsRecordsCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records managed by the resource",
+			},
+		},
+	}
+}
+
+func resourceIBMDNSResourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSResourceRecordsCreate Client initialization failed: %s", err.Error()), ibmDNSResourceRecords, "create")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+
+	mk := "private_dns_resource_records_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	importDiags := importPDNSBulkRecords(ctx, sess, instanceID, zoneID, d.Get(pdnsRecords).(*schema.Set).List())
+	if importDiags.HasError() {
+		return importDiags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, zoneID))
+	return append(importDiags, resourceIBMDNSResourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceIBMDNSResourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSResourceRecordsRead Client initialization failed: %s", err.Error()), ibmDNSResourceRecords, "read")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 2 {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Incorrect ID %s: Id should be a combination of InstanceID/zoneID", d.Id()), ibmDNSResourceRecords, "read")
+		return tfErr.GetDiag()
+	}
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	zone, response, err := sess.GetDnszoneWithContext(ctx, sess.NewGetDnszoneOptions(instanceID, zoneID))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszone failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "read")
+		return tfErr.GetDiag()
+	}
+	existing, response, err := listPDNSBulkRecords(ctx, sess, instanceID, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListResourceRecords failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "read")
+		return tfErr.GetDiag()
+	}
+
+	byKey := map[string]dnssvcsv1.ResourceRecord{}
+	for _, record := range existing {
+		byKey[pdnsBulkRecordKeyFromAPI(record, *zone.Name)] = record
+	}
+
+	records := make([]interface{}, 0)
+	managed := d.Get(pdnsRecords).(*schema.Set).List()
+	if len(managed) == 0 {
+		// On import, the resource takes over all records of the zone
+		for _, record := range existing {
+			if flattened := flattenPDNSBulkRecord(record, *zone.Name); flattened != nil {
+				records = append(records, flattened)
+			}
+		}
+	} else {
+		// Otherwise it keeps track of its own records only, the other records of the zone may be
+		// managed with ibm_dns_resource_record
+		for _, r := range managed {
+			record := r.(map[string]interface{})
+			found, ok := byKey[pdnsBulkRecordKey(record, *zone.Name)]
+			if !ok {
+				continue
+			}
+			if found.TTL != nil {
+				record[pdnsRecordTTL] = int(*found.TTL)
+			}
+			records = append(records, record)
+		}
+	}
+
+	d.Set(pdnsInstanceID, instanceID)
+	d.Set(pdnsZoneID, zoneID)
+	d.Set(pdnsRecords, records)
+	d.Set(pdnsRecordsCount, len(records))
+	return nil
+}
+
+func resourceIBMDNSResourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSResourceRecordsUpdate Client initialization failed: %s", err.Error()), ibmDNSResourceRecords, "update")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	mk := "private_dns_resource_records_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	var diags diag.Diagnostics
+	if d.HasChange(pdnsRecords) {
+		o, n := d.GetChange(pdnsRecords)
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		// A record whose TTL changed is updated in place
+		removed, added, diags = updatePDNSBulkRecordsTTL(ctx, sess, instanceID, zoneID, removed, added)
+		if diags.HasError() {
+			return diags
+		}
+		// The other changed records are deleted first, the zone file cannot replace a record
+		if len(removed) > 0 {
+			if diags = deletePDNSBulkRecords(ctx, sess, instanceID, zoneID, removed); diags.HasError() {
+				return diags
+			}
+		}
+		if len(added) > 0 {
+			if diags = importPDNSBulkRecords(ctx, sess, instanceID, zoneID, added); diags.HasError() {
+				return diags
+			}
+		}
+	}
+	return append(diags, resourceIBMDNSResourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceIBMDNSResourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSResourceRecordsDelete Client initialization failed: %s", err.Error()), ibmDNSResourceRecords, "delete")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, zoneID := idSet[0], idSet[1]
+
+	mk := "private_dns_resource_records_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	if diags := deletePDNSBulkRecords(ctx, sess, instanceID, zoneID, d.Get(pdnsRecords).(*schema.Set).List()); diags.HasError() {
+		return diags
+	}
+	d.SetId("")
+	return nil
+}
+
+// importPDNSBulkRecords adds the records to the zone with a single zone file. The records that the
+// import API rejects are reported as warnings, one per record, as long as some records were added:
+// the added records are read back into the state, and failing the apply would leave them out of
+// it. The rejected records are errors when no record was added.
+func importPDNSBulkRecords(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID string, records []interface{}) diag.Diagnostics {
+	zone, response, err := sess.GetDnszoneWithContext(ctx, sess.NewGetDnszoneOptions(instanceID, zoneID))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszone failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "import")
+		return tfErr.GetDiag()
+	}
+
+	var zoneFile bytes.Buffer
+	fmt.Fprintf(&zoneFile, "$ORIGIN %s.\n", strings.TrimSuffix(*zone.Name, "."))
+	for _, r := range records {
+		zoneFile.WriteString(pdnsBulkRecordZoneFileLine(r.(map[string]interface{}), *zone.Name))
+		zoneFile.WriteString("\n")
+	}
+	result, diags := importPDNSZoneFile(ctx, sess, instanceID, zoneID, zoneFile.Bytes(), ibmDNSResourceRecords, diag.Warning)
+	if result != nil && flex.IntValue(result.RecordsAdded) == 0 && len(result.Errors) > 0 {
+		for i := range diags {
+			diags[i].Severity = diag.Error
+		}
+	}
+	return diags
+}
+
+// updatePDNSBulkRecordsTTL updates the TTL of the records that only differ by their TTL, and returns
+// the removed and added records that are left to be deleted and imported.
+func updatePDNSBulkRecordsTTL(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID string, removed, added []interface{}) ([]interface{}, []interface{}, diag.Diagnostics) {
+	if len(removed) == 0 || len(added) == 0 {
+		return removed, added, nil
+	}
+	zone, response, err := sess.GetDnszoneWithContext(ctx, sess.NewGetDnszoneOptions(instanceID, zoneID))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszone failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "update")
+		return nil, nil, tfErr.GetDiag()
+	}
+	existing, response, err := listPDNSBulkRecords(ctx, sess, instanceID, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListResourceRecords failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "update")
+		return nil, nil, tfErr.GetDiag()
+	}
+
+	recordIDs := map[string]string{}
+	for _, record := range existing {
+		if record.ID != nil {
+			recordIDs[pdnsBulkRecordKeyFromAPI(record, *zone.Name)] = *record.ID
+		}
+	}
+	removedKeys := map[string]bool{}
+	for _, r := range removed {
+		removedKeys[pdnsBulkRecordKey(r.(map[string]interface{}), *zone.Name)] = true
+	}
+
+	updated := map[string]bool{}
+	remainingAdded := make([]interface{}, 0, len(added))
+	for _, r := range added {
+		record := r.(map[string]interface{})
+		key := pdnsBulkRecordKey(record, *zone.Name)
+		recordID, ok := recordIDs[key]
+		if !removedKeys[key] || !ok || updated[key] {
+			remainingAdded = append(remainingAdded, r)
+			continue
+		}
+		// The API requires the name and the data of the record with the new TTL, they are the same
+		// as the ones of the record in the zone since the keys match
+		rdata, err := pdnsBulkRecordUpdateRdata(sess, record)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error creating the data of resource record %s: %s", recordID, err), ibmDNSResourceRecords, "update")
+			return nil, nil, tfErr.GetDiag()
+		}
+		updateOptions := sess.NewUpdateResourceRecordOptions(instanceID, zoneID, recordID, record[pdnsRecordName].(string), rdata)
+		updateOptions.SetTTL(int64(record[pdnsRecordTTL].(int)))
+		if record[pdnsRecordType].(string) == "SRV" {
+			updateOptions.SetService(record[pdnsSrvService].(string))
+			updateOptions.SetProtocol(record[pdnsSrvProtocol].(string))
+		}
+		_, response, err := sess.UpdateResourceRecordWithContext(ctx, updateOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateResourceRecord %s failed with error: %s and response:\n%s", recordID, err, response), ibmDNSResourceRecords, "update")
+			return nil, nil, tfErr.GetDiag()
+		}
+		updated[key] = true
+	}
+
+	remainingRemoved := make([]interface{}, 0, len(removed))
+	for _, r := range removed {
+		if !updated[pdnsBulkRecordKey(r.(map[string]interface{}), *zone.Name)] {
+			remainingRemoved = append(remainingRemoved, r)
+		}
+	}
+	return remainingRemoved, remainingAdded, nil
+}
+
+// pdnsBulkRecordUpdateRdata returns the data of the record in the form of the update API.
+func pdnsBulkRecordUpdateRdata(sess *dnssvcsv1.DnsSvcsV1, record map[string]interface{}) (dnssvcsv1.ResourceRecordUpdateInputRdataIntf, error) {
+	rdata := record[pdnsRdata].(string)
+	switch record[pdnsRecordType].(string) {
+	case "A":
+		return sess.NewResourceRecordUpdateInputRdataRdataARecord(rdata)
+	case "AAAA":
+		return sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(rdata)
+	case "CNAME":
+		return sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(rdata)
+	case "TXT":
+		return sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(rdata)
+	case "MX":
+		return sess.NewResourceRecordUpdateInputRdataRdataMxRecord(rdata, int64(record[pdnsMxPreference].(int)))
+	case "SRV":
+		return sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(int64(record[pdnsSrvPort].(int)), int64(record[pdnsSrvPriority].(int)), rdata, int64(record[pdnsSrvWeight].(int)))
+	}
+	return nil, fmt.Errorf("unsupported record type %s", record[pdnsRecordType])
+}
+
+// importPDNSZoneFile uploads a zone file with the import API, which answers once the records are
+// added. The records of the file that are rejected are reported with the given severity, one
+// diagnostic per record.
+func importPDNSZoneFile(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID string, content []byte, resourceName string, severity diag.Severity) (*dnssvcsv1.ImportResourceRecordsResp, diag.Diagnostics) {
+	importOptions := sess.NewImportResourceRecordsOptions(instanceID, zoneID)
+	importOptions.SetFile(io.NopCloser(bytes.NewReader(content)))
+	importOptions.SetFileContentType("text/plain")
+	result, response, err := sess.ImportResourceRecordsWithContext(ctx, importOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ImportResourceRecords failed with error: %s and response:\n%s", err, response), resourceName, "import")
+		return nil, tfErr.GetDiag()
+	}
+
+	var diags diag.Diagnostics
+	for _, importErr := range result.Errors {
+		detail := ""
+		if importErr.Error != nil {
+			detail = fmt.Sprintf("%s: %s", flex.StringValue(importErr.Error.Code), flex.StringValue(importErr.Error.Message))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Resource record %q was not imported", flex.StringValue(importErr.ResourceRecord)),
+			Detail:   detail,
+		})
+	}
+	if flex.IntValue(result.RecordsAdded) > 0 && len(diags) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Some resource records were imported",
+			Detail: fmt.Sprintf("%d of the %d parsed records were added to the zone",
+				flex.IntValue(result.RecordsAdded), flex.IntValue(result.TotalRecordsParsed)),
+		})
+	}
+	return result, diags
+}
+
+// deletePDNSBulkRecords deletes the records of the zone that match the given records. The API has
+// no bulk delete, so each record is deleted with its own call.
+func deletePDNSBulkRecords(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID string, records []interface{}) diag.Diagnostics {
+	zone, response, err := sess.GetDnszoneWithContext(ctx, sess.NewGetDnszoneOptions(instanceID, zoneID))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszone failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "delete")
+		return tfErr.GetDiag()
+	}
+	existing, response, err := listPDNSBulkRecords(ctx, sess, instanceID, zoneID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListResourceRecords failed with error: %s and response:\n%s", err, response), ibmDNSResourceRecords, "delete")
+		return tfErr.GetDiag()
+	}
+
+	remove := map[string]bool{}
+	for _, r := range records {
+		remove[pdnsBulkRecordKey(r.(map[string]interface{}), *zone.Name)] = true
+	}
+	for _, record := range existing {
+		if record.ID == nil || !remove[pdnsBulkRecordKeyFromAPI(record, *zone.Name)] {
+			continue
+		}
+		response, err := sess.DeleteResourceRecordWithContext(ctx, sess.NewDeleteResourceRecordOptions(instanceID, zoneID, *record.ID))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteResourceRecord %s failed with error: %s and response:\n%s", *record.ID, err, response), ibmDNSResourceRecords, "delete")
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
+
+func listPDNSBulkRecords(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID string) ([]dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	records := []dnssvcsv1.ResourceRecord{}
+	listOptions := sess.NewListResourceRecordsOptions(instanceID, zoneID)
+	listOptions.SetLimit(pdnsRecordsListLimit)
+	for offset := int64(0); ; offset += pdnsRecordsListLimit {
+		listOptions.SetOffset(offset)
+		result, response, err := sess.ListResourceRecordsWithContext(ctx, listOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		records = append(records, result.ResourceRecords...)
+		if len(result.ResourceRecords) < pdnsRecordsListLimit ||
+			(result.TotalCount != nil && int64(len(records)) >= *result.TotalCount) {
+			return records, response, nil
+		}
+	}
+}
+
+// pdnsBulkRecordKey identifies a record of the configuration, regardless of the case of the names
+// and of the TTL, which can be changed without replacing the record.
+func pdnsBulkRecordKey(record map[string]interface{}, zoneName string) string {
+	recordType := record[pdnsRecordType].(string)
+	rdata := record[pdnsRdata].(string)
+	if recordType == "A" || recordType == "AAAA" {
+		if ip := net.ParseIP(rdata); ip != nil {
+			rdata = ip.String()
+		}
+	} else if recordType != "TXT" {
+		rdata = strings.ToLower(strings.TrimSuffix(rdata, "."))
+	}
+	key := []string{recordType, pdnsBulkRecordFQDN(record[pdnsRecordName].(string), zoneName), rdata}
+	switch recordType {
+	case "MX":
+		key = append(key, fmt.Sprint(record[pdnsMxPreference]))
+	case "SRV":
+		key = append(key, fmt.Sprint(record[pdnsSrvPort]), fmt.Sprint(record[pdnsSrvPriority]), fmt.Sprint(record[pdnsSrvWeight]),
+			pdnsBulkRecordSrvLabel(record[pdnsSrvService]), pdnsBulkRecordSrvLabel(record[pdnsSrvProtocol]))
+	}
+	return strings.Join(key, "|")
+}
+
+func pdnsBulkRecordKeyFromAPI(record dnssvcsv1.ResourceRecord, zoneName string) string {
+	flattened := flattenPDNSBulkRecord(record, zoneName)
+	if flattened == nil {
+		return ""
+	}
+	return pdnsBulkRecordKey(flattened, zoneName)
+}
+
+// flattenPDNSBulkRecord returns the record of the API in the form of the configuration, or nil for
+// the record types the resource does not manage.
+func flattenPDNSBulkRecord(record dnssvcsv1.ResourceRecord, zoneName string) map[string]interface{} {
+	if record.Type == nil || record.Name == nil {
+		return nil
+	}
+	flattened := map[string]interface{}{
+		pdnsRecordType:   *record.Type,
+		pdnsRecordTTL:    flex.IntValue(record.TTL),
+		pdnsMxPreference: 0,
+		pdnsSrvPort:      0,
+		pdnsSrvPriority:  0,
+		pdnsSrvWeight:    0,
+		pdnsSrvService:   "",
+		pdnsSrvProtocol:  "",
+	}
+	name := *record.Name
+	data := record.Rdata
+	switch *record.Type {
+	case "A", "AAAA":
+		flattened[pdnsRdata] = fmt.Sprint(data["ip"])
+	case "CNAME":
+		flattened[pdnsRdata] = fmt.Sprint(data["cname"])
+	case "TXT":
+		flattened[pdnsRdata] = fmt.Sprint(data["text"])
+	case "MX":
+		flattened[pdnsRdata] = fmt.Sprint(data["exchange"])
+		flattened[pdnsMxPreference] = pdnsBulkRecordInt(data["preference"])
+	case "SRV":
+		flattened[pdnsRdata] = fmt.Sprint(data["target"])
+		flattened[pdnsSrvPort] = pdnsBulkRecordInt(data["port"])
+		flattened[pdnsSrvPriority] = pdnsBulkRecordInt(data["priority"])
+		flattened[pdnsSrvWeight] = pdnsBulkRecordInt(data["weight"])
+		flattened[pdnsSrvService] = flex.StringValue(record.Service)
+		flattened[pdnsSrvProtocol] = flex.StringValue(record.Protocol)
+		// "_sip._udp.name.zone"
+		parts := strings.SplitN(name, ".", 3)
+		if len(parts) == 3 && strings.HasPrefix(parts[0], "_") && strings.HasPrefix(parts[1], "_") {
+			name = parts[2]
+		}
+	default:
+		return nil
+	}
+	flattened[pdnsRecordName] = pdnsBulkRecordRelativeName(name, zoneName)
+	return flattened
+}
+
+// pdnsBulkRecordZoneFileLine returns the record as a line of a zone file, with the names written
+// as fully qualified domain names.
+func pdnsBulkRecordZoneFileLine(record map[string]interface{}, zoneName string) string {
+	recordType := record[pdnsRecordType].(string)
+	name := pdnsBulkRecordFQDN(record[pdnsRecordName].(string), zoneName) + "."
+	rdata := record[pdnsRdata].(string)
+	ttl := record[pdnsRecordTTL].(int)
+
+	switch recordType {
+	case "CNAME":
+		rdata = strings.TrimSuffix(rdata, ".") + "."
+	case "MX":
+		rdata = fmt.Sprintf("%d %s.", record[pdnsMxPreference].(int), strings.TrimSuffix(rdata, "."))
+	case "SRV":
+		name = fmt.Sprintf("_%s._%s.%s", pdnsBulkRecordSrvLabel(record[pdnsSrvService]), pdnsBulkRecordSrvLabel(record[pdnsSrvProtocol]), name)
+		rdata = fmt.Sprintf("%d %d %d %s.", record[pdnsSrvPriority].(int), record[pdnsSrvWeight].(int), record[pdnsSrvPort].(int), strings.TrimSuffix(rdata, "."))
+	case "TXT":
+		rdata = pdnsBulkRecordTXTData(rdata)
+	}
+	return fmt.Sprintf("%s %d IN %s %s", name, ttl, recordType, rdata)
+}
+
+// pdnsBulkRecordTXTData quotes the text of a TXT record, split in strings of 255 characters, the
+// maximum length of a string of a zone file.
+func pdnsBulkRecordTXTData(text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	chunks := []string{}
+	for len(text) > pdnsRecordsTXTMaxChunk {
+		chunks = append(chunks, `"`+escaped.Replace(text[:pdnsRecordsTXTMaxChunk])+`"`)
+		text = text[pdnsRecordsTXTMaxChunk:]
+	}
+	chunks = append(chunks, `"`+escaped.Replace(text)+`"`)
+	return strings.Join(chunks, " ")
+}
+
+func pdnsBulkRecordFQDN(name, zoneName string) string {
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "@" || name == "" || name == zoneName {
+		return zoneName
+	}
+	if strings.HasSuffix(name, "."+zoneName) {
+		return name
+	}
+	return name + "." + zoneName
+}
+
+func pdnsBulkRecordRelativeName(name, zoneName string) string {
+	zoneName = strings.TrimSuffix(zoneName, ".")
+	name = strings.TrimSuffix(name, ".")
+	if strings.EqualFold(name, zoneName) {
+		return "@"
+	}
+	if len(name) > len(zoneName)+1 && strings.EqualFold(name[len(name)-len(zoneName)-1:], "."+zoneName) {
+		return name[:len(name)-len(zoneName)-1]
+	}
+	return name
+}
+
+// pdnsBulkRecordSrvLabel returns the service or protocol of a SRV record without its leading
+// underscore, the API accepts both "_udp" and "udp".
+func pdnsBulkRecordSrvLabel(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(v.(string), "_"))
+}
+
+func pdnsBulkRecordInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int64:
+		return int(n)
+	case int:
+		return n
+	}
+	return 0
+}
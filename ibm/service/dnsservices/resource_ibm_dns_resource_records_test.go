@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDNSResourceRecords_Basic(t *testing.T) {
+	name := fmt.Sprintf("testpdnsrecords%s.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "ibm_dns_resource_records.test-pdns-records"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDNSResourceRecordsBasic(name, "1.2.3.4", 900),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "records_count", "4"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDNSResourceRecordsBasic(name, "5.6.7.8", 900),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"type":  "A",
+						"rdata": "5.6.7.8",
+					}),
+				),
+			},
+			{
+				// Only the TTL changes, the records are updated in place
+				Config: testAccCheckIBMDNSResourceRecordsBasic(name, "5.6.7.8", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "records_count", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"type":  "A",
+						"rdata": "5.6.7.8",
+						"ttl":   "1800",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"type":       "MX",
+						"preference": "10",
+						"ttl":        "1800",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"type":  "TXT",
+						"rdata": "textinformation",
+						"ttl":   "1800",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDNSResourceRecordsBasic(name, ip string, ttl int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "test-pdns-instance" {
+		name              = "test-pdns-records-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+
+	resource "ibm_dns_zone" "test-pdns-zone" {
+		name        = "%[1]s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "testdescription"
+		label       = "testlabel"
+	}
+
+	resource "ibm_dns_resource_records" "test-pdns-records" {
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		zone_id     = ibm_dns_zone.test-pdns-zone.zone_id
+
+		record {
+			type  = "A"
+			name  = "testA"
+			rdata = "%[2]s"
+			ttl   = %[3]d
+		}
+		record {
+			type  = "CNAME"
+			name  = "testCNAME"
+			rdata = "testA.%[1]s"
+			ttl   = %[3]d
+		}
+		record {
+			type       = "MX"
+			name       = "testMX"
+			rdata      = "mailserver.%[1]s"
+			preference = 10
+			ttl        = %[3]d
+		}
+		record {
+			type  = "TXT"
+			name  = "testTXT"
+			rdata = "textinformation"
+			ttl   = %[3]d
+		}
+	}
+	  `, name, ip, ttl)
+}
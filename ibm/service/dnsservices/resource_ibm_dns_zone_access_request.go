@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmDNSZoneAccessRequest              = "ibm_dns_zone_access_request"
+	pdnsAccessRequestID                  = "access_request_id"
+	pdnsAccessRequestAction              = "action"
+	pdnsAccessRequestState               = "state"
+	pdnsAccessRequestRequestorAccountID  = "requestor_account_id"
+	pdnsAccessRequestRequestorInstanceID = "requestor_instance_id"
+	pdnsAccessRequestRequestorLinkedZone = "requestor_linked_zone_id"
+	pdnsAccessRequestCreatedOn           = "created_on"
+	pdnsAccessRequestModifiedOn          = "modified_on"
+
+	pdnsAccessRequestActionApprove = "APPROVE"
+	pdnsAccessRequestActionReject  = "REJECT"
+	pdnsAccessRequestActionRevoke  = "REVOKE"
+
+	pdnsAccessRequestStatePending  = "PENDING"
+	pdnsAccessRequestStateApproved = "APPROVED"
+	pdnsAccessRequestStateRejected = "REJECTED"
+)
+
+// ResourceIBMDNSZoneAccessRequest approves or rejects, on the side of the account that owns a zone,
+// the access request sent by a linked zone of another account.
+func ResourceIBMDNSZoneAccessRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDNSZoneAccessRequestCreate,
+		ReadContext:   resourceIBMDNSZoneAccessRequestRead,
+		DeleteContext: resourceIBMDNSZoneAccessRequestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the DNS Services instance that owns the zone",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the zone",
+			},
+			pdnsAccessRequestID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the access request",
+			},
+			pdnsAccessRequestAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      pdnsAccessRequestActionApprove,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{pdnsAccessRequestActionApprove, pdnsAccessRequestActionReject}),
+				Description:  "The action applied to the access request, APPROVE or REJECT",
+			},
+			pdnsAccessRequestState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the access request",
+			},
+			pdnsAccessRequestRequestorAccountID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account of the linked zone that sent the access request",
+			},
+			pdnsAccessRequestRequestorInstanceID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the DNS Services instance of the linked zone that sent the access request",
+			},
+			pdnsAccessRequestRequestorLinkedZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the linked zone that sent the access request",
+			},
+			pdnsAccessRequestCreatedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the access request was created",
+			},
+			pdnsAccessRequestModifiedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the access request was modified",
+			},
+		},
+	}
+}
+
+func resourceIBMDNSZoneAccessRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSZoneAccessRequestCreate Client initialization failed: %s", err.Error()), ibmDNSZoneAccessRequest, "create")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+	requestID := d.Get(pdnsAccessRequestID).(string)
+	action := d.Get(pdnsAccessRequestAction).(string)
+
+	accessRequest, response, err := sess.GetDnszoneAccessRequestWithContext(ctx, sess.NewGetDnszoneAccessRequestOptions(instanceID, zoneID, requestID))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszoneAccessRequest failed with error: %s and response:\n%s", err, response), ibmDNSZoneAccessRequest, "create")
+		return tfErr.GetDiag()
+	}
+	target := pdnsAccessRequestStateApproved
+	if action == pdnsAccessRequestActionReject {
+		target = pdnsAccessRequestStateRejected
+	}
+	// Only pending requests can be approved or rejected, an expired or revoked request is replaced
+	// by the new access request of a new linked zone
+	if state := flex.StringValue(accessRequest.State); state != target {
+		if state != pdnsAccessRequestStatePending {
+			tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Access request %s of zone %s is in state %s, only %s access requests can be approved or rejected", requestID, zoneID, state, pdnsAccessRequestStatePending), ibmDNSZoneAccessRequest, "create")
+			return tfErr.GetDiag()
+		}
+
+		mk := "dns_zone_access_request_" + instanceID + zoneID
+		conns.IbmMutexKV.Lock(mk)
+		defer conns.IbmMutexKV.Unlock(mk)
+
+		updateOptions := sess.NewUpdateDnszoneAccessRequestOptions(instanceID, zoneID, requestID, action)
+		_, response, err = sess.UpdateDnszoneAccessRequestWithContext(ctx, updateOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateDnszoneAccessRequest failed with error: %s and response:\n%s", err, response), ibmDNSZoneAccessRequest, "create")
+			return tfErr.GetDiag()
+		}
+		if err := waitForDNSZoneAccessRequestState(ctx, sess, instanceID, zoneID, requestID, []string{pdnsAccessRequestStatePending}, target, d.Timeout(schema.TimeoutCreate)); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for access request %s to be %s: %s", requestID, target, err), ibmDNSZoneAccessRequest, "create")
+			return tfErr.GetDiag()
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, requestID))
+	return resourceIBMDNSZoneAccessRequestRead(ctx, d, meta)
+}
+
+func resourceIBMDNSZoneAccessRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSZoneAccessRequestRead Client initialization failed: %s", err.Error()), ibmDNSZoneAccessRequest, "read")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 3 {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Incorrect ID %s: Id should be a combination of InstanceID/zoneID/accessRequestID", d.Id()), ibmDNSZoneAccessRequest, "read")
+		return tfErr.GetDiag()
+	}
+
+	accessRequest, response, err := sess.GetDnszoneAccessRequestWithContext(ctx, sess.NewGetDnszoneAccessRequestOptions(idSet[0], idSet[1], idSet[2]))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszoneAccessRequest failed with error: %s and response:\n%s", err, response), ibmDNSZoneAccessRequest, "read")
+		return tfErr.GetDiag()
+	}
+
+	state := flex.StringValue(accessRequest.State)
+	d.Set(pdnsInstanceID, idSet[0])
+	d.Set(pdnsZoneID, idSet[1])
+	d.Set(pdnsAccessRequestID, idSet[2])
+	// The action is recreated when the access request is no longer in the state it applied, for
+	// example once an approved request was revoked, or a rejected one expired
+	switch state {
+	case pdnsAccessRequestStateApproved:
+		d.Set(pdnsAccessRequestAction, pdnsAccessRequestActionApprove)
+	case pdnsAccessRequestStateRejected:
+		d.Set(pdnsAccessRequestAction, pdnsAccessRequestActionReject)
+	default:
+		d.Set(pdnsAccessRequestAction, "")
+	}
+	d.Set(pdnsAccessRequestState, state)
+	for k, v := range flattenDNSZoneAccessRequestRequestor(accessRequest.Requestor) {
+		d.Set(k, v)
+	}
+	if accessRequest.CreatedOn != nil {
+		d.Set(pdnsAccessRequestCreatedOn, accessRequest.CreatedOn.String())
+	}
+	if accessRequest.ModifiedOn != nil {
+		d.Set(pdnsAccessRequestModifiedOn, accessRequest.ModifiedOn.String())
+	}
+	return nil
+}
+
+func resourceIBMDNSZoneAccessRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSZoneAccessRequestDelete Client initialization failed: %s", err.Error()), ibmDNSZoneAccessRequest, "delete")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 3 {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Incorrect ID %s: Id should be a combination of InstanceID/zoneID/accessRequestID", d.Id()), ibmDNSZoneAccessRequest, "delete")
+		return tfErr.GetDiag()
+	}
+	instanceID, zoneID, requestID := idSet[0], idSet[1], idSet[2]
+
+	// Destroying an approval revokes the access of the linked zone, a rejection has nothing to undo
+	if d.Get(pdnsAccessRequestState).(string) != pdnsAccessRequestStateApproved {
+		d.SetId("")
+		return nil
+	}
+
+	mk := "dns_zone_access_request_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	updateOptions := sess.NewUpdateDnszoneAccessRequestOptions(instanceID, zoneID, requestID, pdnsAccessRequestActionRevoke)
+	_, response, err := sess.UpdateDnszoneAccessRequestWithContext(ctx, updateOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateDnszoneAccessRequest failed with error: %s and response:\n%s", err, response), ibmDNSZoneAccessRequest, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDNSZoneAccessRequestState(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID, requestID string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			accessRequest, response, err := sess.GetDnszoneAccessRequestWithContext(ctx, sess.NewGetDnszoneAccessRequestOptions(instanceID, zoneID, requestID))
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] GetDnszoneAccessRequest failed with error: %s and response:\n%s", err, response)
+			}
+			return accessRequest, flex.StringValue(accessRequest.State), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func flattenDNSZoneAccessRequestRequestor(requestor *dnssvcsv1.AccessRequestRequestor) map[string]interface{} {
+	flattened := map[string]interface{}{
+		pdnsAccessRequestRequestorAccountID:  "",
+		pdnsAccessRequestRequestorInstanceID: "",
+		pdnsAccessRequestRequestorLinkedZone: "",
+	}
+	if requestor != nil {
+		flattened[pdnsAccessRequestRequestorAccountID] = flex.StringValue(requestor.AccountID)
+		flattened[pdnsAccessRequestRequestorInstanceID] = flex.StringValue(requestor.InstanceID)
+		flattened[pdnsAccessRequestRequestorLinkedZone] = flex.StringValue(requestor.LinkedZoneID)
+	}
+	return flattened
+}
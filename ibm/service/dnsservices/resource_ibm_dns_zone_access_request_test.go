@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDNSZoneAccessRequest_basic(t *testing.T) {
+	// The access request is sent to the owner zone by a linked zone of another account
+	instanceId := "OWNER Instance ID"
+	zoneId := "OWNER ZONE ID"
+	accessRequestId := "ACCESS REQUEST ID"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() {},
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDNSZoneAccessRequestBasic(instanceId, zoneId, accessRequestId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_zone_access_request.test", "state", "APPROVED"),
+					resource.TestCheckResourceAttr("data.ibm_dns_zone_access_requests.test", "access_requests.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_dns_zone_access_request.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDNSZoneAccessRequestBasic(instanceId, zoneId, accessRequestId string) string {
+	return fmt.Sprintf(`
+	resource "ibm_dns_zone_access_request" "test" {
+		instance_id       = "%s"
+		zone_id           = "%s"
+		access_request_id = "%s"
+		action            = "APPROVE"
+	}
+
+	data "ibm_dns_zone_access_requests" "test" {
+		instance_id = ibm_dns_zone_access_request.test.instance_id
+		zone_id     = ibm_dns_zone_access_request.test.zone_id
+	}
+	`, instanceId, zoneId, accessRequestId)
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmDNSZoneImport                = "ibm_dns_zone_import"
+	pdnsZoneImportContent           = "content"
+	pdnsZoneImportTotalParsed       = "total_records_parsed"
+	pdnsZoneImportRecordsAdded      = "records_added"
+	pdnsZoneImportRecordsFailed     = "records_failed"
+	pdnsZoneImportErrors            = "errors"
+	pdnsZoneImportErrorRecord       = "resource_record"
+	pdnsZoneImportErrorCode         = "code"
+	pdnsZoneImportErrorMessage      = "message"
+	pdnsZoneImportDefaultTimeoutMin = 30
+)
+
+// ResourceIBMDNSZoneImport imports the records of a BIND zone file into a zone. The import is a one
+// time action: the records are not tracked, and deleting the resource keeps them in the zone.
+func ResourceIBMDNSZoneImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDNSZoneImportCreate,
+		ReadContext:   resourceIBMDNSZoneImportRead,
+		DeleteContext: resourceIBMDNSZoneImportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(pdnsZoneImportDefaultTimeoutMin * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance ID",
+			},
+			pdnsZoneID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Zone ID",
+			},
+			pdnsZoneImportContent: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Content of the BIND zone file to import",
+			},
+			pdnsZoneImportTotalParsed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records parsed from the zone file",
+			},
+			pdnsZoneImportRecordsAdded: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records added to the zone",
+			},
+			pdnsZoneImportRecordsFailed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records that could not be added to the zone",
+			},
+			pdnsZoneImportErrors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Records that could not be added to the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsZoneImportErrorRecord: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Line of the zone file of the record",
+						},
+						pdnsZoneImportErrorCode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error code",
+						},
+						pdnsZoneImportErrorMessage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error message",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMDNSZoneImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSZoneImportCreate Client initialization failed: %s", err.Error()), ibmDNSZoneImport, "create")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	zoneID := d.Get(pdnsZoneID).(string)
+
+	// Shares the lock of ibm_dns_resource_records, both add records to the zone with the import API
+	mk := "private_dns_resource_records_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	// The rejected records are warnings as long as some records were added, failing the apply
+	// would import the zone file again, and add the same records twice
+	result, diags := importPDNSZoneFile(ctx, sess, instanceID, zoneID, []byte(d.Get(pdnsZoneImportContent).(string)), ibmDNSZoneImport, diag.Warning)
+	if result == nil {
+		return diags
+	}
+	if flex.IntValue(result.RecordsAdded) == 0 && len(result.Errors) > 0 {
+		for i := range diags {
+			diags[i].Severity = diag.Error
+		}
+		return diags
+	}
+
+	importErrors := make([]map[string]interface{}, 0, len(result.Errors))
+	for _, importErr := range result.Errors {
+		e := map[string]interface{}{
+			pdnsZoneImportErrorRecord: flex.StringValue(importErr.ResourceRecord),
+		}
+		if importErr.Error != nil {
+			e[pdnsZoneImportErrorCode] = flex.StringValue(importErr.Error.Code)
+			e[pdnsZoneImportErrorMessage] = flex.StringValue(importErr.Error.Message)
+		}
+		importErrors = append(importErrors, e)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, zoneID))
+	d.Set(pdnsZoneImportTotalParsed, flex.IntValue(result.TotalRecordsParsed))
+	d.Set(pdnsZoneImportRecordsAdded, flex.IntValue(result.RecordsAdded))
+	d.Set(pdnsZoneImportRecordsFailed, flex.IntValue(result.RecordsFailed))
+	d.Set(pdnsZoneImportErrors, importErrors)
+	return append(diags, resourceIBMDNSZoneImportRead(ctx, d, meta)...)
+}
+
+func resourceIBMDNSZoneImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMDNSZoneImportRead Client initialization failed: %s", err.Error()), ibmDNSZoneImport, "read")
+		return tfErr.GetDiag()
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 2 {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Incorrect ID %s: Id should be a combination of InstanceID/zoneID", d.Id()), ibmDNSZoneImport, "read")
+		return tfErr.GetDiag()
+	}
+
+	// Only the zone is checked, the import results are kept from the create
+	_, response, err := sess.GetDnszoneWithContext(ctx, sess.NewGetDnszoneOptions(idSet[0], idSet[1]))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDnszone failed with error: %s and response:\n%s", err, response), ibmDNSZoneImport, "read")
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMDNSZoneImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The imported records stay in the zone
+	d.SetId("")
+	return nil
+}
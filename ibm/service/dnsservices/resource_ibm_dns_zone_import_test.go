@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDNSZoneImport_Basic(t *testing.T) {
+	name := fmt.Sprintf("testpdnsimport%s.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "ibm_dns_zone_import.test-pdns-import"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDNSZoneImportBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "total_records_parsed", "2"),
+					resource.TestCheckResourceAttr(resourceName, "records_added", "2"),
+					resource.TestCheckResourceAttr(resourceName, "records_failed", "0"),
+					resource.TestMatchResourceAttr("data.ibm_dns_zone_export.test-pdns-export", "content", regexp.MustCompile("testImportA")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDNSZoneImportBasic(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "test-pdns-instance" {
+		name              = "test-pdns-import-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+
+	resource "ibm_dns_zone" "test-pdns-zone" {
+		name        = "%[1]s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "testdescription"
+		label       = "testlabel"
+	}
+
+	resource "ibm_dns_zone_import" "test-pdns-import" {
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		zone_id     = ibm_dns_zone.test-pdns-zone.zone_id
+		content     = <<-EOT
+			$ORIGIN %[1]s.
+			testImportA     900 IN A     1.2.3.4
+			testImportCNAME 900 IN CNAME testImportA.%[1]s.
+		EOT
+	}
+
+	data "ibm_dns_zone_export" "test-pdns-export" {
+		instance_id = ibm_dns_zone_import.test-pdns-import.instance_id
+		zone_id     = ibm_dns_zone_import.test-pdns-import.zone_id
+	}
+	  `, name)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -488,7 +489,19 @@ func resouceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema
 		newState := stateprocess(newRaw)
 		oldState := stateprocess(oldRaw)
 
-		// Delete Custom Resolver Location.
+		// The locations are changed one at a time, as a rolling update: new locations are added
+		// before the removed ones are deleted, and every enabled location is healthy before the
+		// next one is changed, so that the resolver keeps serving during the update. The locations
+		// of a disabled resolver are not healthy, they are not waited for.
+		wasEnabled, _ := d.GetChange(pdnsCREnabled)
+		waitHealthy := func(locationID string) diag.Diagnostics {
+			if !cr_enable || !wasEnabled.(bool) {
+				return nil
+			}
+			return waitForPDNSCustomResolverLocationHealthy(context, sess, instanceID, resolverID, locationID, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		var removed []location
 		for _, oldloc := range oldState {
 			locationIdExists := false
 			for _, newloc := range newState {
@@ -498,76 +511,116 @@ func resouceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema
 				}
 			}
 			if !locationIdExists {
-				if oldloc.enabled {
-					err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, oldloc.locationId)
-					if err != nil {
-						return err
-					}
-				}
-				err := deleteCRLocation(meta, instanceID, resolverID, oldloc.locationId)
+				removed = append(removed, oldloc)
+			}
+		}
+		deleteLocation := func(oldloc location) diag.Diagnostics {
+			if oldloc.enabled {
+				err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, oldloc.locationId)
 				if err != nil {
 					return err
 				}
 			}
+			return deleteCRLocation(meta, instanceID, resolverID, oldloc.locationId)
 		}
 
+		// Add new custom resolver locations
+		locationsCount := len(oldState)
 		for _, newLoc := range newState {
-			// Add new custom resolver locations
-			if strings.Contains(newLoc.locationId, "NEW0") {
-				locationID, err := addCRLocation(meta, instanceID, resolverID, newLoc.subnet)
-				if err != nil || locationID == "" {
+			if !strings.Contains(newLoc.locationId, "NEW0") {
+				continue
+			}
+			// A custom resolver has at most three locations, a removed location makes room first
+			if locationsCount >= 3 && len(removed) > 0 {
+				if err := deleteLocation(removed[0]); err != nil {
 					return err
 				}
-				if newLoc.enabled {
-					err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, locationID)
-					if err != nil {
-						return err
-					}
+				removed = removed[1:]
+				locationsCount--
+			}
+			locationID, err := addCRLocation(meta, instanceID, resolverID, newLoc.subnet)
+			if err != nil || locationID == "" {
+				return err
+			}
+			locationsCount++
+			if newLoc.enabled {
+				err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, locationID)
+				if err != nil {
+					return err
 				}
-			} else {
-				// Update Location
-				locationIdExists := false
-				for _, oldLoc := range oldState {
-					if oldLoc.locationId == newLoc.locationId {
-						locationIdExists = true
-						if !(oldLoc.subnet == newLoc.subnet) {
-							// Update location subnet crn.
-							// Disable location before changing the subnet.
-							err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, newLoc.locationId)
+				if err := waitHealthy(locationID); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Update Location, the locations to enable before the ones to disable
+		var updates []location
+		for _, newLoc := range newState {
+			if !strings.Contains(newLoc.locationId, "NEW0") && newLoc.enabled {
+				updates = append(updates, newLoc)
+			}
+		}
+		for _, newLoc := range newState {
+			if !strings.Contains(newLoc.locationId, "NEW0") && !newLoc.enabled {
+				updates = append(updates, newLoc)
+			}
+		}
+		for _, newLoc := range updates {
+			locationIdExists := false
+			for _, oldLoc := range oldState {
+				if oldLoc.locationId == newLoc.locationId {
+					locationIdExists = true
+					if !(oldLoc.subnet == newLoc.subnet) {
+						// Update location subnet crn.
+						// Disable location before changing the subnet.
+						err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, newLoc.locationId)
+						if err != nil {
+							return err
+						}
+						errSub := updateLocationSubnet(meta, instanceID, resolverID, newLoc.locationId, newLoc.subnet)
+						if errSub != nil {
+							return errSub
+						}
+						if newLoc.enabled {
+							err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, newLoc.locationId)
 							if err != nil {
 								return err
 							}
-							errSub := updateLocationSubnet(meta, instanceID, resolverID, newLoc.locationId, newLoc.subnet)
-							if errSub != nil {
-								return errSub
+							if err := waitHealthy(newLoc.locationId); err != nil {
+								return err
 							}
-							if newLoc.enabled {
-								err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, newLoc.locationId)
-								if err != nil {
-									return err
-								}
+						}
+					} else if newLoc.enabled != oldLoc.enabled {
+						// Update location enable/disable
+						if newLoc.enabled {
+							err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, newLoc.locationId)
+							if err != nil {
+								return err
 							}
-						} else if newLoc.enabled != oldLoc.enabled {
-							// Update location enable/disable
-							if newLoc.enabled {
-								err := PDNSCustomResolverEnableLocation(meta, instanceID, resolverID, newLoc.locationId)
-								if err != nil {
-									return err
-								}
-							} else {
-								err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, newLoc.locationId)
-								if err != nil {
-									return err
-								}
+							if err := waitHealthy(newLoc.locationId); err != nil {
+								return err
+							}
+						} else {
+							err := PDNSCustomResolverDisableLocation(meta, instanceID, resolverID, newLoc.locationId)
+							if err != nil {
+								return err
 							}
 						}
 					}
 				}
-				if !locationIdExists {
-					err := fmt.Errorf("[ERROR] The custom resolver location %s does not exist anymore: %v", newLoc.locationId, err)
-					tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_dns_custom_resolver", "update")
-					return tfErr.GetDiag()
-				}
+			}
+			if !locationIdExists {
+				err := fmt.Errorf("[ERROR] The custom resolver location %s does not exist anymore: %v", newLoc.locationId, err)
+				tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_dns_custom_resolver", "update")
+				return tfErr.GetDiag()
+			}
+		}
+
+		// Delete Custom Resolver Location, once the new locations serve.
+		for _, oldloc := range removed {
+			if err := deleteLocation(oldloc); err != nil {
+				return err
 			}
 		}
 	}
@@ -799,6 +852,38 @@ func updateLocationSubnet(meta interface{}, instanceID string, customResolverID
 	return nil
 }
 
+// waitForPDNSCustomResolverLocationHealthy waits for an enabled location of a custom resolver to be
+// healthy, before the rolling update of the locations changes the next one.
+func waitForPDNSCustomResolverLocationHealthy(ctx context.Context, sess *dnssvcsv1.DnsSvcsV1, instanceID, customResolverID, locationID string, timeout time.Duration) diag.Diagnostics {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"unhealthy"},
+		Target:  []string{"healthy"},
+		Refresh: func() (interface{}, string, error) {
+			result, resp, err := sess.GetCustomResolverWithContext(ctx, sess.NewGetCustomResolverOptions(instanceID, customResolverID))
+			if err != nil || result == nil {
+				return nil, "", fmt.Errorf("[ERROR] GetCustomResolver failed with error: %s and response:\n%s", err, resp)
+			}
+			for _, loc := range result.Locations {
+				if loc.ID != nil && *loc.ID == locationID {
+					if loc.Healthy != nil && *loc.Healthy {
+						return result, "healthy", nil
+					}
+					return result, "unhealthy", nil
+				}
+			}
+			return nil, "", fmt.Errorf("[ERROR] The custom resolver location %s does not exist anymore", locationID)
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("The custom resolver location %s did not become healthy, the other locations are left unchanged: %s", locationID, err), "ibm_dns_custom_resolver", "update")
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
 func stateprocess(Raw interface{}) (State []location) {
 	new_LocationId := 0
 	for _, loc := range Raw.([]interface{}) {
@@ -30,6 +30,8 @@ const (
 	pdnsGLBAZPoolsPools     = "pools"
 	pdnsGLBCreatedOn        = "created_on"
 	pdnsGLBModifiedOn       = "modified_on"
+	pdnsGLBPoolsHealth      = "pools_health"
+	pdnsGLBActivePool       = "active_pool_id"
 	pdnsGLBDeleting         = "deleting"
 	pdnsGLBDeleted          = "done"
 )
@@ -95,6 +97,35 @@ func ResourceIBMPrivateDNSGLB() *schema.Resource {
 				Computed:    true,
 				Description: "Healthy state of the load balancer.",
 			},
+			pdnsGLBPoolsHealth: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of the default pools, in failover order, and of the fallback pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsGlbPoolID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pool ID",
+						},
+						pdnsGlbPoolHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the pool",
+						},
+						pdnsGlbPoolHealthyOriginsCount: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of enabled origins of the pool that pass the health check",
+						},
+					},
+				},
+			},
+			pdnsGLBActivePool: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The pool that serves the traffic: the first default pool that is not DOWN, or the fallback pool",
+			},
 			pdnsGLBFallbackPool: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -213,9 +244,61 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set(pdnsGLBCreatedOn, response.CreatedOn.String())
 	d.Set(pdnsGLBModifiedOn, response.ModifiedOn.String())
 	d.Set(pdnsGLBAZPools, flattenPDNSGlbAZpool(response.AzPools))
+
+	pools, err := listPDNSGLBPools(sess, idset[0])
+	if err != nil {
+		return err
+	}
+	poolsHealth, activePool := flattenPDNSGLBPoolsHealth(response.DefaultPools, flex.StringValue(response.FallbackPool), pools)
+	d.Set(pdnsGLBPoolsHealth, poolsHealth)
+	d.Set(pdnsGLBActivePool, activePool)
 	return nil
 }
 
+// listPDNSGLBPools returns the pools of an instance by ID, to report the health of the pools of
+// load balancers with a single call.
+func listPDNSGLBPools(sess *dnssvcsv1.DnsSvcsV1, instanceID string) (map[string]dnssvcsv1.Pool, error) {
+	listPoolsOptions := sess.NewListPoolsOptions(instanceID)
+	result, detail, err := sess.ListPools(listPoolsOptions)
+	if err != nil || result == nil {
+		return nil, flex.FmtErrorf("[ERROR] Error reading list of dns services GLB pools:%s\n%s", err, detail)
+	}
+	pools := make(map[string]dnssvcsv1.Pool, len(result.Pools))
+	for _, pool := range result.Pools {
+		if pool.ID != nil {
+			pools[*pool.ID] = pool
+		}
+	}
+	return pools, nil
+}
+
+// flattenPDNSGLBPoolsHealth returns the health of the default pools, in failover order, and of the
+// fallback pool, with the pool that serves the traffic: the first default pool that is not DOWN, or
+// the fallback pool when all of them are.
+func flattenPDNSGLBPoolsHealth(defaultPools []string, fallbackPool string, pools map[string]dnssvcsv1.Pool) ([]map[string]interface{}, string) {
+	poolsHealth := make([]map[string]interface{}, 0, len(defaultPools)+1)
+	activePool := ""
+	for _, poolID := range append(append([]string{}, defaultPools...), fallbackPool) {
+		pool, ok := pools[poolID]
+		if !ok {
+			continue
+		}
+		health := flex.StringValue(pool.Health)
+		poolsHealth = append(poolsHealth, map[string]interface{}{
+			pdnsGlbPoolID:                  poolID,
+			pdnsGlbPoolHealth:              health,
+			pdnsGlbPoolHealthyOriginsCount: pdnsGlbPoolHealthyOrigins(pool.Origins),
+		})
+		if activePool == "" && health != pdnsGlbPoolHealthDown {
+			activePool = poolID
+		}
+	}
+	if activePool == "" {
+		activePool = fallbackPool
+	}
+	return poolsHealth, activePool
+}
+
 func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
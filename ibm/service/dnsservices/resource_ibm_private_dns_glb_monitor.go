@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -46,6 +47,8 @@ func ResourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 		Exists:   resourceIBMPrivateDNSGLBMonitorExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMPrivateDNSGLBMonitorCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -87,10 +90,11 @@ func ResourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 			},
 
 			pdnsGlbMonitorPort: {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Optional:    true,
-				Description: "Port number to connect to for the health check",
+				Type:         schema.TypeInt,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator(ibmDNSGlbMonitor, pdnsGlbMonitorPort),
+				Description:  "Port number to connect to for the health check",
 			},
 
 			pdnsGlbMonitorInterval: {
@@ -165,7 +169,7 @@ func ResourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ValidateFunc: validate.InvokeValidator(ibmDNSGlbMonitor, pdnsGlbMonitorExpectedCodes),
-				Description:  "The expected HTTP response code or code range of the health check. This parameter is only valid for HTTP and HTTPS",
+				Description:  "The expected HTTP response codes or code ranges of the health check, separated by commas. This parameter is only valid for HTTP and HTTPS",
 			},
 
 			pdnsGlbMonitorExpectedBody: {
@@ -192,7 +196,6 @@ func ResourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 func ResourceIBMPrivateDNSGLBMonitorValidator() *validate.ResourceValidator {
 	monitorCheckTypes := "HTTP, HTTPS, TCP"
 	methods := "GET, HEAD"
-	expectedcode := "200,201,202,203,204,205,206,207,208,226,2xx,3xx,4xx,5xx"
 
 	validateSchema := make([]validate.ValidateSchema, 0)
 	validateSchema = append(validateSchema,
@@ -209,17 +212,42 @@ func ResourceIBMPrivateDNSGLBMonitorValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              methods})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 pdnsGlbMonitorPort,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	// A comma separated list of response codes and code ranges, for example "200,301" or "2xx,304"
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 pdnsGlbMonitorExpectedCodes,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              expectedcode})
+			Regexp:                     `^([1-5][0-9]{2}|[1-5]xx)(,([1-5][0-9]{2}|[1-5]xx))*$`})
 	dnsMonitorValidator := validate.ResourceValidator{ResourceName: ibmDNSGlbMonitor, Schema: validateSchema}
 	return &dnsMonitorValidator
 }
 
+// resourceIBMPrivateDNSGLBMonitorCustomizeDiff rejects at plan time the TCP monitors the API rejects:
+// the ones without a port, there is no default port for TCP, and the ones with expected codes.
+func resourceIBMPrivateDNSGLBMonitorCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get(pdnsGlbMonitorType).(string) != "TCP" {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.GetAttr(pdnsGlbMonitorPort).IsNull() {
+		return fmt.Errorf("[ERROR] %s is required for TCP monitors", pdnsGlbMonitorPort)
+	}
+	if !config.GetAttr(pdnsGlbMonitorExpectedCodes).IsNull() {
+		return fmt.Errorf("[ERROR] %s is only valid for HTTP and HTTPS monitors", pdnsGlbMonitorExpectedCodes)
+	}
+	return nil
+}
+
 func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
@@ -5,6 +5,7 @@ package dnsservices_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -75,6 +76,92 @@ func TestAccIBMPrivateDNSGlbMonitorImport(t *testing.T) {
 
 }
 
+func TestAccIBMPrivateDNSGlbMonitor_TCP(t *testing.T) {
+	var resultprivatedns string
+	name := fmt.Sprintf("testpdnstcp%s.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPrivateDNSGlbMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPrivateDNSGlbMonitorTCP(name, ""),
+				ExpectError: regexp.MustCompile("port is required for TCP monitors"),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSGlbMonitorTCP(name, "port = 8443"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPrivateDNSGlbMonitorExists("ibm_dns_glb_monitor.test-pdns-monitor", resultprivatedns),
+					resource.TestCheckResourceAttr("ibm_dns_glb_monitor.test-pdns-monitor", "type", "TCP"),
+					resource.TestCheckResourceAttr("ibm_dns_glb_monitor.test-pdns-monitor", "port", "8443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPrivateDNSGlbMonitor_ExpectedCodes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPrivateDNSGlbMonitorExpectedCodes("200,3x"),
+				ExpectError: regexp.MustCompile("expected_codes"),
+				PlanOnly:    true,
+			},
+			{
+				Config:             testAccCheckIBMPrivateDNSGlbMonitorExpectedCodes("200,301,4xx"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSGlbMonitorTCP(name, port string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default=true
+    }
+
+    resource "ibm_resource_instance" "test-pdns-instance" {
+		name = "test-pdns-glb-monitor-tcp-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location = "global"
+		service = "dns-svcs"
+		plan = "standard-dns"
+    }
+
+    resource "ibm_dns_zone" "test-pdns-zone" {
+		depends_on = [ibm_resource_instance.test-pdns-instance]
+		name = "%s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "testdescription"
+		label = "testlabel"
+    }
+
+	resource "ibm_dns_glb_monitor" "test-pdns-monitor" {
+		depends_on = [ibm_dns_zone.test-pdns-zone]
+		name = "test-pdns-glb-monitor-tcp"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		type="TCP"
+		%s
+    }
+	  `, name, port)
+}
+
+func testAccCheckIBMPrivateDNSGlbMonitorExpectedCodes(codes string) string {
+	return fmt.Sprintf(`
+	resource "ibm_dns_glb_monitor" "test-pdns-monitor" {
+		name = "test-pdns-glb-monitor-codes"
+		instance_id = "6ffda12064634723b079acdb018ef308"
+		type="HTTP"
+		expected_codes= "%s"
+    }
+	  `, codes)
+}
+
 func testAccCheckIBMPrivateDNSGlbMonitorBasic(name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "rg" {
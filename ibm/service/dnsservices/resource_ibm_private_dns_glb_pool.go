@@ -25,6 +25,8 @@ const (
 	pdnsGlbPoolEnabled                    = "enabled"
 	pdnsGlbPoolHealth                     = "health"
 	pdnsGlbPoolHealthyOriginsThreshold    = "healthy_origins_threshold"
+	pdnsGlbPoolHealthyOriginsCount        = "healthy_origins_count"
+	pdnsGlbPoolHealthDown                 = "DOWN"
 	pdnsGlbPoolOrigins                    = "origins"
 	pdnsGlbPoolOriginsName                = "name"
 	pdnsGlbPoolOriginsDescription         = "description"
@@ -95,6 +97,11 @@ func ResourceIBMPrivateDNSGLBPool() *schema.Resource {
 				Optional:    true,
 				Description: "The minimum number of origins that must be healthy for this pool to serve traffic",
 			},
+			pdnsGlbPoolHealthyOriginsCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of enabled origins that pass the health check",
+			},
 			pdnsGlbPoolOrigins: {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -237,6 +244,7 @@ func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{})
 	d.Set(pdnsGlbPoolEnabled, response.Enabled)
 	d.Set(pdnsGlbPoolHealth, response.Health)
 	d.Set(pdnsGlbPoolHealthyOriginsThreshold, response.HealthyOriginsThreshold)
+	d.Set(pdnsGlbPoolHealthyOriginsCount, pdnsGlbPoolHealthyOrigins(response.Origins))
 	d.Set(pdnsGlbPoolMonitor, response.Monitor)
 	d.Set(pdnsGlbPoolChannel, response.NotificationChannel)
 	d.Set(pdnsGlbPoolRegion, response.HealthcheckRegion)
@@ -256,14 +264,26 @@ func flattenPDNSGlbPoolOrigins(list []dns.Origin) []map[string]interface{} {
 			pdnsGlbPoolOriginsAddress:             *origin.Address,
 			pdnsGlbPoolOriginsEnabled:             *origin.Enabled,
 			pdnsGlbPoolOriginsDescription:         *origin.Description,
-			pdnsGlbPoolOriginsHealth:              *origin.Health,
-			pdnsGlbPoolOriginsHealthFailureReason: *origin.HealthFailureReason,
+			pdnsGlbPoolOriginsHealth:              origin.Health != nil && *origin.Health,
+			pdnsGlbPoolOriginsHealthFailureReason: flex.StringValue(origin.HealthFailureReason),
 		}
 		origins = append(origins, l)
 	}
 	return origins
 }
 
+// pdnsGlbPoolHealthyOrigins returns the number of enabled origins of a pool that pass the health
+// check, the pool is DOWN when it falls below the healthy origins threshold.
+func pdnsGlbPoolHealthyOrigins(list []dns.Origin) int {
+	healthy := 0
+	for _, origin := range list {
+		if origin.Enabled != nil && *origin.Enabled && origin.Health != nil && *origin.Health {
+			healthy++
+		}
+	}
+	return healthy
+}
+
 func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
@@ -31,6 +31,7 @@ func TestAccIBMPrivateDNSGlbPool_Basic(t *testing.T) {
 
 					resource.TestCheckResourceAttr("ibm_dns_glb_pool.test-pdns-pool-nw", "name", "testpool"),
 					resource.TestCheckResourceAttr("ibm_dns_glb_pool.test-pdns-pool-nw", "healthy_origins_threshold", "1"), // default value
+					resource.TestCheckResourceAttrSet("ibm_dns_glb_pool.test-pdns-pool-nw", "healthy_origins_count"),
 				),
 			},
 			{
@@ -32,6 +32,8 @@ func TestAccIBMPrivateDNSGlbLoadBalancer_Basic(t *testing.T) {
 					testAccCheckIBMPrivateDNSGlbLoadBalancerExists("ibm_dns_glb.test-pdns-lb", &resultprivatedns),
 					resource.TestCheckResourceAttr("ibm_dns_glb.test-pdns-lb", "name", newName),
 					resource.TestCheckResourceAttr("ibm_dns_glb.test-pdns-lb", "description", "new lb"),
+					resource.TestCheckResourceAttrSet("ibm_dns_glb.test-pdns-lb", "active_pool_id"),
+					resource.TestCheckResourceAttrSet("ibm_dns_glb.test-pdns-lb", "pools_health.#"),
 				),
 			},
 			{
@@ -4,13 +4,18 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,6 +27,7 @@ const (
 	pdnsPermittedNetworkModifiedOn = "modified_on"
 	pdnsPermittedNetworkState      = "state"
 	pdnsPermittedNetwork           = "permitted_network"
+	pdnsPermittedNetworkLinked     = "linked"
 )
 
 var allowedNetworkTypes = []string{
@@ -30,11 +36,13 @@ var allowedNetworkTypes = []string{
 
 func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSPermittedNetworkCreate,
-		Read:     resourceIBMPrivateDNSPermittedNetworkRead,
-		Delete:   resourceIBMPrivateDNSPermittedNetworkDelete,
-		Exists:   resourceIBMPrivateDNSPermittedNetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMPrivateDNSPermittedNetworkCreate,
+		Read:   resourceIBMPrivateDNSPermittedNetworkRead,
+		Delete: resourceIBMPrivateDNSPermittedNetworkDelete,
+		Exists: resourceIBMPrivateDNSPermittedNetworkExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMPrivateDNSPermittedNetworkImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -78,6 +86,14 @@ func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 				Description: "VPC CRN id",
 			},
 
+			pdnsPermittedNetworkLinked: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether zone_id is a linked zone, the VPC is then permitted to resolve the zone of another account that the linked zone is linked to",
+			},
+
 			pdnsPermittedNetworkCreatedOn: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -109,22 +125,53 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	zoneID := d.Get(pdnsZoneID).(string)
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
-	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
 
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
 	if err != nil {
 		return err
 	}
+
+	if d.Get(pdnsPermittedNetworkLinked).(bool) {
+		// A linked zone can only be used once the owner of the zone it is linked to, in the other
+		// account, approved its access request
+		if err := waitForDNSLinkedZoneApproval(context.Background(), sess, instanceID, zoneID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+		createLzPermittedNetworkOptions := sess.NewCreateLzPermittedNetworkOptions(instanceID, zoneID, nwType, permittedNetworkCrn)
+		var permittedNetworkID string
+		detail, err := retryPDNSPermittedNetworkConflict(d.Timeout(schema.TimeoutCreate), func() (*core.DetailedResponse, error) {
+			response, detail, err := sess.CreateLzPermittedNetwork(createLzPermittedNetworkOptions)
+			if err == nil {
+				permittedNetworkID = *response.ID
+			}
+			return detail, err
+		})
+		if err != nil {
+			return flex.FmtErrorf("[ERROR] Error creating dns services linked permitted network:%s\n%s", err, detail)
+		}
+		d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, permittedNetworkID))
+		return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
+	}
+
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID, nwType, permittedNetworkCrn)
 
-	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+	var permittedNetworkID string
+	detail, err := retryPDNSPermittedNetworkConflict(d.Timeout(schema.TimeoutCreate), func() (*core.DetailedResponse, error) {
+		response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+		if err == nil {
+			permittedNetworkID = *response.ID
+		}
+		if detail != nil && detail.StatusCode == 409 && pdnsPermittedNetworkExists(sess, instanceID, zoneID, vpcCRN) {
+			// The VPC is already permitted, the conflict is not with a concurrent change of the zone
+			return nil, flex.FmtErrorf("[ERROR] VPC %s is already a permitted network of zone %s: %s", vpcCRN, zoneID, err)
+		}
+		return detail, err
+	})
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error creating dns services permitted network:%s\n%s", err, detail)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, permittedNetworkID))
 
 	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
 }
@@ -136,6 +183,23 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	}
 
 	idSet := strings.Split(d.Id(), "/")
+	if d.Get(pdnsPermittedNetworkLinked).(bool) {
+		getLinkedPermittedNetworkOptions := sess.NewGetLinkedPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
+		response, detail, err := sess.GetLinkedPermittedNetwork(getLinkedPermittedNetworkOptions)
+		if err != nil {
+			return flex.FmtErrorf("[ERROR] Error reading dns services linked permitted network:%s\n%s", err, detail)
+		}
+		d.Set(pdnsInstanceID, idSet[0])
+		d.Set(pdnsZoneID, idSet[1])
+		d.Set(pdnsPermittedNetworkID, response.ID)
+		d.Set(pdnsPermittedNetworkCreatedOn, response.CreatedOn.String())
+		d.Set(pdnsPermittedNetworkModifiedOn, response.ModifiedOn.String())
+		d.Set(pdnsVpcCRN, response.PermittedNetwork.VpcCrn)
+		d.Set(pdnsNetworkType, response.Type)
+		d.Set(pdnsPermittedNetworkState, response.State)
+		return nil
+	}
+
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
@@ -162,11 +226,23 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 	}
 
 	idSet := strings.Split(d.Id(), "/")
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if d.Get(pdnsPermittedNetworkLinked).(bool) {
+		deleteLzPermittedNetworkOptions := sess.NewDeleteLzPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
+		response, err := retryPDNSPermittedNetworkConflict(d.Timeout(schema.TimeoutDelete), func() (response *core.DetailedResponse, err error) {
+			_, response, err = sess.DeleteLzPermittedNetwork(deleteLzPermittedNetworkOptions)
+			return response, err
+		})
+		if err != nil {
+			return flex.FmtErrorf("[ERROR] Error deleting dns services linked permitted network:%s\n%s", err, response)
+		}
+		d.SetId("")
+		return nil
+	}
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+	response, err := retryPDNSPermittedNetworkConflict(d.Timeout(schema.TimeoutDelete), func() (response *core.DetailedResponse, err error) {
+		_, response, err = sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+		return response, err
+	})
 
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error deleting dns services permitted network:%s\n%s", err, response)
@@ -187,11 +263,14 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 		return false, flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/permittedNetworkID", d.Id())
 	}
 
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	var response *core.DetailedResponse
+	if d.Get(pdnsPermittedNetworkLinked).(bool) {
+		getLinkedPermittedNetworkOptions := sess.NewGetLinkedPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
+		_, response, err = sess.GetLinkedPermittedNetwork(getLinkedPermittedNetworkOptions)
+	} else {
+		getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
+		_, response, err = sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	}
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -200,3 +279,63 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 	return true, nil
 }
+
+// retryPDNSPermittedNetworkConflict calls fn again while DNS Services answers 409 Conflict, which it
+// does while another permitted network of the same zone is being added or removed. Retrying the
+// conflicts, instead of serializing the calls, lets many permitted networks be applied in parallel.
+func retryPDNSPermittedNetworkConflict(timeout time.Duration, fn func() (*core.DetailedResponse, error)) (*core.DetailedResponse, error) {
+	var response *core.DetailedResponse
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		response, err = fn()
+		if err != nil {
+			if response != nil && response.StatusCode == 409 {
+				log.Printf("[DEBUG] Permitted network change conflicts with another change of the zone, retrying: %s", err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return response, err
+}
+
+// pdnsPermittedNetworkExists returns whether the VPC is a permitted network of the zone.
+func pdnsPermittedNetworkExists(sess *dnssvcsv1.DnsSvcsV1, instanceID, zoneID, vpcCRN string) bool {
+	result, _, err := sess.ListPermittedNetworks(sess.NewListPermittedNetworksOptions(instanceID, zoneID))
+	if err != nil || result == nil {
+		return false
+	}
+	for _, permittedNetwork := range result.PermittedNetworks {
+		if permittedNetwork.PermittedNetwork != nil && flex.StringValue(permittedNetwork.PermittedNetwork.VpcCrn) == vpcCRN {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIBMPrivateDNSPermittedNetworkImport imports the permitted networks of zones and of linked
+// zones, which share the same ID format: the linked ones are the ones the zone API does not find.
+func resourceIBMPrivateDNSPermittedNetworkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) < 3 {
+		return nil, flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/permittedNetworkID", d.Id())
+	}
+
+	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
+	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	if err != nil {
+		if response == nil || response.StatusCode != 404 {
+			return nil, flex.FmtErrorf("[ERROR] Error reading dns services permitted network:%s\n%s", err, response)
+		}
+		d.Set(pdnsPermittedNetworkLinked, true)
+		return []*schema.ResourceData{d}, nil
+	}
+	d.Set(pdnsPermittedNetworkLinked, false)
+	return []*schema.ResourceData{d}, nil
+}
@@ -92,6 +92,67 @@ func testAccCheckIBMPrivateDNSPermittedNetworkBasic(name string) string {
 	  `, name)
 }
 
+func TestAccIBMPrivateDNSPermittedNetwork_Linked(t *testing.T) {
+	name := fmt.Sprintf("testpdnslinked%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+	// The owner zone is in another account, which must approve the access request of the linked zone
+	ownerInstanceId := "OWNER Instance ID"
+	ownerZoneId := "OWNER ZONE ID"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() {},
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSPermittedNetworkLinked(name, ownerInstanceId, ownerZoneId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_permitted_network.test-pdns-linked-permitted-network", "linked", "true"),
+					resource.TestCheckResourceAttrSet("ibm_dns_permitted_network.test-pdns-linked-permitted-network", "permitted_network_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_dns_permitted_network.test-pdns-linked-permitted-network",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSPermittedNetworkLinked(name, ownerInstanceId, ownerZoneId string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+
+	resource "ibm_is_vpc" "test-pdns-linked-vpc" {
+		name           = "%[1]s-vpc"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+
+	resource "ibm_resource_instance" "test-pdns-linked-instance" {
+		name              = "%[1]s-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+
+	resource "ibm_dns_linked_zone" "test-pdns-linked-zone" {
+		name              = "%[1]s"
+		instance_id       = ibm_resource_instance.test-pdns-linked-instance.guid
+		owner_instance_id = "%[2]s"
+		owner_zone_id     = "%[3]s"
+	}
+
+	resource "ibm_dns_permitted_network" "test-pdns-linked-permitted-network" {
+		instance_id = ibm_resource_instance.test-pdns-linked-instance.guid
+		zone_id     = ibm_dns_linked_zone.test-pdns-linked-zone.linked_zone_id
+		vpc_crn     = ibm_is_vpc.test-pdns-linked-vpc.crn
+		type        = "vpc"
+		linked      = true
+	}
+	  `, name, ownerInstanceId, ownerZoneId)
+}
+
 func testAccCheckIBMPrivateDNSPermittedNetworkDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_permitted_network" {
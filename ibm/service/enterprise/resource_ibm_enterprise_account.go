@@ -15,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
@@ -36,7 +37,7 @@ func ResourceIBMEnterpriseAccount() *schema.Resource {
 			"parent": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself.",
+				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself. Changing the parent moves the account without re-creating it.",
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -371,11 +372,47 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+		if d.HasChange("parent") {
+			_, err = waitForEnterpriseAccountMove(context, d, meta, d.Get("parent").(string))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for account (%s) to move to %s: %s", d.Id(), d.Get("parent").(string), err))
+			}
+		}
 	}
 
 	return resourceIbmEnterpriseAccountRead(context, d, meta)
 }
 
+func waitForEnterpriseAccountMove(context context.Context, d *schema.ResourceData, meta interface{}, parent string) (interface{}, error) {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return nil, err
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+	getAccountOptions.SetAccountID(d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"moving"},
+		Target:  []string{"moved"},
+		Refresh: func() (interface{}, string, error) {
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.Parent != nil && *account.Parent == parent {
+				return account, "moved", nil
+			}
+			return account, "moving", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
 func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const tagListPageLimit = 1000
+
+// ResourceIBMResourceTagCleanup deletes the unused tags of the account whose name
+// starts with a prefix. The work is done on create; read and delete only manage
+// the state, so changing an argument or a trigger runs the cleanup again.
+func ResourceIBMResourceTagCleanup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMResourceTagCleanupCreate,
+		ReadContext:   resourceIBMResourceTagCleanupRead,
+		DeleteContext: resourceIBMResourceTagCleanupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only unused tags whose name starts with this prefix are deleted, it must not be empty",
+			},
+			"include_access_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Also delete unused access tags matching the prefix",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "List the tags that would be deleted without deleting them",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the cleanup again when they change",
+			},
+			"deleted_tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The user tags that were deleted, or that would be deleted when dry_run is set",
+			},
+			"deleted_access_tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The access tags that were deleted, or that would be deleted when dry_run is set",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceTagCleanupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_resource_tag_cleanup", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	prefix := d.Get("prefix").(string)
+	dryRun := d.Get("dry_run").(bool)
+
+	deletedTags, err := cleanupUnusedTags(context, gtClient, "user", prefix, dryRun)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deletedAccessTags := []string{}
+	if d.Get("include_access_tags").(bool) {
+		deletedAccessTags, err = cleanupUnusedTags(context, gtClient, "access", prefix, dryRun)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", prefix, time.Now().UTC().Format(time.RFC3339)))
+	d.Set("deleted_tags", deletedTags)
+	d.Set("deleted_access_tags", deletedAccessTags)
+
+	return nil
+}
+
+func resourceIBMResourceTagCleanupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceIBMResourceTagCleanupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// cleanupUnusedTags deletes the tags of the given type that start with prefix and
+// are not attached to any resource, and returns their names
+func cleanupUnusedTags(context context.Context, gtClient *globaltaggingv1.GlobalTaggingV1, tagType, prefix string, dryRun bool) ([]string, error) {
+	allTags, err := listTagNames(context, gtClient, tagType, false)
+	if err != nil {
+		return nil, err
+	}
+	attachedTags, err := listTagNames(context, gtClient, tagType, true)
+	if err != nil {
+		return nil, err
+	}
+	attached := make(map[string]bool, len(attachedTags))
+	for _, name := range attachedTags {
+		attached[name] = true
+	}
+
+	unused := []string{}
+	for _, name := range allTags {
+		if strings.HasPrefix(name, prefix) && !attached[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+
+	if dryRun {
+		log.Printf("[INFO] Dry run, %d unused %s tags match prefix %q: %v", len(unused), tagType, prefix, unused)
+		return unused, nil
+	}
+
+	for _, name := range unused {
+		tagName := name
+		deleteTagOptions := &globaltaggingv1.DeleteTagOptions{
+			TagName: &tagName,
+			TagType: &tagType,
+		}
+		results, resp, err := gtClient.DeleteTagWithContext(context, deleteTagOptions)
+		if err != nil {
+			return nil, flex.FmtErrorf("Error while deleting %s tag (%s) : %v\n%v", tagType, tagName, err, resp)
+		}
+		if results != nil {
+			errMap := make([]globaltaggingv1.DeleteTagResultsItem, 0)
+			for _, res := range results.Results {
+				if res.IsError != nil && *res.IsError {
+					errMap = append(errMap, res)
+				}
+			}
+			if len(errMap) > 0 {
+				output, _ := json.MarshalIndent(errMap, "", "    ")
+				return nil, flex.FmtErrorf("Error while deleting %s tag in results (%s) : %s", tagType, tagName, string(output))
+			}
+		}
+	}
+
+	return unused, nil
+}
+
+func listTagNames(context context.Context, gtClient *globaltaggingv1.GlobalTaggingV1, tagType string, attachedOnly bool) ([]string, error) {
+	names := []string{}
+	var offset int64
+	for {
+		listTagsOptions := &globaltaggingv1.ListTagsOptions{
+			TagType:      &tagType,
+			AttachedOnly: &attachedOnly,
+			Offset:       core.Int64Ptr(offset),
+			Limit:        core.Int64Ptr(tagListPageLimit),
+		}
+		taggingResult, resp, err := gtClient.ListTagsWithContext(context, listTagsOptions)
+		if err != nil {
+			return nil, flex.FmtErrorf("Error while listing %s tags : %v\n%v", tagType, err, resp)
+		}
+		for _, item := range taggingResult.Items {
+			if item.Name != nil {
+				names = append(names, *item.Name)
+			}
+		}
+		offset += int64(len(taggingResult.Items))
+		if len(taggingResult.Items) < tagListPageLimit || (taggingResult.TotalCount != nil && offset >= *taggingResult.TotalCount) {
+			break
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTagCleanup_DryRun(t *testing.T) {
+	prefix := fmt.Sprintf("tf-cleanup-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckResourceTagCleanupConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag_cleanup.cleanup", "deleted_tags.#", "0"),
+					resource.TestCheckResourceAttr("ibm_resource_tag_cleanup.cleanup", "deleted_access_tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_resource_tag_cleanup.cleanup", "deleted_access_tags.0", prefix+":unused"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagCleanupConfig(prefix string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_tag" "tag" {
+		name = "%[1]s:unused"
+	}
+
+	resource "ibm_resource_tag_cleanup" "cleanup" {
+		prefix              = "%[1]s"
+		include_access_tags = true
+		dry_run             = true
+		depends_on          = [ibm_iam_access_tag.tag]
+	}
+	`, prefix)
+}
@@ -51,6 +51,12 @@ func DataSourceIBMHPCS() *schema.Resource {
 				Optional:    true,
 				Default:     "hs-crypto",
 			},
+			"fetch_hsm_info": {
+				Description: "Whether to query the HSM configuration of the crypto units, which requires access to the crypto units",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"units": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -286,6 +292,10 @@ func dataSourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta
 	d.Set("plan", servicePlan)
 	d.Set("crn", instance.CRN)
 
+	if !d.Get("fetch_hsm_info").(bool) {
+		d.Set("hsm_info", nil)
+		return nil
+	}
 	ci, err := hsmClient(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,437 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-hpcs-uko-sdk/ukov4"
+)
+
+// ukoInventoryPageSize is the number of keystores and managed keys that are listed per call.
+const ukoInventoryPageSize = 100
+
+func DataSourceIbmKeyInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIbmKeyInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the UKO instance this resource exists in.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The region of the UKO instance this resource exists in.",
+			},
+			"vault_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only list the keystores and managed keys of these vaults.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"states": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only list the managed keys in these states, for example active or deactivated.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"algorithms": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only list the managed keys of these algorithms, for example aes or rsa.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"keystores": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The keystores of the vaults.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID used to uniquely identify the resource, as specified by RFC 4122.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the target keystore.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of keystore.",
+						},
+						"location": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Geographic location of the keystore, if available.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the keystore.",
+						},
+						"vault": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Reference to a vault.",
+							Elem:        dataSourceIbmKeyInventoryReferenceSchema("Name of the referenced vault."),
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time when the target keystore was created.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time when the target keystore was last updated.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A URL that uniquely identifies your cloud resource.",
+						},
+					},
+				},
+			},
+			"managed_keys": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The managed keys of the vaults that match the states and algorithms.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The v4 UUID used to uniquely identify the resource, as specified by RFC 4122.",
+						},
+						"label": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the key.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the key.",
+						},
+						"algorithm": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The algorithm of the key.",
+						},
+						"size": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The size of the underlying cryptographic key or key pair. E.g. \"256\" for AES keys, or \"2048\" for RSA.",
+						},
+						"vault": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Reference to a vault.",
+							Elem:        dataSourceIbmKeyInventoryReferenceSchema("Name of the referenced vault."),
+						},
+						"template": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Reference to a key template.",
+							Elem:        dataSourceIbmKeyInventoryReferenceSchema("Name of the key template."),
+						},
+						"referenced_keystores": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "References to the keystores the key is distributed to.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The v4 UUID used to uniquely identify the resource, as specified by RFC 4122.",
+									},
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the target keystore.",
+									},
+									"type": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of keystore.",
+									},
+									"href": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A URL that uniquely identifies your cloud resource.",
+									},
+								},
+							},
+						},
+						"activation_date": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "First day when the key is active.",
+						},
+						"expiration_date": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last day when the key is active.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time when the key was created.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time when the key was last updated.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A URL that uniquely identifies your cloud resource.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmKeyInventoryReferenceSchema(nameDescription string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID used to uniquely identify the resource, as specified by RFC 4122.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: nameDescription,
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A URL that uniquely identifies your cloud resource.",
+			},
+		},
+	}
+}
+
+func DataSourceIbmKeyInventoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ukoClient, err := meta.(conns.ClientSession).UkoV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	instance_id := d.Get("instance_id").(string)
+	vaultIDs := dataSourceIbmKeyInventoryFilter(d, "vault_ids")
+	states := dataSourceIbmKeyInventoryFilter(d, "states")
+	algorithms := dataSourceIbmKeyInventoryFilter(d, "algorithms")
+
+	url, err := getUkoUrl(context, region, instance_id, ukoClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ukoClient.SetServiceURL(url)
+
+	// The filters are sent to the API and the inventory lists every page of the matching keystores and
+	// managed keys. The results are matched again because a keystore or key without a vault must not
+	// be listed when the vaults are filtered.
+	keystores := []map[string]interface{}{}
+	for offset := int64(0); ; offset += ukoInventoryPageSize {
+		listKeystoresOptions := &ukov4.ListKeystoresOptions{}
+		listKeystoresOptions.Limit = core.Int64Ptr(ukoInventoryPageSize)
+		listKeystoresOptions.Offset = core.Int64Ptr(offset)
+		if len(vaultIDs) > 0 {
+			listKeystoresOptions.VaultID = vaultIDs
+		}
+		result, response, err := ukoClient.ListKeystoresWithContext(context, listKeystoresOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListKeystoresWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListKeystoresWithContext failed %s\n%s", err, response))
+		}
+		for _, keystoreIntf := range result.Keystores {
+			keystore, ok := keystoreIntf.(*ukov4.Keystore)
+			if !ok || !dataSourceIbmKeyInventoryMatch(vaultIDs, dataSourceIbmKeyInventoryVaultID(keystore.Vault)) {
+				continue
+			}
+			keystores = append(keystores, dataSourceIbmKeyInventoryKeystoreToMap(keystore))
+		}
+		if len(result.Keystores) < ukoInventoryPageSize || (result.TotalCount != nil && offset+ukoInventoryPageSize >= *result.TotalCount) {
+			break
+		}
+	}
+	if err = d.Set("keystores", keystores); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting keystores %s", err))
+	}
+
+	managedKeys := []map[string]interface{}{}
+	for offset := int64(0); ; offset += ukoInventoryPageSize {
+		listManagedKeysOptions := &ukov4.ListManagedKeysOptions{}
+		listManagedKeysOptions.Limit = core.Int64Ptr(ukoInventoryPageSize)
+		listManagedKeysOptions.Offset = core.Int64Ptr(offset)
+		if len(vaultIDs) > 0 {
+			listManagedKeysOptions.VaultID = vaultIDs
+		}
+		if len(states) > 0 {
+			listManagedKeysOptions.State = states
+		}
+		if len(algorithms) > 0 {
+			listManagedKeysOptions.Algorithm = algorithms
+		}
+		result, response, err := ukoClient.ListManagedKeysWithContext(context, listManagedKeysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListManagedKeysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListManagedKeysWithContext failed %s\n%s", err, response))
+		}
+		for i := range result.ManagedKeys {
+			managedKey := &result.ManagedKeys[i]
+			if !dataSourceIbmKeyInventoryMatch(vaultIDs, dataSourceIbmKeyInventoryVaultID(managedKey.Vault)) {
+				continue
+			}
+			if !dataSourceIbmKeyInventoryMatch(states, managedKey.State) || !dataSourceIbmKeyInventoryMatch(algorithms, managedKey.Algorithm) {
+				continue
+			}
+			managedKeys = append(managedKeys, dataSourceIbmKeyInventoryManagedKeyToMap(managedKey))
+		}
+		if len(result.ManagedKeys) < ukoInventoryPageSize || (result.TotalCount != nil && offset+ukoInventoryPageSize >= *result.TotalCount) {
+			break
+		}
+	}
+	if err = d.Set("managed_keys", managedKeys); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting managed_keys %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", region, instance_id))
+
+	return nil
+}
+
+// dataSourceIbmKeyInventoryFilter returns the lowercased values of a filter argument.
+func dataSourceIbmKeyInventoryFilter(d *schema.ResourceData, key string) []string {
+	values := []string{}
+	for _, v := range d.Get(key).([]interface{}) {
+		values = append(values, strings.ToLower(v.(string)))
+	}
+	return values
+}
+
+// dataSourceIbmKeyInventoryVaultID returns the ID of a vault reference, or nil without a vault.
+func dataSourceIbmKeyInventoryVaultID(vault *ukov4.VaultReference) *string {
+	if vault == nil {
+		return nil
+	}
+	return vault.ID
+}
+
+// dataSourceIbmKeyInventoryMatch tells whether a value matches a filter, an empty filter matches
+// every value.
+func dataSourceIbmKeyInventoryMatch(filter []string, value *string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	for _, f := range filter {
+		if f == strings.ToLower(*value) {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceIbmKeyInventoryKeystoreToMap(keystore *ukov4.Keystore) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if keystore.ID != nil {
+		modelMap["id"] = *keystore.ID
+	}
+	if keystore.Name != nil {
+		modelMap["name"] = *keystore.Name
+	}
+	if keystore.Type != nil {
+		modelMap["type"] = *keystore.Type
+	}
+	if keystore.Location != nil {
+		modelMap["location"] = *keystore.Location
+	}
+	if keystore.Description != nil {
+		modelMap["description"] = *keystore.Description
+	}
+	if keystore.Vault != nil {
+		vault, _ := DataSourceIbmKeystoreVaultReferenceToMap(keystore.Vault)
+		modelMap["vault"] = []map[string]interface{}{vault}
+	}
+	modelMap["created_at"] = flex.DateTimeToString(keystore.CreatedAt)
+	modelMap["updated_at"] = flex.DateTimeToString(keystore.UpdatedAt)
+	if keystore.Href != nil {
+		modelMap["href"] = *keystore.Href
+	}
+	return modelMap
+}
+
+func dataSourceIbmKeyInventoryManagedKeyToMap(managedKey *ukov4.ManagedKey) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if managedKey.ID != nil {
+		modelMap["id"] = *managedKey.ID
+	}
+	if managedKey.Label != nil {
+		modelMap["label"] = *managedKey.Label
+	}
+	if managedKey.State != nil {
+		modelMap["state"] = *managedKey.State
+	}
+	if managedKey.Algorithm != nil {
+		modelMap["algorithm"] = *managedKey.Algorithm
+	}
+	if managedKey.Size != nil {
+		modelMap["size"] = *managedKey.Size
+	}
+	if managedKey.Vault != nil {
+		vault, _ := DataSourceIbmManagedKeyVaultReferenceToMap(managedKey.Vault)
+		modelMap["vault"] = []map[string]interface{}{vault}
+	}
+	if managedKey.Template != nil {
+		template, _ := DataSourceIbmManagedKeyTemplateReferenceToMap(managedKey.Template)
+		modelMap["template"] = []map[string]interface{}{template}
+	}
+	referencedKeystores := []map[string]interface{}{}
+	for i := range managedKey.ReferencedKeystores {
+		keystore, _ := DataSourceIbmManagedKeyTargetKeystoreReferenceToMap(&managedKey.ReferencedKeystores[i])
+		referencedKeystores = append(referencedKeystores, keystore)
+	}
+	modelMap["referenced_keystores"] = referencedKeystores
+	modelMap["activation_date"] = flex.DateToString(managedKey.ActivationDate)
+	modelMap["expiration_date"] = flex.DateToString(managedKey.ExpirationDate)
+	modelMap["created_at"] = flex.DateTimeToString(managedKey.CreatedAt)
+	modelMap["updated_at"] = flex.DateTimeToString(managedKey.UpdatedAt)
+	if managedKey.Href != nil {
+		modelMap["href"] = *managedKey.Href
+	}
+	return modelMap
+}
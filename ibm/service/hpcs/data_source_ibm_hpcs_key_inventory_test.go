@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmHpcsKeyInventoryDataSourceVaultFilter(t *testing.T) {
+	vaultName := fmt.Sprintf("tf-inventory-vault-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// a new vault has no keystores and no managed keys, the keystores and keys of the
+				// other vaults and those without a vault are filtered out
+				Config: testAccCheckIbmHpcsKeyInventoryDataSourceConfig(vaultName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_hpcs_key_inventory.inventory", "id"),
+					resource.TestCheckResourceAttr("data.ibm_hpcs_key_inventory.inventory", "keystores.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_hpcs_key_inventory.inventory", "managed_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmHpcsKeyInventoryDataSourceConfig(vaultName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_hpcs_vault" "vault" {
+		instance_id = "%[1]s"
+		region      = "us-east"
+		name        = "%[2]s"
+	}
+
+	data "ibm_hpcs_key_inventory" "inventory" {
+		instance_id = "%[1]s"
+		region      = "us-east"
+		vault_ids   = [ibm_hpcs_vault.vault.vault_id]
+		states      = ["active"]
+		algorithms  = ["aes"]
+	}
+	`, acc.HpcsInstanceID, vaultName)
+}
@@ -37,3 +37,29 @@ func testAccCheckIBMHPCSDatasourceConfig(instanceName string) string {
 	`, instanceName)
 
 }
+
+func TestAccIBMHPCSDatasourceWithoutHSMInfo(t *testing.T) {
+	instanceName := acc.HpcsInstanceName
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMHPCSDatasourceWithoutHSMInfoConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_hpcs.hpcs", "name", instanceName),
+					resource.TestCheckResourceAttr("data.ibm_hpcs.hpcs", "hsm_info.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMHPCSDatasourceWithoutHSMInfoConfig(instanceName string) string {
+	return fmt.Sprintf(`
+	data "ibm_hpcs" "hpcs" {
+		name           = "%s"
+		fetch_hsm_info = false
+	}
+	`, instanceName)
+}
@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/ibm-hpcs-tke-sdk/tkesdk"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -49,6 +52,7 @@ func ResourceIBMHPCS() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceIBMHPCSAdminsCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -199,23 +203,27 @@ func ResourceIBMHPCS() *schema.Resource {
 				Description: "The extended metadata as a map associated with the HPCS instance.",
 			},
 			"signature_server_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "URL of signing service",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of signing service",
 			},
 			"signature_threshold": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Signature Threshold Value",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, hpcsMaxAdmins),
+				Description:  "Signature Threshold Value",
 			},
 			"revocation_threshold": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Revocation Threshold Value",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, hpcsMaxAdmins),
+				Description:  "Revocation Threshold Value",
 			},
 			"admins": {
 				Type:        schema.TypeSet,
 				Required:    true,
+				MaxItems:    hpcsMaxAdmins,
 				Description: "Crypto Unit Administrators",
 				Set:         resourceIBMHPCSAdminHash,
 				Elem: &schema.Resource{
@@ -228,7 +236,7 @@ func ResourceIBMHPCS() *schema.Resource {
 						"key": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The administrator signature key",
+							Description: "The administrator signature key, the path of the signature key file, or the name of the signature key in the signing service when signature_server_url is set",
 						},
 						"token": {
 							Type:        schema.TypeString,
@@ -315,6 +323,37 @@ type HPCSParams struct {
 	ServiceEndpoints      string `json:"allowed_network,omitempty"`
 }
 
+// hpcsMaxAdmins is the maximum number of administrators of the crypto units, and the maximum of
+// their signature and revocation thresholds.
+const hpcsMaxAdmins = 8
+
+// hpcsSigningServiceKeyRegexp matches the names of the signature keys of a signing service, they are
+// appended to the URI sent to the signing service and must only use the unreserved characters of
+// RFC 3986.
+var hpcsSigningServiceKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9._~-]+$`)
+
+func resourceIBMHPCSAdminsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("admins") {
+		return nil
+	}
+	admins := diff.Get("admins").(*schema.Set).List()
+	for _, threshold := range []string{"signature_threshold", "revocation_threshold"} {
+		if t := diff.Get(threshold).(int); diff.NewValueKnown(threshold) && t > len(admins) {
+			return fmt.Errorf("[ERROR] %s is %d, the crypto units need at least as many admins, got %d", threshold, t, len(admins))
+		}
+	}
+	if diff.Get("signature_server_url").(string) == "" {
+		return nil
+	}
+	for _, a := range admins {
+		admin := a.(map[string]interface{})
+		if key := admin["key"].(string); key != "" && !hpcsSigningServiceKeyRegexp.MatchString(key) {
+			return fmt.Errorf("[ERROR] The key %q of the admin %q must be the name of a signature key of the signing service, with only the unreserved characters of RFC 3986", key, admin["name"])
+		}
+	}
+	return nil
+}
+
 func ResourceIBMHPCSValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	validateSchema = append(validateSchema,
@@ -658,13 +697,6 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	// Initialise HPCS Crypto Units
 
 	if d.HasChange("signature_threshold") || d.HasChange("revocation_threshold") || d.HasChange("admins") || d.HasChange("signature_server_url") {
-		if url, ok := d.GetOk("signature_server_url"); ok {
-			serverURL := url.(string)
-			err := os.Setenv("TKE_SIGNSERV_URL", serverURL)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
 		hsm_config := expandHSMConfig(d, meta)
 		// Bluemix Session to get Oauth tokens
 		ci, err := hsmClient(d, meta)
@@ -673,21 +705,27 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 		ci.InstanceId = *instance.GUID
 
-		// Check Transitions
-		problems, err := tkesdk.CheckTransition(ci, hsm_config)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Checking Transitions: %s", err))
-		}
-		if len(problems) != 0 {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Checking Transitions: %v", problems))
-		}
-		// Update / Initialize Crypto Units
-		hsmDetails, err := tkesdk.Update(ci, hsm_config)
+		err = withHPCSSigningService(d, func() error {
+			// Check Transitions
+			problems, err := tkesdk.CheckTransition(ci, hsm_config)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Checking Transitions: %s", err)
+			}
+			if len(problems) != 0 {
+				return fmt.Errorf("[ERROR] Error Checking Transitions: %v", problems)
+			}
+			// Update / Initialize Crypto Units
+			hsmDetails, err := tkesdk.Update(ci, hsm_config)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Updating Crypto Units: %s", err)
+			}
+			if len(hsmDetails) != 0 {
+				return fmt.Errorf("[ERROR] Error Updating Crypto Units..One or more problems were found during initial checks: %v", hsmDetails)
+			}
+			return nil
+		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Crypto Units: %s", err))
-		}
-		if len(hsmDetails) != 0 {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Crypto Units..One or more problems were found during initial checks: %v", hsmDetails))
+			return diag.FromErr(err)
 		}
 	}
 	return resourceIBMHPCSRead(context, d, meta)
@@ -735,16 +773,11 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 	ci.InstanceId = *instance.GUID
-	if url, ok := d.GetOk("signature_server_url"); ok {
-		serverURL := url.(string)
-		err := os.Setenv("TKE_SIGNSERV_URL", serverURL)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
 	// Zeroize Crypto Units
 	hsm := expandHSMConfig(d, meta)
-	err = tkesdk.Zeroize(ci, hsm)
+	err = withHPCSSigningService(d, func() error {
+		return tkesdk.Zeroize(ci, hsm)
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Zeroizing Crypto Units: %s", err))
 	}
@@ -890,6 +923,38 @@ func validateHSM(hsmInfo []tkesdk.HsmInfo) bool {
 	}
 	return update
 }
+
+// hpcsSigningServiceMutex serializes the crypto unit operations, the TKE SDK reads the URL of the
+// signing service from the environment of the process, which the instances changed in parallel
+// share.
+var hpcsSigningServiceMutex sync.Mutex
+
+// withHPCSSigningService calls fn with the TKE SDK pointed to the signing service of the instance,
+// when signature_server_url is set. The previous value of TKE_SIGNSERV_URL is restored afterwards,
+// so that the other instances, and the instances that do not set signature_server_url, keep the
+// environment of the provider.
+func withHPCSSigningService(d *schema.ResourceData, fn func() error) error {
+	hpcsSigningServiceMutex.Lock()
+	defer hpcsSigningServiceMutex.Unlock()
+
+	url, ok := d.GetOk("signature_server_url")
+	if !ok {
+		return fn()
+	}
+	previous, set := os.LookupEnv("TKE_SIGNSERV_URL")
+	if err := os.Setenv("TKE_SIGNSERV_URL", url.(string)); err != nil {
+		return err
+	}
+	defer func() {
+		if set {
+			os.Setenv("TKE_SIGNSERV_URL", previous)
+		} else {
+			os.Unsetenv("TKE_SIGNSERV_URL")
+		}
+	}()
+	return fn()
+}
+
 func hsmClient(d *schema.ResourceData, meta interface{}) (tkesdk.CommonInputs, error) {
 	ci := tkesdk.CommonInputs{}
 	// Bluemix Session to get Oauth tokens
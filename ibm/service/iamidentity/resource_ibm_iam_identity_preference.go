@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func ResourceIBMIamIdentityPreference() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIamIdentityPreferenceCreate,
+		ReadContext:   resourceIBMIamIdentityPreferenceRead,
+		UpdateContext: resourceIBMIamIdentityPreferenceUpdate,
+		DeleteContext: resourceIBMIamIdentityPreferenceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Account id to update preference for. Defaults to the account of the provider credentials.",
+			},
+			"iam_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IAM id of the user or trusted profile to update the preference for.",
+			},
+			"service": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_identity_preference", "service"),
+				Description:  "Service of the preference to be updated, for example `console`.",
+			},
+			"preference_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_identity_preference", "preference_id"),
+				Description:  "Identifier of the preference to be updated, for example `landing_page` or `global_left_navigation`.",
+			},
+			"value_string": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value_string", "value_list_of_strings"},
+				Description:  "String value of the preference.",
+			},
+			"value_list_of_strings": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of values of the preference.",
+			},
+			"scope": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope of the preference.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIamIdentityPreferenceValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "service",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-z0-9_-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             64,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "preference_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-z0-9_-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             64,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_iam_identity_preference", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIamIdentityPreferenceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "create", "get-user-details").GetDiag()
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", accountID, d.Get("iam_id").(string), d.Get("service").(string), d.Get("preference_id").(string)))
+
+	diags := resourceIBMIamIdentityPreferenceUpdatePreference(context, d, meta, "create")
+	if diags != nil {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceIBMIamIdentityPreferenceRead(context, d, meta)
+}
+
+func resourceIBMIamIdentityPreferenceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "sep-id-parts").GetDiag()
+	}
+	if len(parts) != 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of accountID/iamID/service/preferenceID", d.Id())
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "sep-id-parts").GetDiag()
+	}
+
+	getPreferencesOnScopeAccountOptions := &iamidentityv1.GetPreferencesOnScopeAccountOptions{}
+	getPreferencesOnScopeAccountOptions.SetAccountID(parts[0])
+	getPreferencesOnScopeAccountOptions.SetIamID(parts[1])
+	getPreferencesOnScopeAccountOptions.SetService(parts[2])
+	getPreferencesOnScopeAccountOptions.SetPreferenceID(parts[3])
+
+	preference, response, err := iamIdentityClient.GetPreferencesOnScopeAccountWithContext(context, getPreferencesOnScopeAccountOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetPreferencesOnScopeAccountWithContext failed: %s", err.Error()), "ibm_iam_identity_preference", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set("account_id", parts[0]); err != nil {
+		err = fmt.Errorf("Error setting account_id: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-account_id").GetDiag()
+	}
+	if err = d.Set("iam_id", parts[1]); err != nil {
+		err = fmt.Errorf("Error setting iam_id: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-iam_id").GetDiag()
+	}
+	if err = d.Set("service", parts[2]); err != nil {
+		err = fmt.Errorf("Error setting service: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-service").GetDiag()
+	}
+	if err = d.Set("preference_id", parts[3]); err != nil {
+		err = fmt.Errorf("Error setting preference_id: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-preference_id").GetDiag()
+	}
+	if !core.IsNil(preference.ValueString) {
+		if err = d.Set("value_string", preference.ValueString); err != nil {
+			err = fmt.Errorf("Error setting value_string: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-value_string").GetDiag()
+		}
+	}
+	if preference.ValueListOfStrings != nil {
+		if err = d.Set("value_list_of_strings", preference.ValueListOfStrings); err != nil {
+			err = fmt.Errorf("Error setting value_list_of_strings: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-value_list_of_strings").GetDiag()
+		}
+	}
+	if err = d.Set("scope", preference.Scope); err != nil {
+		err = fmt.Errorf("Error setting scope: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "read", "set-scope").GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIBMIamIdentityPreferenceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("value_string", "value_list_of_strings") {
+		if diags := resourceIBMIamIdentityPreferenceUpdatePreference(context, d, meta, "update"); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMIamIdentityPreferenceRead(context, d, meta)
+}
+
+func resourceIBMIamIdentityPreferenceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "delete", "sep-id-parts").GetDiag()
+	}
+	if len(parts) != 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of accountID/iamID/service/preferenceID", d.Id())
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", "delete", "sep-id-parts").GetDiag()
+	}
+
+	deletePreferencesOnScopeAccountOptions := &iamidentityv1.DeletePreferencesOnScopeAccountOptions{}
+	deletePreferencesOnScopeAccountOptions.SetAccountID(parts[0])
+	deletePreferencesOnScopeAccountOptions.SetIamID(parts[1])
+	deletePreferencesOnScopeAccountOptions.SetService(parts[2])
+	deletePreferencesOnScopeAccountOptions.SetPreferenceID(parts[3])
+
+	response, err := iamIdentityClient.DeletePreferencesOnScopeAccountWithContext(context, deletePreferencesOnScopeAccountOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeletePreferencesOnScopeAccountWithContext failed: %s", err.Error()), "ibm_iam_identity_preference", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMIamIdentityPreferenceUpdatePreference sets the value of the preference
+// identified by the resource ID. The API has no create call, preferences are
+// created by their first update.
+func resourceIBMIamIdentityPreferenceUpdatePreference(context context.Context, d *schema.ResourceData, meta interface{}, operation string) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", operation, "sep-id-parts").GetDiag()
+	}
+	if len(parts) != 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of accountID/iamID/service/preferenceID", d.Id())
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_iam_identity_preference", operation, "sep-id-parts").GetDiag()
+	}
+
+	updatePreferenceOnScopeAccountOptions := &iamidentityv1.UpdatePreferenceOnScopeAccountOptions{}
+	updatePreferenceOnScopeAccountOptions.SetAccountID(parts[0])
+	updatePreferenceOnScopeAccountOptions.SetIamID(parts[1])
+	updatePreferenceOnScopeAccountOptions.SetService(parts[2])
+	updatePreferenceOnScopeAccountOptions.SetPreferenceID(parts[3])
+	if _, ok := d.GetOk("value_string"); ok {
+		updatePreferenceOnScopeAccountOptions.SetValueString(d.Get("value_string").(string))
+	}
+	if _, ok := d.GetOk("value_list_of_strings"); ok {
+		updatePreferenceOnScopeAccountOptions.SetValueListOfStrings(flex.ExpandStringList(d.Get("value_list_of_strings").([]interface{})))
+	}
+
+	_, _, err = iamIdentityClient.UpdatePreferenceOnScopeAccountWithContext(context, updatePreferenceOnScopeAccountOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdatePreferenceOnScopeAccountWithContext failed: %s", err.Error()), "ibm_iam_identity_preference", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
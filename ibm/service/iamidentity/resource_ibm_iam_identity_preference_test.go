@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func TestAccIBMIamIdentityPreferenceBasic(t *testing.T) {
+	profileName := fmt.Sprintf("tf_profile_%d", acctest.RandIntRange(10, 100))
+	landingPage := "/billing"
+	landingPageUpdate := "/resources"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIamIdentityPreferenceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamIdentityPreferenceConfigBasic(profileName, landingPage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIamIdentityPreferenceExists("ibm_iam_identity_preference.iam_identity_preference"),
+					resource.TestCheckResourceAttr("ibm_iam_identity_preference.iam_identity_preference", "service", "console"),
+					resource.TestCheckResourceAttr("ibm_iam_identity_preference.iam_identity_preference", "preference_id", "landing_page"),
+					resource.TestCheckResourceAttr("ibm_iam_identity_preference.iam_identity_preference", "value_string", landingPage),
+					resource.TestCheckResourceAttrSet("ibm_iam_identity_preference.iam_identity_preference", "account_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIamIdentityPreferenceConfigBasic(profileName, landingPageUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_identity_preference.iam_identity_preference", "value_string", landingPageUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_identity_preference.iam_identity_preference",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamIdentityPreferenceConfigBasic(profileName string, landingPage string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "iam_trusted_profile" {
+			name = "%s"
+		}
+		resource "ibm_iam_identity_preference" "iam_identity_preference" {
+			iam_id = ibm_iam_trusted_profile.iam_trusted_profile.iam_id
+			service = "console"
+			preference_id = "landing_page"
+			value_string = "%s"
+		}
+	`, profileName, landingPage)
+}
+
+func testAccCheckIBMIamIdentityPreferenceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getPreferencesOnScopeAccountOptions := &iamidentityv1.GetPreferencesOnScopeAccountOptions{}
+		getPreferencesOnScopeAccountOptions.SetAccountID(parts[0])
+		getPreferencesOnScopeAccountOptions.SetIamID(parts[1])
+		getPreferencesOnScopeAccountOptions.SetService(parts[2])
+		getPreferencesOnScopeAccountOptions.SetPreferenceID(parts[3])
+
+		_, _, err = iamIdentityClient.GetPreferencesOnScopeAccount(getPreferencesOnScopeAccountOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMIamIdentityPreferenceDestroy(s *terraform.State) error {
+	iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_identity_preference" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getPreferencesOnScopeAccountOptions := &iamidentityv1.GetPreferencesOnScopeAccountOptions{}
+		getPreferencesOnScopeAccountOptions.SetAccountID(parts[0])
+		getPreferencesOnScopeAccountOptions.SetIamID(parts[1])
+		getPreferencesOnScopeAccountOptions.SetService(parts[2])
+		getPreferencesOnScopeAccountOptions.SetPreferenceID(parts[3])
+
+		_, response, err := iamIdentityClient.GetPreferencesOnScopeAccount(getPreferencesOnScopeAccountOptions)
+
+		if err == nil {
+			return fmt.Errorf("iam_identity_preference still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for iam_identity_preference (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
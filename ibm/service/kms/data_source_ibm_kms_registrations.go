@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSRegistrations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMKMSRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The id of the key to list the registrations of, the registrations of all the keys of the instance are listed by default",
+			},
+			"crn_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only list the registrations of the cloud resources whose CRN matches one of these patterns, the patterns can contain the wildcard *",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Registrations of the keys across different services",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the key being used in the registration",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource tied to the key registration",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the registration of the key prevents a deletion.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// default page size of the registrations and keys APIs
+const kmsRegistrationsPageSize = 200
+
+func dataSourceIBMKMSRegistrationsRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	api, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	crnFilters := []string{""}
+	if v, ok := d.GetOk("crn_filters"); ok {
+		crnFilters = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	seen := make(map[string]bool)
+	registrationMap := make([]map[string]interface{}, 0)
+	addRegistrations := func(keyID, crnFilter string) (int, error) {
+		registrations, err := api.ListRegistrations(context.Background(), keyID, crnFilter)
+		if err != nil {
+			return 0, err
+		}
+		for _, r := range registrations.Registrations {
+			// The CRN filters may overlap, every registration is listed once
+			if seen[r.KeyID+r.ResourceCrn] {
+				continue
+			}
+			seen[r.KeyID+r.ResourceCrn] = true
+			registration := map[string]interface{}{
+				"key_id":               r.KeyID,
+				"resource_crn":         r.ResourceCrn,
+				"prevent_key_deletion": r.PreventKeyDeletion,
+			}
+			registrationMap = append(registrationMap, registration)
+		}
+		return len(registrations.Registrations), nil
+	}
+
+	// The registrations of the instance, or of the key, are listed with one request per CRN filter.
+	// The client has no paging for registrations, when a request returns a full page of the API the
+	// registrations are listed again key by key with the keys of the instance paged.
+	truncated := false
+	for _, crnFilter := range crnFilters {
+		count, err := addRegistrations(d.Get("key_id").(string), crnFilter)
+		if err != nil {
+			return flex.FmtErrorf("[ERROR] List Registrations failed with error: %s", err)
+		}
+		if count >= kmsRegistrationsPageSize {
+			truncated = true
+		}
+	}
+
+	if _, ok := d.GetOk("key_id"); !ok && truncated {
+		offset := 0
+		for {
+			keys, err := api.GetKeys(context.Background(), kmsRegistrationsPageSize, offset)
+			if err != nil {
+				return flex.FmtErrorf("[ERROR] Get Keys failed with error: %s", err)
+			}
+			for _, key := range keys.Keys {
+				for _, crnFilter := range crnFilters {
+					if _, err := addRegistrations(key.ID, crnFilter); err != nil {
+						return flex.FmtErrorf("[ERROR] List Registrations of the key %s failed with error: %s", key.ID, err)
+					}
+				}
+			}
+			if keys.Metadata.NumberOfKeys < kmsRegistrationsPageSize {
+				break
+			}
+			offset = offset + kmsRegistrationsPageSize
+		}
+	}
+
+	d.SetId(instanceID)
+	d.Set("registrations", registrationMap)
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", d.Get("endpoint_type").(string))
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSRegistrationsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsRegistrationsDataSourceConfig(instanceName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_registrations.test", "registrations.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_kms_registrations.filtered", "registrations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsRegistrationsDataSourceConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = "${ibm_resource_instance.kms_instance.guid}"
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	data "ibm_kms_registrations" "test" {
+		instance_id = "${ibm_kms_key.test.instance_id}"
+		key_id      = "${ibm_kms_key.test.key_id}"
+	}
+	data "ibm_kms_registrations" "filtered" {
+		instance_id = "${ibm_kms_key.test.instance_id}"
+		crn_filters = ["crn:v1:bluemix:public:cloud-object-storage:*"]
+	}
+`, addPrefixToResourceName(instanceName), keyName)
+}
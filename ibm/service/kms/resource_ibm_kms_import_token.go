@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsImportToken() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsImportTokenCreate,
+		Read:     resourceIBMKmsImportTokenRead,
+		Delete:   resourceIBMKmsImportTokenDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: validate.ValidateAllowedRangeInt(300, 86400),
+				Description:  "The time in seconds from the creation of the import token that determines how long its associated public key remains valid, with a minimum of 300 and a maximum of 86400",
+			},
+			"max_allowed_retrievals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 500),
+				Description:  "The number of times that the public key of the import token can be retrieved, with a minimum of 1 and a maximum of 500",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token was created",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token expires, the key material must be imported before it",
+			},
+			"transport_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded public key that encrypts the key material to import",
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded nonce to encrypt with the key material, to verify the import request",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsImportTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = kpAPI.CreateImportToken(context.Background(), d.Get("expiration").(int), d.Get("max_allowed_retrievals").(int))
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while creating import token: %s", err)
+	}
+	// Retrieving the public key counts as one of the max_allowed_retrievals, the import token is
+	// only retrieved once and kept in the state.
+	transportKey, err := kpAPI.GetImportTokenTransportKey(context.Background())
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while retrieving import token: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:importToken:%s", transportKey.ID, *instanceCRN))
+	if transportKey.CreationDate != nil {
+		d.Set("creation_date", transportKey.CreationDate.Format(time.RFC3339))
+	}
+	if transportKey.ExpirationDate != nil {
+		d.Set("expiration_date", transportKey.ExpirationDate.Format(time.RFC3339))
+	}
+	d.Set("transport_key", transportKey.Payload)
+	d.Set("nonce", transportKey.Nonce)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+
+	return resourceIBMKmsImportTokenRead(d, meta)
+}
+
+func resourceIBMKmsImportTokenRead(d *schema.ResourceData, meta interface{}) error {
+	id := strings.Split(d.Id(), ":importToken:")
+	if len(id) < 2 {
+		return flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be a combination of importTokenID:importToken:InstanceCRN", d.Id())
+	}
+	d.Set("instance_id", getInstanceIDFromCRN(id[1]))
+
+	// Every retrieval of the import token is counted, it is not read again. An expired token stays
+	// in the state, the keys imported with it are not affected.
+	if e, ok := d.GetOk("expiration_date"); ok {
+		if expiration, err := time.Parse(time.RFC3339, e.(string)); err == nil && time.Now().After(expiration) {
+			log.Printf("[WARN] The import token %s expired on %s", id[0], e)
+		}
+	}
+	return nil
+}
+
+func resourceIBMKmsImportTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	// Import tokens cannot be deleted, they expire
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,43 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSResource_Import_Token(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: buildResourceSet(WithResourceKMSInstance(instanceName), WithResourceKMSImportToken(1200, 2)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_import_token.test", "expiration", "1200"),
+					resource.TestCheckResourceAttr("ibm_kms_import_token.test", "max_allowed_retrievals", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.test", "transport_key"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.test", "nonce"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.test", "expiration_date"),
+				),
+			},
+		},
+	})
+}
+
+func WithResourceKMSImportToken(expiration, maxAllowedRetrievals int) CreateResourceOption {
+	return func(resources *string) {
+		*resources += fmt.Sprintf(`
+		resource "ibm_kms_import_token" "test" {
+			instance_id = ibm_resource_instance.kms_instance.guid
+			expiration = %d
+			max_allowed_retrievals = %d
+		}`, expiration, maxAllowedRetrievals)
+	}
+}
@@ -61,9 +61,8 @@ func ResourceIBMKmskey() *schema.Resource {
 			"key_ring_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     "default",
-				Description: "Key Ring for the Key",
+				Description: "Key Ring for the Key, changing it moves the key to the new key ring",
 			},
 			"key_id": {
 				Type:        schema.TypeString,
@@ -109,16 +108,18 @@ func ResourceIBMKmskey() *schema.Resource {
 				ForceNew:  true,
 			},
 			"encrypted_nonce": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Only for imported root key",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "iv_value"},
+				Description:  "Only for imported root key, the nonce of the import token encrypted with the key material",
 			},
 			"iv_value": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Only for imported root key",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "encrypted_nonce"},
+				Description:  "Only for imported root key, the initialization vector used to encrypt the nonce",
 			},
 			"force_delete": {
 				Type:        schema.TypeBool,
@@ -236,10 +237,32 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if err := moveKMSKeyToKeyRing(d, meta); err != nil {
+		return err
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
 
+// Move the key to its new key ring, the key keeps its ID, CRN, policies and aliases
+func moveKMSKeyToKeyRing(d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() || !d.HasChange("key_ring_id") {
+		return nil
+	}
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+	oldKeyRing, newKeyRing := d.GetChange("key_ring_id")
+	kpAPI.Config.KeyRing = oldKeyRing.(string)
+	_, err = kpAPI.SetKeyRing(context.Background(), keyid, newKeyRing.(string))
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while moving the key %s from the key ring %s to %s: %s", keyid, oldKeyRing, newKeyRing, err)
+	}
+	return nil
+}
+
 func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
 	kpAPI, _, err := populateKPClient(d, meta, instanceID)
@@ -53,6 +53,43 @@ func TestAccIBMKMSResource_Key_Ring_Key(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_Key_Ring_Move_Key(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyRing := fmt.Sprintf("keyRing%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	var keyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: buildResourceSet(WithResourceKMSInstance(instanceName), WithResourceKMSKeyRing(keyRing, true), WithResourceKMSKey(keyName, "default")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_ring_id", "default"),
+					resource.TestCheckResourceAttrWith("ibm_kms_key.test", "key_id", func(value string) error {
+						keyID = value
+						return nil
+					}),
+				),
+			},
+			// Moving the key to another key ring keeps the key
+			{
+				Config: buildResourceSet(WithResourceKMSInstance(instanceName), WithResourceKMSKeyRing(keyRing, true), WithResourceKMSKey(keyName, "ibm_kms_key_rings.test.key_ring_id")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_ring_id", keyRing),
+					resource.TestCheckResourceAttrWith("ibm_kms_key.test", "key_id", func(value string) error {
+						if value != keyID {
+							return fmt.Errorf("the key was replaced, key_id changed from %s to %s", keyID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMKMSResource_Key_Ring_Not_Exist(t *testing.T) {
 	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
 	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
@@ -44,9 +44,8 @@ func ResourceIBMKmsKeyWithPolicyOverrides() *schema.Resource {
 			"key_ring_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     "default",
-				Description: "Key Ring for the Key",
+				Description: "Key Ring for the Key, changing it moves the key to the new key ring",
 			},
 			"key_id": {
 				Type:        schema.TypeString,
@@ -258,6 +257,9 @@ func resourceIBMKmsKeyWithPolicyOverridesUpdate(context context.Context, d *sche
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if err := moveKMSKeyToKeyRing(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("rotation") || d.HasChange("dual_auth_delete") {
 		_, rotationOk := d.GetOk("rotation")
 		_, dualAuthOk := d.GetOk("dual_auth_delete")
@@ -10,6 +10,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/logs-go-sdk/logsv0"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -54,7 +55,7 @@ func getClientWithLogsInstanceEndpoint(originalClient *logsv0.LogsV0, meta inter
 	}
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return originalClient, fmt.Errorf("bxsession error: %s  id: $s", err)
+		return originalClient, fmt.Errorf("bxsession error: %s id: %s", err, instanceId)
 	}
 
 	var privateEndpointType string
@@ -115,9 +116,10 @@ func AddLogsInstanceFields(resource *schema.Resource) *schema.Resource {
 		Description: "The region of the logs instance.",
 	}
 	resource.Schema["endpoint_type"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "public or private.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+		Description:  "public or private.",
 	}
 
 	return resource
@@ -26,6 +26,8 @@ func ResourceIBMMetricsRouterRoute() *schema.Resource {
 		DeleteContext: resourceIBMMetricsRouterRouteDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMMetricsRouterRouteCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -64,7 +66,7 @@ func ResourceIBMMetricsRouterRoute() *schema.Resource {
 						"inclusion_filters": &schema.Schema{
 							Type:        schema.TypeList,
 							Optional:    true,
-							Description: "A list of conditions to be satisfied for routing metrics to pre-defined target.",
+							Description: "A list of conditions to be satisfied for routing metrics to pre-defined target. Combine with the `drop` action to exclude metrics instead.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"operand": &schema.Schema{
@@ -158,6 +160,46 @@ func ResourceIBMMetricsRouterRouteValidator() *validate.ResourceValidator {
 	return &resourceValidator
 }
 
+func resourceIBMMetricsRouterRouteCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rules") {
+		return nil
+	}
+	return validateMetricsRouterRouteRules(diff.Get("rules").([]interface{}))
+}
+
+// validateMetricsRouterRouteRules checks the number of values of each inclusion filter against its operator
+func validateMetricsRouterRouteRules(rules []interface{}) error {
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok || rule["inclusion_filters"] == nil {
+			continue
+		}
+		for j, f := range rule["inclusion_filters"].([]interface{}) {
+			filter, ok := f.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			values := filter["values"].([]interface{})
+			for _, v := range values {
+				if v == nil || v.(string) == "" {
+					return fmt.Errorf("rules.%d.inclusion_filters.%d: values cannot be empty", i, j)
+				}
+			}
+			switch filter["operator"] {
+			case "is":
+				if len(values) != 1 {
+					return fmt.Errorf("rules.%d.inclusion_filters.%d: operator 'is' requires exactly one value, got %d", i, j, len(values))
+				}
+			case "in":
+				if len(values) < 1 || len(values) > 20 {
+					return fmt.Errorf("rules.%d.inclusion_filters.%d: operator 'in' requires 1 to 20 values, got %d", i, j, len(values))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func resourceIBMMetricsRouterRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metricsRouterClient, err := meta.(conns.ClientSession).MetricsRouterV3()
 	if err != nil {
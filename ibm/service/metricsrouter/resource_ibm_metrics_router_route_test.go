@@ -145,6 +145,43 @@ func TestAccIBMMetricsRouterRouteSendNoTarget(t *testing.T) {
 	})
 }
 
+func TestAccIBMMetricsRouterRouteIsOperatorMultipleValues(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMMetricsRouterRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMMetricsRouterRouteFilterValues(name, "is", `["worker", "master"]`),
+				ExpectError: regexp.MustCompile("operator 'is' requires exactly one value"),
+			},
+		},
+	})
+}
+
+func TestAccIBMMetricsRouterRouteDropWithInOperator(t *testing.T) {
+	var conf metricsrouterv3.Route
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMMetricsRouterRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMMetricsRouterRouteFilterValues(name, "in", `["worker", "master"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMMetricsRouterRouteExists("ibm_metrics_router_route.metrics_router_route_instance", conf),
+					resource.TestCheckResourceAttr("ibm_metrics_router_route.metrics_router_route_instance", "rules.0.inclusion_filters.0.operator", "in"),
+					resource.TestCheckResourceAttr("ibm_metrics_router_route.metrics_router_route_instance", "rules.0.inclusion_filters.0.values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMMetricsRouterRouteConfigBasic(name, filter_value string) string {
 	return fmt.Sprintf(`
 		resource "ibm_metrics_router_target" "metrics_router_target_instance" {
@@ -277,3 +314,18 @@ func testAccCheckIBMMetricsRouterRouteDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMMetricsRouterRouteFilterValues(name string, operator string, values string) string {
+	return fmt.Sprintf(`
+	resource "ibm_metrics_router_route" "metrics_router_route_instance" {
+		name = "%s"
+		rules {
+			action = "drop"
+			inclusion_filters {
+				operand = "resource_type"
+				operator = "%s"
+				values = %s
+			}
+		}
+	}`, name, operator, values)
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMMonitoringAlert() *schema.Resource {
+	return addMonitoringInstanceFields(&schema.Resource{
+		CreateContext: resourceIBMMonitoringAlertCreate,
+		ReadContext:   resourceIBMMonitoringAlertRead,
+		UpdateContext: resourceIBMMonitoringAlertUpdate,
+		DeleteContext: resourceIBMMonitoringAlertDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the alert.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the alert.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the alert is evaluated.",
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(0, 7),
+				Description:  "The severity of the alert, from 0 (emergency) to 7 (debug).",
+			},
+			"condition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The metric condition that triggers the alert, for example `avg(cpu.used.percent) > 90`.",
+			},
+			"timespan": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "The number of seconds the condition must be met before the alert fires.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The scope filter of the alert, for example `kube_cluster_name = \"prod\"`.",
+			},
+			"segment_by": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels the alert is evaluated separately for.",
+			},
+			"segment_condition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ANY",
+				ValidateFunc: validation.StringInSlice([]string{"ANY", "ALL"}, false),
+				Description:  "Whether the alert fires when ANY or ALL of the segments meet the condition.",
+			},
+			"notification_channel_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the notification channels that are notified when the alert fires.",
+			},
+			"alert_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the alert.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the alert.",
+			},
+		},
+	})
+}
+
+func resourceIBMMonitoringAlertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region := d.Get("region").(string)
+	instanceID := d.Get("instance_id").(string)
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringAlertEnvelope{}
+	response, err := client.request(context, core.POST, "/api/alerts", nil, &monitoringAlertEnvelope{Alert: *resourceIBMMonitoringAlertMapToModel(d)}, result)
+	if err != nil {
+		log.Printf("[DEBUG] CreateAlert failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring alert: %s\n%s", err, response))
+	}
+	if result.Alert.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring alert: no ID returned"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", region, instanceID, *result.Alert.ID))
+
+	return resourceIBMMonitoringAlertRead(context, d, meta)
+}
+
+func resourceIBMMonitoringAlertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, alertID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := "public"
+	if v, ok := d.GetOk("endpoint_type"); ok {
+		endpointType = v.(string)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringAlertEnvelope{}
+	response, err := client.request(context, core.GET, "/api/alerts/{id}", map[string]string{"id": alertID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing monitoring alert (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAlert failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting monitoring alert (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	alert := result.Alert
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("name", alert.Name)
+	d.Set("description", alert.Description)
+	d.Set("enabled", alert.Enabled)
+	d.Set("severity", alert.Severity)
+	d.Set("condition", alert.Condition)
+	// the API works in microseconds
+	d.Set("timespan", alert.Timespan/1000000)
+	d.Set("scope", alert.Filter)
+	d.Set("segment_by", alert.SegmentBy)
+	if alert.SegmentCondition != nil {
+		d.Set("segment_condition", alert.SegmentCondition.Type)
+	}
+	channelIDs := []int{}
+	for _, id := range alert.NotificationChannelIds {
+		channelIDs = append(channelIDs, int(id))
+	}
+	d.Set("notification_channel_ids", channelIDs)
+	if alert.ID != nil {
+		d.Set("alert_id", int(*alert.ID))
+	}
+	if alert.Version != nil {
+		d.Set("version", int(*alert.Version))
+	}
+
+	return nil
+}
+
+func resourceIBMMonitoringAlertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, alertID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alert := resourceIBMMonitoringAlertMapToModel(d)
+	id, err := strconv.ParseInt(alertID, 10, 64)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid alert ID %s: %s", alertID, err))
+	}
+	alert.ID = &id
+	// the API rejects updates that do not carry the current version
+	alert.Version = core.Int64Ptr(int64(d.Get("version").(int)))
+
+	response, err := client.request(context, core.PUT, "/api/alerts/{id}", map[string]string{"id": alertID}, &monitoringAlertEnvelope{Alert: *alert}, nil)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateAlert failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating monitoring alert (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	return resourceIBMMonitoringAlertRead(context, d, meta)
+}
+
+func resourceIBMMonitoringAlertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, alertID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.request(context, core.DELETE, "/api/alerts/{id}", map[string]string{"id": alertID}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteAlert failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting monitoring alert (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMMonitoringAlertMapToModel(d *schema.ResourceData) *monitoringAlert {
+	alert := &monitoringAlert{
+		Type:             "MANUAL",
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Enabled:          d.Get("enabled").(bool),
+		Severity:         d.Get("severity").(int),
+		Condition:        d.Get("condition").(string),
+		Timespan:         int64(d.Get("timespan").(int)) * 1000000,
+		Filter:           d.Get("scope").(string),
+		SegmentCondition: &monitoringAlertSegmentCondition{Type: d.Get("segment_condition").(string)},
+	}
+	for _, segment := range d.Get("segment_by").([]interface{}) {
+		alert.SegmentBy = append(alert.SegmentBy, segment.(string))
+	}
+	for _, id := range d.Get("notification_channel_ids").([]interface{}) {
+		alert.NotificationChannelIds = append(alert.NotificationChannelIds, int64(id.(int)))
+	}
+
+	return alert
+}
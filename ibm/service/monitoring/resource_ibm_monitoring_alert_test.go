@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMMonitoringAlertBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-alert-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMonitoring(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMMonitoringAlertConfig(name, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_alert.alert", "name", name),
+					resource.TestCheckResourceAttr("ibm_monitoring_alert.alert", "condition", "avg(cpu.used.percent) > 90"),
+					resource.TestCheckResourceAttr("ibm_monitoring_alert.alert", "timespan", "600"),
+					resource.TestCheckResourceAttr("ibm_monitoring_alert.alert", "notification_channel_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_monitoring_alert.alert", "alert_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMMonitoringAlertConfig(name, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_alert.alert", "condition", "avg(cpu.used.percent) > 80"),
+				),
+			},
+			{
+				ResourceName:      "ibm_monitoring_alert.alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMMonitoringAlertConfig(name string, threshold int) string {
+	return fmt.Sprintf(`
+		resource "ibm_monitoring_notification_channel" "channel" {
+			instance_id      = "%[1]s"
+			region           = "%[2]s"
+			name             = "%[3]s-channel"
+			type             = "EMAIL"
+			email_recipients = ["terraform@example.com"]
+		}
+
+		resource "ibm_monitoring_alert" "alert" {
+			instance_id              = "%[1]s"
+			region                   = "%[2]s"
+			name                     = "%[3]s"
+			severity                 = 2
+			condition                = "avg(cpu.used.percent) > %[4]d"
+			timespan                 = 600
+			segment_by               = ["host.hostName"]
+			notification_channel_ids = [ibm_monitoring_notification_channel.channel.channel_id]
+		}
+	`, acc.MonitoringInstanceID, acc.MonitoringInstanceRegion, name, threshold)
+}
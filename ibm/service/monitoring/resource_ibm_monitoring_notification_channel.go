@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMMonitoringNotificationChannel() *schema.Resource {
+	return addMonitoringInstanceFields(&schema.Resource{
+		CreateContext: resourceIBMMonitoringNotificationChannelCreate,
+		ReadContext:   resourceIBMMonitoringNotificationChannelRead,
+		UpdateContext: resourceIBMMonitoringNotificationChannelUpdate,
+		DeleteContext: resourceIBMMonitoringNotificationChannelDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the notification channel.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EMAIL", "WEBHOOK", "SLACK"}, false),
+				Description:  "The type of the notification channel.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether notifications are sent through the channel.",
+			},
+			"email_recipients": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The email addresses notified by an EMAIL channel.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The URL called by a WEBHOOK or SLACK channel.",
+			},
+			"slack_channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Slack channel that receives the notifications of a SLACK channel.",
+			},
+			"notify_on_ok": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a notification is sent when an alert returns to the OK state.",
+			},
+			"notify_on_resolve": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether a notification is sent when an alert is manually resolved.",
+			},
+			"channel_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the notification channel.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the notification channel.",
+			},
+		},
+	})
+}
+
+func resourceIBMMonitoringNotificationChannelCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region := d.Get("region").(string)
+	instanceID := d.Get("instance_id").(string)
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	channel, err := resourceIBMMonitoringNotificationChannelMapToModel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringNotificationChannelEnvelope{}
+	response, err := client.request(context, core.POST, "/api/notificationChannels", nil, &monitoringNotificationChannelEnvelope{NotificationChannel: *channel}, result)
+	if err != nil {
+		log.Printf("[DEBUG] CreateNotificationChannel failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring notification channel: %s\n%s", err, response))
+	}
+	if result.NotificationChannel.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring notification channel: no ID returned"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", region, instanceID, *result.NotificationChannel.ID))
+
+	return resourceIBMMonitoringNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMMonitoringNotificationChannelRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, channelID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := "public"
+	if v, ok := d.GetOk("endpoint_type"); ok {
+		endpointType = v.(string)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringNotificationChannelEnvelope{}
+	response, err := client.request(context, core.GET, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing monitoring notification channel (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetNotificationChannel failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting monitoring notification channel (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	channel := result.NotificationChannel
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("name", channel.Name)
+	d.Set("type", channel.Type)
+	d.Set("enabled", channel.Enabled)
+	d.Set("email_recipients", channel.Options.EmailRecipients)
+	if channel.Options.URL != "" {
+		d.Set("url", channel.Options.URL)
+	}
+	d.Set("slack_channel", channel.Options.Channel)
+	d.Set("notify_on_ok", channel.Options.NotifyOnOk)
+	d.Set("notify_on_resolve", channel.Options.NotifyOnResolve)
+	if channel.ID != nil {
+		d.Set("channel_id", flex.IntValue(channel.ID))
+	}
+	if channel.Version != nil {
+		d.Set("version", flex.IntValue(channel.Version))
+	}
+
+	return nil
+}
+
+func resourceIBMMonitoringNotificationChannelUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, channelID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	channel, err := resourceIBMMonitoringNotificationChannelMapToModel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := strconv.ParseInt(channelID, 10, 64)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid notification channel ID %s: %s", channelID, err))
+	}
+	channel.ID = &id
+	// the API rejects updates that do not carry the current version
+	channel.Version = core.Int64Ptr(int64(d.Get("version").(int)))
+
+	response, err := client.request(context, core.PUT, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, &monitoringNotificationChannelEnvelope{NotificationChannel: *channel}, nil)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateNotificationChannel failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating monitoring notification channel (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	return resourceIBMMonitoringNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMMonitoringNotificationChannelDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, channelID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.request(context, core.DELETE, "/api/notificationChannels/{id}", map[string]string{"id": channelID}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteNotificationChannel failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting monitoring notification channel (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMMonitoringNotificationChannelMapToModel(d *schema.ResourceData) (*monitoringNotificationChannel, error) {
+	channel := &monitoringNotificationChannel{
+		Name:    d.Get("name").(string),
+		Type:    d.Get("type").(string),
+		Enabled: d.Get("enabled").(bool),
+		Options: monitoringNotificationChannelOptions{
+			URL:             d.Get("url").(string),
+			Channel:         d.Get("slack_channel").(string),
+			NotifyOnOk:      d.Get("notify_on_ok").(bool),
+			NotifyOnResolve: d.Get("notify_on_resolve").(bool),
+		},
+	}
+	for _, recipient := range d.Get("email_recipients").([]interface{}) {
+		channel.Options.EmailRecipients = append(channel.Options.EmailRecipients, recipient.(string))
+	}
+
+	switch channel.Type {
+	case "EMAIL":
+		if len(channel.Options.EmailRecipients) == 0 {
+			return nil, fmt.Errorf("[ERROR] email_recipients is required for an EMAIL notification channel")
+		}
+	case "WEBHOOK", "SLACK":
+		if channel.Options.URL == "" {
+			return nil, fmt.Errorf("[ERROR] url is required for a %s notification channel", channel.Type)
+		}
+	}
+
+	return channel, nil
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMMonitoringNotificationChannelBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-channel-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-channel-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMonitoring(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMMonitoringNotificationChannelConfig(name, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "name", name),
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "type", "EMAIL"),
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "email_recipients.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_monitoring_notification_channel.channel", "channel_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMMonitoringNotificationChannelConfig(nameUpdate, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_monitoring_notification_channel.channel", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_monitoring_notification_channel.channel",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMMonitoringNotificationChannelConfig(name string, enabled string) string {
+	return fmt.Sprintf(`
+		resource "ibm_monitoring_notification_channel" "channel" {
+			instance_id      = "%s"
+			region           = "%s"
+			name             = "%s"
+			type             = "EMAIL"
+			enabled          = %s
+			email_recipients = ["terraform@example.com"]
+		}
+	`, acc.MonitoringInstanceID, acc.MonitoringInstanceRegion, name, enabled)
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMMonitoringTeam() *schema.Resource {
+	return addMonitoringInstanceFields(&schema.Resource{
+		CreateContext: resourceIBMMonitoringTeamCreate,
+		ReadContext:   resourceIBMMonitoringTeamRead,
+		UpdateContext: resourceIBMMonitoringTeamUpdate,
+		DeleteContext: resourceIBMMonitoringTeamDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the team.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the team.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The scope of the team, a filter expression that limits the data the members of the team can see, for example `kubernetes.namespace.name = \"prod\"`.",
+			},
+			"show": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "container",
+				ValidateFunc: validation.StringInSlice([]string{"host", "container"}, false),
+				Description:  "Whether the scope applies to host or container data.",
+			},
+			"default_team_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ROLE_TEAM_STANDARD",
+				ValidateFunc: validation.StringInSlice([]string{"ROLE_TEAM_EDIT", "ROLE_TEAM_MANAGER", "ROLE_TEAM_READ", "ROLE_TEAM_STANDARD"}, false),
+				Description:  "The role of the users that join the team.",
+			},
+			"can_use_sysdig_capture": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the members of the team can take captures.",
+			},
+			"can_use_custom_events": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the members of the team can see the custom events.",
+			},
+			"team_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the team.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the team.",
+			},
+		},
+	})
+}
+
+func resourceIBMMonitoringTeamCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region := d.Get("region").(string)
+	instanceID := d.Get("instance_id").(string)
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringTeamEnvelope{}
+	response, err := client.request(context, core.POST, "/api/teams", nil, resourceIBMMonitoringTeamMapToModel(d), result)
+	if err != nil {
+		log.Printf("[DEBUG] CreateTeam failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring team: %s\n%s", err, response))
+	}
+	if result.Team.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating monitoring team: no ID returned"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", region, instanceID, *result.Team.ID))
+
+	return resourceIBMMonitoringTeamRead(context, d, meta)
+}
+
+func resourceIBMMonitoringTeamRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, teamID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := "public"
+	if v, ok := d.GetOk("endpoint_type"); ok {
+		endpointType = v.(string)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &monitoringTeamEnvelope{}
+	response, err := client.request(context, core.GET, "/api/teams/{id}", map[string]string{"id": teamID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing monitoring team (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetTeam failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting monitoring team (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	team := result.Team
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("name", team.Name)
+	d.Set("description", team.Description)
+	d.Set("scope", team.Filter)
+	d.Set("show", team.Show)
+	d.Set("default_team_role", team.DefaultTeamRole)
+	d.Set("can_use_sysdig_capture", team.CanUseSysdigCapture)
+	d.Set("can_use_custom_events", team.CanUseCustomEvents)
+	if team.ID != nil {
+		d.Set("team_id", flex.IntValue(team.ID))
+	}
+	if team.Version != nil {
+		d.Set("version", flex.IntValue(team.Version))
+	}
+
+	return nil
+}
+
+func resourceIBMMonitoringTeamUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, teamID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	team := resourceIBMMonitoringTeamMapToModel(d)
+	id, err := strconv.ParseInt(teamID, 10, 64)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid team ID %s: %s", teamID, err))
+	}
+	team.ID = &id
+	// the API rejects updates that do not carry the current version
+	team.Version = core.Int64Ptr(int64(d.Get("version").(int)))
+
+	response, err := client.request(context, core.PUT, "/api/teams/{id}", map[string]string{"id": teamID}, team, nil)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateTeam failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating monitoring team (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	return resourceIBMMonitoringTeamRead(context, d, meta)
+}
+
+func resourceIBMMonitoringTeamDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceID, teamID, err := parseMonitoringID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getMonitoringClient(meta, region, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.request(context, core.DELETE, "/api/teams/{id}", map[string]string{"id": teamID}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteTeam failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting monitoring team (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// The teams API takes the team without an envelope and returns it in a team envelope
+func resourceIBMMonitoringTeamMapToModel(d *schema.ResourceData) *monitoringTeam {
+	return &monitoringTeam{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Filter:              d.Get("scope").(string),
+		Show:                d.Get("show").(string),
+		Products:            []string{"SDC"},
+		DefaultTeamRole:     d.Get("default_team_role").(string),
+		CanUseSysdigCapture: d.Get("can_use_sysdig_capture").(bool),
+		CanUseCustomEvents:  d.Get("can_use_custom_events").(bool),
+		EntryPoint:          &monitoringTeamEntryPoint{Module: "Explore"},
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMMonitoringTeamBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-team-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMonitoring(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMMonitoringTeamConfig(name, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_team.team", "name", name),
+					resource.TestCheckResourceAttr("ibm_monitoring_team.team", "scope", `kubernetes.namespace.name = "prod"`),
+					resource.TestCheckResourceAttr("ibm_monitoring_team.team", "show", "container"),
+					resource.TestCheckResourceAttrSet("ibm_monitoring_team.team", "team_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMMonitoringTeamConfig(name, "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_team.team", "scope", `kubernetes.namespace.name = "staging"`),
+				),
+			},
+			{
+				ResourceName:      "ibm_monitoring_team.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMMonitoringTeamConfig(name string, namespace string) string {
+	return fmt.Sprintf(`
+		resource "ibm_monitoring_team" "team" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "%s"
+			scope       = "kubernetes.namespace.name = \"%s\""
+		}
+	`, acc.MonitoringInstanceID, acc.MonitoringInstanceRegion, name, namespace)
+}
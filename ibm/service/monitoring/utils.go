@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	monitoringEndpointPublic  = "https://%s.monitoring.cloud.ibm.com"
+	monitoringEndpointPrivate = "https://private.%s.monitoring.cloud.ibm.com"
+)
+
+// monitoringClient calls the Sysdig API of a single IBM Cloud Monitoring instance.
+// There is no IBM Go SDK for this API, the requests are built with the SDK core
+// and authenticated with the IAM authenticator of the provider session.
+type monitoringClient struct {
+	service    *core.BaseService
+	instanceID string
+}
+
+type monitoringNotificationChannelOptions struct {
+	EmailRecipients      []string `json:"emailRecipients,omitempty"`
+	URL                  string   `json:"url,omitempty"`
+	Channel              string   `json:"channel,omitempty"`
+	NotifyOnOk           bool     `json:"notifyOnOk"`
+	NotifyOnResolve      bool     `json:"notifyOnResolve"`
+	SendTestNotification bool     `json:"sendTestNotification"`
+}
+
+type monitoringNotificationChannel struct {
+	ID      *int64                               `json:"id,omitempty"`
+	Version *int64                               `json:"version,omitempty"`
+	Type    string                               `json:"type"`
+	Name    string                               `json:"name"`
+	Enabled bool                                 `json:"enabled"`
+	Options monitoringNotificationChannelOptions `json:"options"`
+}
+
+type monitoringNotificationChannelEnvelope struct {
+	NotificationChannel monitoringNotificationChannel `json:"notificationChannel"`
+}
+
+type monitoringAlertSegmentCondition struct {
+	Type string `json:"type"`
+}
+
+type monitoringAlert struct {
+	ID                     *int64                           `json:"id,omitempty"`
+	Version                *int64                           `json:"version,omitempty"`
+	Type                   string                           `json:"type"`
+	Name                   string                           `json:"name"`
+	Description            string                           `json:"description,omitempty"`
+	Enabled                bool                             `json:"enabled"`
+	Severity               int                              `json:"severity"`
+	Timespan               int64                            `json:"timespan"`
+	Condition              string                           `json:"condition"`
+	Filter                 string                           `json:"filter,omitempty"`
+	SegmentBy              []string                         `json:"segmentBy,omitempty"`
+	SegmentCondition       *monitoringAlertSegmentCondition `json:"segmentCondition,omitempty"`
+	NotificationChannelIds []int64                          `json:"notificationChannelIds,omitempty"`
+}
+
+type monitoringAlertEnvelope struct {
+	Alert monitoringAlert `json:"alert"`
+}
+
+type monitoringTeamEntryPoint struct {
+	Module string `json:"module"`
+}
+
+type monitoringTeam struct {
+	ID                  *int64                    `json:"id,omitempty"`
+	Version             *int64                    `json:"version,omitempty"`
+	Name                string                    `json:"name"`
+	Description         string                    `json:"description,omitempty"`
+	Filter              string                    `json:"filter,omitempty"`
+	Show                string                    `json:"show"`
+	Products            []string                  `json:"products"`
+	DefaultTeamRole     string                    `json:"defaultTeamRole,omitempty"`
+	CanUseSysdigCapture bool                      `json:"canUseSysdigCapture"`
+	CanUseCustomEvents  bool                      `json:"canUseCustomEvents"`
+	EntryPoint          *monitoringTeamEntryPoint `json:"entryPoint,omitempty"`
+}
+
+type monitoringTeamEnvelope struct {
+	Team monitoringTeam `json:"team"`
+}
+
+// Add the fields needed for building the instance endpoint to the given schema
+func addMonitoringInstanceFields(resource *schema.Resource) *schema.Resource {
+	resource.Schema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the IBM Cloud Monitoring instance.",
+	}
+	resource.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The region of the IBM Cloud Monitoring instance.",
+	}
+	resource.Schema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "public",
+		ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+		Description:  "public or private.",
+	}
+
+	return resource
+}
+
+func getMonitoringClient(meta interface{}, region, instanceID, endpointType string) (*monitoringClient, error) {
+	// the IAM authenticator of the provider session refreshes the token on every request
+	authenticator, err := meta.(conns.ClientSession).Authenticator()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the session authenticator for instance %s: %s", instanceID, err)
+	}
+
+	endpoint := fmt.Sprintf(monitoringEndpointPublic, region)
+	if endpointType == "private" {
+		endpoint = fmt.Sprintf(monitoringEndpointPrivate, region)
+	}
+	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_MONITORING_API_ENDPOINT"}, endpoint)
+	log.Printf("[DEBUG] Using IBM Cloud Monitoring endpoint %s for instance %s", endpoint, instanceID)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+		URL:           endpoint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.EnableRetries(meta.(conns.ClientSession).RetryConfig())
+	service.SetDefaultHeaders(http.Header{
+		"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+	})
+
+	return &monitoringClient{service: service, instanceID: instanceID}, nil
+}
+
+func (c *monitoringClient) request(ctx context.Context, method string, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.service.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("IBMInstanceID", c.instanceID)
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.service.Request(request, result)
+}
+
+// parseMonitoringID splits an ID of the form region/instance_id/resource_id
+func parseMonitoringID(id string) (region string, instanceID string, resourceID string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil || len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Invalid ID %s: ID should be a combination of region/instance_id/id", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// MQRC_UNKNOWN_OBJECT_NAME, returned when the object of an MQSC command does not exist
+	mqscReasonUnknownObjectName = 2085
+	// MQRCCF_CHANNEL_NOT_FOUND, returned instead for a channel that does not exist
+	mqscReasonChannelNotFound = 3200
+)
+
+// The administrator API key is not part of the import ID, it is read from this environment variable
+const mqscImportAPIKeyEnv = "IBM_MQCLOUD_ADMINISTRATOR_API_KEY"
+
+// Queues and channels are objects of the queue manager itself and are not part of
+// the MQ on Cloud service API, so they are managed with MQSC commands sent to the
+// administrative REST API of the queue manager.
+type mqscClient struct {
+	service          *core.BaseService
+	queueManagerName string
+}
+
+type mqscCommand struct {
+	Type               string                 `json:"type"`
+	Command            string                 `json:"command"`
+	Qualifier          string                 `json:"qualifier"`
+	Name               string                 `json:"name"`
+	Parameters         map[string]interface{} `json:"parameters,omitempty"`
+	OptionalParameters []string               `json:"optionalParameters,omitempty"`
+	ResponseParameters []string               `json:"responseParameters,omitempty"`
+}
+
+type mqscCommandResponseItem struct {
+	CompletionCode int                    `json:"completionCode"`
+	ReasonCode     int                    `json:"reasonCode"`
+	Text           []string               `json:"text"`
+	Parameters     map[string]interface{} `json:"parameters"`
+}
+
+type mqscResponse struct {
+	CommandResponse       []mqscCommandResponseItem `json:"commandResponse"`
+	OverallCompletionCode int                       `json:"overallCompletionCode"`
+	OverallReasonCode     int                       `json:"overallReasonCode"`
+}
+
+type mqscError struct {
+	ReasonCode int
+	Text       string
+}
+
+func (e *mqscError) Error() string {
+	return fmt.Sprintf("MQSC command failed with reason code %d: %s", e.ReasonCode, e.Text)
+}
+
+func isMqscUnknownObject(err error) bool {
+	mqscErr, ok := err.(*mqscError)
+	return ok && (mqscErr.ReasonCode == mqscReasonUnknownObjectName || mqscErr.ReasonCode == mqscReasonChannelNotFound)
+}
+
+// importMqscObject sets the connection fields and the name of a queue manager object from an import ID
+// <administrator_api_endpoint_url>|<administrator_user>|<queue_manager_name>/<name>
+func importMqscObject(d *schema.ResourceData) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Incorrect ID %s: ID should be a combination of administratorAPIEndpointURL|administratorUser|queueManagerName/name", d.Id())
+	}
+	names := strings.SplitN(parts[2], "/", 2)
+	if len(names) != 2 || names[0] == "" || names[1] == "" {
+		return nil, fmt.Errorf("Incorrect ID %s: ID should be a combination of administratorAPIEndpointURL|administratorUser|queueManagerName/name", d.Id())
+	}
+	apiKey := os.Getenv(mqscImportAPIKeyEnv)
+	if apiKey == "" {
+		return nil, fmt.Errorf("%s must be set to the administrator API key to import %s", mqscImportAPIKeyEnv, parts[2])
+	}
+
+	d.Set("administrator_api_endpoint_url", parts[0])
+	d.Set("administrator_user", parts[1])
+	d.Set("administrator_api_key", apiKey)
+	d.Set("queue_manager_name", names[0])
+	d.Set("name", names[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Add the fields needed to reach the administrative REST API of a queue manager to the given schema
+func addMqscConnectionFields(resource *schema.Resource) *schema.Resource {
+	resource.Schema["administrator_api_endpoint_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The administrator_api_endpoint_url of the queue manager.",
+	}
+	resource.Schema["queue_manager_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the queue manager.",
+	}
+	resource.Schema["administrator_user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of an MQ administrator of the service instance.",
+	}
+	resource.Schema["administrator_api_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The administrator API key of the MQ administrator.",
+	}
+	return resource
+}
+
+func getMqscClient(d *schema.ResourceData) (*mqscClient, error) {
+	endpoint, err := url.Parse(d.Get("administrator_api_endpoint_url").(string))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid administrator_api_endpoint_url %q", d.Get("administrator_api_endpoint_url").(string))
+	}
+	authenticator, err := core.NewBasicAuthenticator(d.Get("administrator_user").(string), d.Get("administrator_api_key").(string))
+	if err != nil {
+		return nil, err
+	}
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           fmt.Sprintf("%s://%s", endpoint.Scheme, endpoint.Host),
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mqscClient{service: service, queueManagerName: d.Get("queue_manager_name").(string)}, nil
+}
+
+func (c *mqscClient) run(ctx context.Context, command *mqscCommand) (*mqscResponse, error) {
+	command.Type = "runCommandJSON"
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.service.GetServiceURL(), "/ibmmq/rest/v2/admin/action/qmgr/{qmgr}/mqsc", map[string]string{"qmgr": c.queueManagerName})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	// required by the MQ REST API for all state changing requests, the value is not checked
+	builder.AddHeader("ibm-mq-rest-csrf-token", "terraform")
+	if _, err = builder.SetBodyContentJSON(command); err != nil {
+		return nil, err
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &mqscResponse{}
+	response, err := c.service.Request(request, result)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s %s failed: %s\n%s", command.Command, command.Qualifier, command.Name, err, response)
+	}
+	if result.OverallCompletionCode != 0 {
+		text := []string{}
+		reasonCode := result.OverallReasonCode
+		for _, item := range result.CommandResponse {
+			text = append(text, item.Text...)
+			if item.ReasonCode != 0 {
+				reasonCode = item.ReasonCode
+			}
+		}
+		return result, &mqscError{ReasonCode: reasonCode, Text: strings.Join(text, " ")}
+	}
+	return result, nil
+}
+
+// display returns the attributes of a queue manager object, or nil if it does not exist
+func (c *mqscClient) display(ctx context.Context, qualifier, name string) (map[string]interface{}, error) {
+	result, err := c.run(ctx, &mqscCommand{
+		Command:            "display",
+		Qualifier:          qualifier,
+		Name:               name,
+		ResponseParameters: []string{"all"},
+	})
+	if err != nil {
+		if isMqscUnknownObject(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(result.CommandResponse) == 0 {
+		return nil, nil
+	}
+	return result.CommandResponse[0].Parameters, nil
+}
+
+func mqscStringParameter(parameters map[string]interface{}, key string) string {
+	if v, ok := parameters[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func mqscIntParameter(parameters map[string]interface{}, key string) int {
+	switch v := parameters[key].(type) {
+	case float64:
+		return int(v)
+	case json.Number:
+		i, _ := v.Int64()
+		return int(i)
+	}
+	return 0
+}
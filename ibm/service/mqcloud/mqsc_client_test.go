@@ -0,0 +1,93 @@
+package mqcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newTestMqscClient(t *testing.T, handler http.HandlerFunc) *mqscClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	d := schema.TestResourceDataRaw(t, ResourceIbmMqcloudQueue().Schema, map[string]interface{}{
+		"administrator_api_endpoint_url": server.URL + "/ibmmq/rest/v1/",
+		"queue_manager_name":             "QM1",
+		"administrator_user":             "admin",
+		"administrator_api_key":          "secret",
+		"name":                           "DEV.QUEUE.1",
+	})
+	client, err := getMqscClient(d)
+	if err != nil {
+		t.Fatalf("getMqscClient: %s", err)
+	}
+	return client
+}
+
+func TestMqscClientRun(t *testing.T) {
+	var received mqscCommand
+	client := newTestMqscClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ibmmq/rest/v2/admin/action/qmgr/QM1/mqsc" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			t.Errorf("unexpected credentials %s/%s", user, pass)
+		}
+		if r.Header.Get("ibm-mq-rest-csrf-token") == "" {
+			t.Errorf("missing csrf token header")
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"commandResponse":[{"completionCode":0,"reasonCode":0}],"overallCompletionCode":0,"overallReasonCode":0}`))
+	})
+
+	_, err := client.run(context.Background(), &mqscCommand{
+		Command:    "define",
+		Qualifier:  "qlocal",
+		Name:       "DEV.QUEUE.1",
+		Parameters: map[string]interface{}{"maxdepth": 10},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if received.Type != "runCommandJSON" || received.Command != "define" || received.Name != "DEV.QUEUE.1" {
+		t.Errorf("unexpected command %+v", received)
+	}
+}
+
+func TestMqscClientDisplayUnknownObject(t *testing.T) {
+	client := newTestMqscClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"commandResponse":[{"completionCode":2,"reasonCode":2085,"text":["AMQ8147E: IBM MQ object DEV.QUEUE.1 not found."]}],"overallCompletionCode":2,"overallReasonCode":3008}`))
+	})
+
+	parameters, err := client.display(context.Background(), "qlocal", "DEV.QUEUE.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if parameters != nil {
+		t.Errorf("expected no parameters, got %v", parameters)
+	}
+}
+
+func TestMqscClientDisplay(t *testing.T) {
+	client := newTestMqscClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"commandResponse":[{"completionCode":0,"reasonCode":0,"parameters":{"queue":"DEV.QUEUE.1","maxdepth":5000,"defpsist":"yes","descr":"dev"}}],"overallCompletionCode":0,"overallReasonCode":0}`))
+	})
+
+	parameters, err := client.display(context.Background(), "qlocal", "DEV.QUEUE.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := mqscIntParameter(parameters, "maxdepth"); got != 5000 {
+		t.Errorf("expected maxdepth 5000, got %d", got)
+	}
+	if got := mqscStringParameter(parameters, "defpsist"); got != "yes" {
+		t.Errorf("expected defpsist yes, got %s", got)
+	}
+}
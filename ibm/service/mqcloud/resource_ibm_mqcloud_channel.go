@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIbmMqcloudChannel() *schema.Resource {
+	return addMqscConnectionFields(&schema.Resource{
+		CreateContext: resourceIbmMqcloudChannelCreate,
+		ReadContext:   resourceIbmMqcloudChannelRead,
+		UpdateContext: resourceIbmMqcloudChannelUpdate,
+		DeleteContext: resourceIbmMqcloudChannelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return importMqscObject(d)
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "name"),
+				Description:  "The name of the server-connection channel.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the channel.",
+			},
+			"ssl_cipher_spec": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The TLS cipher specification required by the channel, for example `ANY_TLS12_OR_HIGHER`. An empty value disables TLS on the channel.",
+			},
+			"max_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      999999999,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "max_instances"),
+				Description:  "The maximum number of simultaneous instances of the channel.",
+			},
+			"max_instances_per_client": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      999999999,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_channel", "max_instances_per_client"),
+				Description:  "The maximum number of simultaneous instances of the channel that can be started from a single client.",
+			},
+		},
+	})
+}
+
+func ResourceIbmMqcloudChannelValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9._/%]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             20,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "max_instances",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "999999999",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "max_instances_per_client",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "999999999",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_mqcloud_channel", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmMqcloudChannelCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "create", "initialize-client").GetDiag()
+	}
+
+	name := d.Get("name").(string)
+	parameters := resourceIbmMqcloudChannelParameters(d)
+	parameters["chltype"] = "svrconn"
+	_, err = client.run(context, &mqscCommand{
+		Command:    "define",
+		Qualifier:  "channel",
+		Name:       name,
+		Parameters: parameters,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DEFINE CHANNEL failed: %s", err.Error()), "ibm_mqcloud_channel", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("queue_manager_name").(string), name))
+
+	return resourceIbmMqcloudChannelRead(context, d, meta)
+}
+
+func resourceIbmMqcloudChannelRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "read", "initialize-client").GetDiag()
+	}
+
+	parameters, err := client.display(context, "channel", d.Get("name").(string))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DISPLAY CHANNEL failed: %s", err.Error()), "ibm_mqcloud_channel", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if parameters == nil {
+		log.Printf("[WARN] Removing channel (%s) from state because it is not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("description", mqscStringParameter(parameters, "descr")); err != nil {
+		err = fmt.Errorf("Error setting description: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "read", "set-description").GetDiag()
+	}
+	if err = d.Set("ssl_cipher_spec", mqscStringParameter(parameters, "sslciph")); err != nil {
+		err = fmt.Errorf("Error setting ssl_cipher_spec: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "read", "set-ssl_cipher_spec").GetDiag()
+	}
+	if err = d.Set("max_instances", mqscIntParameter(parameters, "maxinst")); err != nil {
+		err = fmt.Errorf("Error setting max_instances: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "read", "set-max_instances").GetDiag()
+	}
+	if err = d.Set("max_instances_per_client", mqscIntParameter(parameters, "maxinstc")); err != nil {
+		err = fmt.Errorf("Error setting max_instances_per_client: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "read", "set-max_instances_per_client").GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIbmMqcloudChannelUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("description", "ssl_cipher_spec", "max_instances", "max_instances_per_client") {
+		return resourceIbmMqcloudChannelRead(context, d, meta)
+	}
+
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "update", "initialize-client").GetDiag()
+	}
+
+	parameters := resourceIbmMqcloudChannelParameters(d)
+	parameters["chltype"] = "svrconn"
+	_, err = client.run(context, &mqscCommand{
+		Command:    "alter",
+		Qualifier:  "channel",
+		Name:       d.Get("name").(string),
+		Parameters: parameters,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ALTER CHANNEL failed: %s", err.Error()), "ibm_mqcloud_channel", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return resourceIbmMqcloudChannelRead(context, d, meta)
+}
+
+func resourceIbmMqcloudChannelDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_channel", "delete", "initialize-client").GetDiag()
+	}
+
+	_, err = client.run(context, &mqscCommand{
+		Command:   "delete",
+		Qualifier: "channel",
+		Name:      d.Get("name").(string),
+	})
+	if err != nil && !isMqscUnknownObject(err) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DELETE CHANNEL failed: %s", err.Error()), "ibm_mqcloud_channel", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmMqcloudChannelParameters(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"descr":    d.Get("description").(string),
+		"sslciph":  d.Get("ssl_cipher_spec").(string),
+		"maxinst":  d.Get("max_instances").(int),
+		"maxinstc": d.Get("max_instances_per_client").(int),
+	}
+}
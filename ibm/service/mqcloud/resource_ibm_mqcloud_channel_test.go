@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmMqcloudChannelBasic(t *testing.T) {
+	name := fmt.Sprintf("TF.SVRCONN.%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMqcloudAdmin(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmMqcloudChannelConfig(name, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "name", name),
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "ssl_cipher_spec", "ANY_TLS12_OR_HIGHER"),
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "max_instances", "10"),
+				),
+			},
+			{
+				Config: testAccCheckIbmMqcloudChannelConfig(name, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_channel.mqcloud_channel_instance", "max_instances", "20"),
+				),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv("IBM_MQCLOUD_ADMINISTRATOR_API_KEY", acc.MqcloudAdminAPIKey)
+				},
+				ResourceName:            "ibm_mqcloud_channel.mqcloud_channel_instance",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s|%s|%s/%s", acc.MqcloudAdminEndpoint, acc.MqcloudAdminUser, acc.MqcloudQueueManagerName, name),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_api_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmMqcloudChannelConfig(name string, maxInstances int) string {
+	return fmt.Sprintf(`
+		resource "ibm_mqcloud_channel" "mqcloud_channel_instance" {
+			administrator_api_endpoint_url = "%s"
+			queue_manager_name = "%s"
+			administrator_user = "%s"
+			administrator_api_key = "%s"
+			name = "%s"
+			description = "created by terraform"
+			ssl_cipher_spec = "ANY_TLS12_OR_HIGHER"
+			max_instances = %d
+			max_instances_per_client = 5
+		}
+	`, acc.MqcloudAdminEndpoint, acc.MqcloudQueueManagerName, acc.MqcloudAdminUser, acc.MqcloudAdminAPIKey, name, maxInstances)
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIbmMqcloudQueue() *schema.Resource {
+	return addMqscConnectionFields(&schema.Resource{
+		CreateContext: resourceIbmMqcloudQueueCreate,
+		ReadContext:   resourceIbmMqcloudQueueRead,
+		UpdateContext: resourceIbmMqcloudQueueUpdate,
+		DeleteContext: resourceIbmMqcloudQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("purge_on_delete", false)
+				return importMqscObject(d)
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_queue", "name"),
+				Description:  "The name of the local queue.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the queue.",
+			},
+			"max_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5000,
+				ValidateFunc: validate.InvokeValidator("ibm_mqcloud_queue", "max_depth"),
+				Description:  "The maximum number of messages allowed on the queue.",
+			},
+			"default_persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether messages put on the queue are persistent by default.",
+			},
+			"purge_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the queue even if it still holds messages.",
+			},
+			"current_depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of messages currently on the queue.",
+			},
+		},
+	})
+}
+
+func ResourceIbmMqcloudQueueValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9._/%]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             48,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "max_depth",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "999999999",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_mqcloud_queue", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmMqcloudQueueCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "create", "initialize-client").GetDiag()
+	}
+
+	name := d.Get("name").(string)
+	_, err = client.run(context, &mqscCommand{
+		Command:    "define",
+		Qualifier:  "qlocal",
+		Name:       name,
+		Parameters: resourceIbmMqcloudQueueParameters(d),
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DEFINE QLOCAL failed: %s", err.Error()), "ibm_mqcloud_queue", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("queue_manager_name").(string), name))
+
+	return resourceIbmMqcloudQueueRead(context, d, meta)
+}
+
+func resourceIbmMqcloudQueueRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "read", "initialize-client").GetDiag()
+	}
+
+	parameters, err := client.display(context, "qlocal", d.Get("name").(string))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DISPLAY QLOCAL failed: %s", err.Error()), "ibm_mqcloud_queue", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if parameters == nil {
+		log.Printf("[WARN] Removing queue (%s) from state because it is not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("description", mqscStringParameter(parameters, "descr")); err != nil {
+		err = fmt.Errorf("Error setting description: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "read", "set-description").GetDiag()
+	}
+	if err = d.Set("max_depth", mqscIntParameter(parameters, "maxdepth")); err != nil {
+		err = fmt.Errorf("Error setting max_depth: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "read", "set-max_depth").GetDiag()
+	}
+	if err = d.Set("default_persistence", mqscStringParameter(parameters, "defpsist") == "yes"); err != nil {
+		err = fmt.Errorf("Error setting default_persistence: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "read", "set-default_persistence").GetDiag()
+	}
+	if err = d.Set("current_depth", mqscIntParameter(parameters, "curdepth")); err != nil {
+		err = fmt.Errorf("Error setting current_depth: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "read", "set-current_depth").GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIbmMqcloudQueueUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("description", "max_depth", "default_persistence") {
+		return resourceIbmMqcloudQueueRead(context, d, meta)
+	}
+
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "update", "initialize-client").GetDiag()
+	}
+
+	_, err = client.run(context, &mqscCommand{
+		Command:    "alter",
+		Qualifier:  "qlocal",
+		Name:       d.Get("name").(string),
+		Parameters: resourceIbmMqcloudQueueParameters(d),
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ALTER QLOCAL failed: %s", err.Error()), "ibm_mqcloud_queue", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return resourceIbmMqcloudQueueRead(context, d, meta)
+}
+
+func resourceIbmMqcloudQueueDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMqscClient(d)
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_mqcloud_queue", "delete", "initialize-client").GetDiag()
+	}
+
+	command := &mqscCommand{
+		Command:   "delete",
+		Qualifier: "qlocal",
+		Name:      d.Get("name").(string),
+	}
+	if d.Get("purge_on_delete").(bool) {
+		command.OptionalParameters = []string{"purge"}
+	}
+	_, err = client.run(context, command)
+	if err != nil && !isMqscUnknownObject(err) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DELETE QLOCAL failed: %s", err.Error()), "ibm_mqcloud_queue", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmMqcloudQueueParameters(d *schema.ResourceData) map[string]interface{} {
+	defpsist := "no"
+	if d.Get("default_persistence").(bool) {
+		defpsist = "yes"
+	}
+	return map[string]interface{}{
+		"descr":    d.Get("description").(string),
+		"maxdepth": d.Get("max_depth").(int),
+		"defpsist": defpsist,
+	}
+}
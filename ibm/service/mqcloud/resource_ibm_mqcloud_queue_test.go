@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mqcloud_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmMqcloudQueueBasic(t *testing.T) {
+	name := fmt.Sprintf("TF.QUEUE.%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckMqcloudAdmin(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmMqcloudQueueConfig(name, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_queue.mqcloud_queue_instance", "name", name),
+					resource.TestCheckResourceAttr("ibm_mqcloud_queue.mqcloud_queue_instance", "max_depth", "5000"),
+					resource.TestCheckResourceAttr("ibm_mqcloud_queue.mqcloud_queue_instance", "default_persistence", "true"),
+					resource.TestCheckResourceAttr("ibm_mqcloud_queue.mqcloud_queue_instance", "current_depth", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIbmMqcloudQueueConfig(name, 10000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_mqcloud_queue.mqcloud_queue_instance", "max_depth", "10000"),
+				),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv("IBM_MQCLOUD_ADMINISTRATOR_API_KEY", acc.MqcloudAdminAPIKey)
+				},
+				ResourceName:            "ibm_mqcloud_queue.mqcloud_queue_instance",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s|%s|%s/%s", acc.MqcloudAdminEndpoint, acc.MqcloudAdminUser, acc.MqcloudQueueManagerName, name),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_api_key", "purge_on_delete"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmMqcloudQueueConfig(name string, maxDepth int) string {
+	return fmt.Sprintf(`
+		resource "ibm_mqcloud_queue" "mqcloud_queue_instance" {
+			administrator_api_endpoint_url = "%s"
+			queue_manager_name = "%s"
+			administrator_user = "%s"
+			administrator_api_key = "%s"
+			name = "%s"
+			description = "created by terraform"
+			max_depth = %d
+			default_persistence = true
+			purge_on_delete = true
+		}
+	`, acc.MqcloudAdminEndpoint, acc.MqcloudQueueManagerName, acc.MqcloudAdminUser, acc.MqcloudAdminAPIKey, name, maxDepth)
+}
@@ -5,6 +5,8 @@ package power
 
 import (
 	"context"
+	"slices"
+	"sort"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -25,6 +27,12 @@ func DataSourceIBMPIDatacenters() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_SysType: {
+				Description:  "Only list the datacenters that support this system type, for example s922 or e980.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
 
 			// Attributes
 			Attr_Datacenters: {
@@ -173,6 +181,12 @@ func DataSourceIBMPIDatacenters() *schema.Resource {
 					},
 				},
 			},
+			Attr_SysTypes: {
+				Computed:    true,
+				Description: "The system types supported by any of the listed datacenters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 		},
 	}
 }
@@ -191,9 +205,23 @@ func dataSourceIBMPIDatacentersRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	sysType := d.Get(Arg_SysType).(string)
 	datacenters := make([]map[string]interface{}, 0, len(datacentersData.Datacenters))
+	sysTypes := map[string]bool{}
 	for _, datacenter := range datacentersData.Datacenters {
 		if datacenter != nil {
+			datacenterSysTypes := []string{}
+			if datacenter.CapabilitiesDetails != nil && datacenter.CapabilitiesDetails.SupportedSystems != nil {
+				datacenterSysTypes = append(datacenterSysTypes, datacenter.CapabilitiesDetails.SupportedSystems.General...)
+				datacenterSysTypes = append(datacenterSysTypes, datacenter.CapabilitiesDetails.SupportedSystems.Dedicated...)
+			}
+			if sysType != "" && !slices.Contains(datacenterSysTypes, sysType) {
+				continue
+			}
+			for _, t := range datacenterSysTypes {
+				sysTypes[t] = true
+			}
+
 			dc := map[string]interface{}{
 
 				Attr_DatacenterCapabilities: datacenter.Capabilities,
@@ -217,5 +245,11 @@ func dataSourceIBMPIDatacentersRead(ctx context.Context, d *schema.ResourceData,
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
 	d.Set(Attr_Datacenters, datacenters)
+	sortedSysTypes := make([]string, 0, len(sysTypes))
+	for t := range sysTypes {
+		sortedSysTypes = append(sortedSysTypes, t)
+	}
+	sort.Strings(sortedSysTypes)
+	d.Set(Attr_SysTypes, sortedSysTypes)
 	return nil
 }
@@ -41,6 +41,22 @@ func TestAccIBMPIDatacentersDataSourcePrivate(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIDatacentersDataSourceSysType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDatacentersDataSourceSysTypeConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_datacenters.test", "id"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_pi_datacenters.test", "sys_types.*", "s922"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIDatacentersDataSourceConfig() string {
 	return `data "ibm_pi_datacenters" "test" {}`
 }
@@ -51,3 +67,10 @@ func testAccCheckIBMPIDatacentersDataSourcePrivateConfig() string {
 		pi_cloud_instance_id = "%s"
 	}`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIDatacentersDataSourceSysTypeConfig() string {
+	return `
+	data "ibm_pi_datacenters" "test" {
+		pi_sys_type = "s922"
+	}`
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -54,6 +58,11 @@ func DataSourceIBMPIDhcp() *schema.Resource {
 							Description: "MAC Address of the PVM Instance.",
 							Type:        schema.TypeString,
 						},
+						Attr_InstanceName: {
+							Computed:    true,
+							Description: "Name of the PVM Instance, which is also its hostname.",
+							Type:        schema.TypeString,
+						},
 					},
 				},
 				Type: schema.TypeList,
@@ -107,15 +116,49 @@ func dataSourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if dhcpServer.Leases != nil {
-		leaseList := make([]map[string]string, len(dhcpServer.Leases))
-		for i, lease := range dhcpServer.Leases {
-			leaseList[i] = map[string]string{
-				Attr_InstanceIP:  *lease.InstanceIP,
-				Attr_InstanceMac: *lease.InstanceMacAddress,
-			}
+		instanceNames, err := getIBMPIInstanceNamesByMac(ctx, sess, cloudInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		d.Set(Attr_Leases, leaseList)
+		d.Set(Attr_Leases, flattenIBMPIDhcpLeases(dhcpServer.Leases, instanceNames))
 	}
 
 	return nil
 }
+
+// getIBMPIInstanceNamesByMac maps the MAC addresses of all PVM instance networks to the instance name.
+func getIBMPIInstanceNamesByMac(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (map[string]string, error) {
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvmInstances, err := client.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	instanceNames := map[string]string{}
+	for _, pvmInstance := range pvmInstances.PvmInstances {
+		if pvmInstance == nil {
+			continue
+		}
+		for _, network := range pvmInstance.Networks {
+			if network != nil && network.MacAddress != "" {
+				instanceNames[strings.ToLower(network.MacAddress)] = flex.StringValue(pvmInstance.ServerName)
+			}
+		}
+	}
+	return instanceNames, nil
+}
+
+func flattenIBMPIDhcpLeases(leases []*models.DHCPServerLeases, instanceNames map[string]string) []map[string]string {
+	leaseList := make([]map[string]string, 0, len(leases))
+	for _, lease := range leases {
+		if lease == nil {
+			continue
+		}
+		mac := flex.StringValue(lease.InstanceMacAddress)
+		leaseList = append(leaseList, map[string]string{
+			Attr_InstanceIP:   flex.StringValue(lease.InstanceIP),
+			Attr_InstanceMac:  mac,
+			Attr_InstanceName: instanceNames[strings.ToLower(mac)],
+		})
+	}
+	return leaseList
+}
@@ -27,6 +27,11 @@ func DataSourceIBMPIDhcps() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_NetworkID: {
+				Description: "Only list the DHCP Servers of this private network.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 
 			// Attributes
 			Attr_Servers: {
@@ -39,6 +44,30 @@ func DataSourceIBMPIDhcps() *schema.Resource {
 							Description: "ID of the DHCP Server.",
 							Type:        schema.TypeString,
 						},
+						Attr_Leases: {
+							Computed:    true,
+							Description: "List of DHCP Server PVM Instance leases.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_InstanceIP: {
+										Computed:    true,
+										Description: "IP of the PVM Instance.",
+										Type:        schema.TypeString,
+									},
+									Attr_InstanceMac: {
+										Computed:    true,
+										Description: "MAC Address of the PVM Instance.",
+										Type:        schema.TypeString,
+									},
+									Attr_InstanceName: {
+										Computed:    true,
+										Description: "Name of the PVM Instance, which is also its hostname.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
 						Attr_NetworkID: {
 							Computed:    true,
 							Description: "ID of the DHCP Server private network.",
@@ -76,12 +105,33 @@ func dataSourceIBMPIDhcpServersRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	networkID := d.Get(Arg_NetworkID).(string)
+	var instanceNames map[string]string
 	servers := make([]map[string]interface{}, 0, len(dhcpServers))
 	for _, dhcpServer := range dhcpServers {
+		if networkID != "" && (dhcpServer.Network == nil || dhcpServer.Network.ID == nil || *dhcpServer.Network.ID != networkID) {
+			continue
+		}
 		server := map[string]interface{}{
 			Attr_DhcpID: *dhcpServer.ID,
 			Attr_Status: *dhcpServer.Status,
 		}
+
+		// Leases are only returned by the DHCP Server details
+		dhcpServerDetail, err := client.Get(*dhcpServer.ID)
+		if err != nil {
+			log.Printf("[DEBUG] get DHCP failed %v", err)
+			return diag.FromErr(err)
+		}
+		if len(dhcpServerDetail.Leases) > 0 {
+			if instanceNames == nil {
+				instanceNames, err = getIBMPIInstanceNamesByMac(ctx, sess, cloudInstanceID)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			server[Attr_Leases] = flattenIBMPIDhcpLeases(dhcpServerDetail.Leases, instanceNames)
+		}
 		if dhcpServer.Network != nil {
 			dhcpNetwork := dhcpServer.Network
 			if dhcpNetwork.ID != nil {
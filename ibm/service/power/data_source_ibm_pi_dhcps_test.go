@@ -27,9 +27,33 @@ func TestAccIBMPIDhcpServersDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIDhcpServersDataSourceNetworkFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDhcpsDataSourceNetworkFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcps.servers", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_dhcps.servers", "servers.0.network_id", acc.Pi_network_id),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIDhcpsDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_dhcps" "servers" {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIDhcpsDataSourceNetworkFilterConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_dhcps" "servers" {
+			pi_cloud_instance_id = "%s"
+			pi_network_id        = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_network_id)
+}
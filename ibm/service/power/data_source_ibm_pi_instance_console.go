@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Datasource to generate the serial console URL of an instance
+func DataSourceIBMPIInstanceConsole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceConsoleRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceName: {
+				Description:  "The unique identifier or name of the instance.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ConsoleURL: {
+				Computed:    true,
+				Description: "The URL to the serial console of the instance.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceConsoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceName := d.Get(Arg_InstanceName).(string)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	console, err := client.PostConsoleURL(instanceName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, instanceName))
+	d.Set(Attr_ConsoleURL, console.ConsoleURL)
+
+	return nil
+}
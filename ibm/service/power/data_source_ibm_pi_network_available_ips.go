@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPINetworkAvailableIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPINetworkAvailableIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_NetworkID: {
+				Description:  "The network ID.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_AvailableIPCount: {
+				Computed:    true,
+				Description: "The number of unassigned IP addresses in the network.",
+				Type:        schema.TypeInt,
+			},
+			Attr_AvailableIPs: {
+				Computed:    true,
+				Description: "The unassigned IP addresses in the IP address ranges of the network.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPINetworkAvailableIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	networkID := d.Get(Arg_NetworkID).(string)
+
+	networkC := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	network, err := networkC.Get(networkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ports, err := networkC.GetAllPorts(networkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	usedIPs := map[string]bool{}
+	if network.Gateway != "" {
+		usedIPs[network.Gateway] = true
+	}
+	for _, port := range ports.Ports {
+		if port != nil && port.IPAddress != nil {
+			usedIPs[*port.IPAddress] = true
+		}
+	}
+
+	availableIPs, err := getAvailableIPs(network.IPAddressRanges, usedIPs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, networkID))
+	d.Set(Attr_AvailableIPCount, len(availableIPs))
+	d.Set(Attr_AvailableIPs, availableIPs)
+
+	return nil
+}
+
+// getAvailableIPs returns every IPv4 address of the given ranges that is not in usedIPs.
+func getAvailableIPs(ipAddressRanges []*models.IPAddressRange, usedIPs map[string]bool) ([]string, error) {
+	availableIPs := []string{}
+	seen := map[uint64]bool{}
+	for _, ipRange := range ipAddressRanges {
+		if ipRange == nil || ipRange.StartingIPAddress == nil || ipRange.EndingIPAddress == nil {
+			continue
+		}
+		start := net.ParseIP(*ipRange.StartingIPAddress).To4()
+		end := net.ParseIP(*ipRange.EndingIPAddress).To4()
+		if start == nil || end == nil {
+			return nil, fmt.Errorf("invalid IP address range %s - %s", *ipRange.StartingIPAddress, *ipRange.EndingIPAddress)
+		}
+		for i := uint64(binary.BigEndian.Uint32(start)); i <= uint64(binary.BigEndian.Uint32(end)); i++ {
+			if seen[i] {
+				continue
+			}
+			seen[i] = true
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, uint32(i))
+			if !usedIPs[ip.String()] {
+				availableIPs = append(availableIPs, ip.String())
+			}
+		}
+	}
+	return availableIPs, nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMPINetworkAvailableIPsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkAvailableIPsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_network_available_ips.testacc_ds_network_available_ips", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_network_available_ips.testacc_ds_network_available_ips", "available_ip_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkAvailableIPsDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_network_available_ips" "testacc_ds_network_available_ips" {
+			pi_cloud_instance_id = "%s"
+			pi_network_id        = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_network_id)
+}
@@ -6,11 +6,13 @@ package power
 import (
 	"context"
 	"log"
+	"regexp"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +30,25 @@ func DataSourceIBMPINetworks() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_NameRegex: {
+				Description:  "Only list the networks whose name matches this regular expression.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			Arg_NetworkType: {
+				Description:  "Only list the networks of this type.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{DhcpVlan, PubVlan, Vlan}),
+			},
+			Arg_UserTags: {
+				Description: "Only list the networks tagged with all of these user tags.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
 
 			// Attributes
 			Attr_Networks: {
@@ -120,13 +141,56 @@ func dataSourceIBMPINetworksRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	// Filter before flattening, the user tags of every listed network are fetched one by one
+	networks := make([]*models.NetworkReference, 0, len(networkdata.Networks))
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk(Arg_NameRegex); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	networkType := d.Get(Arg_NetworkType).(string)
+	for _, network := range networkdata.Networks {
+		if networkType != "" && flex.StringValue(network.Type) != networkType {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(flex.StringValue(network.Name)) {
+			continue
+		}
+		networks = append(networks, network)
+	}
+
+	result := flattenNetworks(networks, meta)
+	if v, ok := d.GetOk(Arg_UserTags); ok {
+		userTags := flex.ExpandStringList(v.(*schema.Set).List())
+		filtered := make([]map[string]interface{}, 0, len(result))
+		for _, network := range result {
+			if hasNetworkUserTags(network, userTags) {
+				filtered = append(filtered, network)
+			}
+		}
+		result = filtered
+	}
+
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
-	d.Set(Attr_Networks, flattenNetworks(networkdata.Networks, meta))
+	d.Set(Attr_Networks, result)
 
 	return nil
 }
 
+// hasNetworkUserTags checks that a flattened network has all the given user tags
+func hasNetworkUserTags(network map[string]interface{}, userTags []string) bool {
+	tags, ok := network[Attr_UserTags].(*schema.Set)
+	if !ok || tags == nil {
+		return false
+	}
+	for _, tag := range userTags {
+		if !tags.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
 func flattenNetworks(list []*models.NetworkReference, meta interface{}) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
 	for _, i := range list {
@@ -28,9 +28,36 @@ func TestAccIBMPINetworksDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPINetworksDataSource_filter(t *testing.T) {
+	networksResData := "data.ibm_pi_networks.testacc_ds_networks"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworksDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(networksResData, "id"),
+					resource.TestCheckResourceAttr(networksResData, "networks.0.name", acc.Pi_network_name),
+					resource.TestCheckResourceAttr(networksResData, "networks.0.type", "vlan"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPINetworksDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_networks" "testacc_ds_networks" {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPINetworksDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_networks" "testacc_ds_networks" {
+			pi_cloud_instance_id = "%s"
+			pi_name_regex        = "^%s$"
+			pi_network_type      = "vlan"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_network_name)
+}
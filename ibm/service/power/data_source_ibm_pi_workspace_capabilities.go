@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIWorkspaceCapabilities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIWorkspaceCapabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Capabilities: {
+				Computed:    true,
+				Description: "All capabilities of the workspace, for example power-edge-router, vtl, dedicated-hosts or replication, and whether they are enabled.",
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Type:        schema.TypeMap,
+			},
+			Attr_EnabledCapabilities: {
+				Computed:    true,
+				Description: "The names of the enabled capabilities of the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_NetworkSecurityGroupsState: {
+				Computed:    true,
+				Description: "The state of the network security groups configuration of the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_PowerEdgeRouterState: {
+				Computed:    true,
+				Description: "The state of the Power Edge Router of the workspace.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIWorkspaceCapabilitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	// The cloud instance lists the tenant capabilities such as custom-virtualcores, the workspace the
+	// capabilities of its location with their enablement
+	capabilities := map[string]bool{}
+	cloudInstanceClient := instance.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID)
+	cloudInstance, err := cloudInstanceClient.Get(cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, capability := range cloudInstance.Capabilities {
+		capabilities[capability] = true
+	}
+
+	if !sess.IsOnPrem() {
+		wsclient := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+		wsData, err := wsclient.Get(cloudInstanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for capability, enabled := range wsData.Capabilities {
+			capabilities[capability] = capabilities[capability] || enabled
+		}
+		if wsData.Details != nil {
+			if wsData.Details.PowerEdgeRouter != nil && wsData.Details.PowerEdgeRouter.State != nil {
+				d.Set(Attr_PowerEdgeRouterState, *wsData.Details.PowerEdgeRouter.State)
+			}
+			if wsData.Details.NetworkSecurityGroups != nil && wsData.Details.NetworkSecurityGroups.State != nil {
+				d.Set(Attr_NetworkSecurityGroupsState, *wsData.Details.NetworkSecurityGroups.State)
+			}
+		}
+	}
+
+	enabledCapabilities := []string{}
+	for capability, enabled := range capabilities {
+		if enabled {
+			enabledCapabilities = append(enabledCapabilities, capability)
+		}
+	}
+	sort.Strings(enabledCapabilities)
+
+	d.SetId(cloudInstanceID)
+	d.Set(Attr_Capabilities, capabilities)
+	d.Set(Attr_EnabledCapabilities, enabledCapabilities)
+
+	return nil
+}
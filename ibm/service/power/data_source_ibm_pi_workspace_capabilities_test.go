@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIWorkspaceCapabilitiesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceCapabilitiesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace_capabilities.test", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace_capabilities.test", "capabilities.%"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace_capabilities.test", "enabled_capabilities.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceCapabilitiesDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_workspace_capabilities" "test" {
+			pi_cloud_instance_id = "%s"
+		}`, acc.Pi_cloud_instance_id)
+}
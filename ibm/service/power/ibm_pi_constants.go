@@ -46,6 +46,7 @@ const (
 	Arg_DestinationType                      = "pi_destination_type"
 	Arg_DestinationPort                      = "pi_destination_port"
 	Arg_DestinationPorts                     = "pi_destination_ports"
+	Arg_DhcpEnabled                          = "pi_dhcp_enabled"
 	Arg_DhcpID                               = "pi_dhcp_id"
 	Arg_DhcpName                             = "pi_dhcp_name"
 	Arg_DhcpSnatEnabled                      = "pi_dhcp_snat_enabled"
@@ -55,6 +56,7 @@ const (
 	Arg_Enabled                              = "pi_enabled"
 	Arg_EndingIPAddress                      = "pi_ending_ip_address"
 	Arg_FamilyFilter                         = "pi_family_filter"
+	Arg_FirstUsableOffset                    = "pi_first_usable_offset"
 	Arg_Gateway                              = "pi_gateway"
 	Arg_HealthStatus                         = "pi_health_status"
 	Arg_Host                                 = "pi_host"
@@ -69,6 +71,7 @@ const (
 	Arg_ImageBucketFileName                  = "pi_image_bucket_file_name"
 	Arg_ImageBucketName                      = "pi_image_bucket_name"
 	Arg_ImageBucketRegion                    = "pi_image_bucket_region"
+	Arg_ImageChecksum                        = "pi_image_checksum"
 	Arg_ImageID                              = "pi_image_id"
 	Arg_ImageImportDetails                   = "pi_image_import_details"
 	Arg_ImageName                            = "pi_image_name"
@@ -79,6 +82,7 @@ const (
 	Arg_InstanceName                         = "pi_instance_name"
 	Arg_IPAddress                            = "pi_ip_address"
 	Arg_IPAddressRange                       = "pi_ipaddress_range"
+	Arg_IPRangeManagement                    = "pi_ip_range_management"
 	Arg_Key                                  = "pi_ssh_key"
 	Arg_KeyName                              = "pi_key_name"
 	Arg_KeyPairName                          = "pi_key_pair_name"
@@ -86,6 +90,7 @@ const (
 	Arg_LicenseRepositoryCapacity            = "pi_license_repository_capacity"
 	Arg_Memory                               = "pi_memory"
 	Arg_Name                                 = "pi_name"
+	Arg_NameRegex                            = "pi_name_regex"
 	Arg_Network                              = "pi_network"
 	Arg_NetworkAddressGroupID                = "pi_network_address_group_id"
 	Arg_NetworkAddressGroupMemberID          = "pi_network_address_group_member_id"
@@ -94,6 +99,7 @@ const (
 	Arg_NetworkMTU                           = "pi_network_mtu"
 	Arg_NetworkName                          = "pi_network_name"
 	Arg_NetworkPeer                          = "pi_network_peer"
+	Arg_NetworkPort                          = "pi_network_port"
 	Arg_NetworkPortDescription               = "pi_network_port_description"
 	Arg_NetworkPortIPAddress                 = "pi_network_port_ipaddress"
 	Arg_NetworkSecurityGroupID               = "pi_network_security_group_id"
@@ -104,6 +110,7 @@ const (
 	Arg_NextHopType                          = "pi_next_hop_type"
 	Arg_OnboardingVolumes                    = "pi_onboarding_volumes"
 	Arg_PinPolicy                            = "pi_pin_policy"
+	Arg_Placement                            = "pi_placement"
 	Arg_PlacementGroupID                     = "pi_placement_group_id"
 	Arg_PlacementGroupName                   = "pi_placement_group_name"
 	Arg_PlacementGroupPolicy                 = "pi_placement_group_policy"
@@ -119,6 +126,7 @@ const (
 	Arg_ReplicationPolicy                    = "pi_replication_policy"
 	Arg_ReplicationScheme                    = "pi_replication_scheme"
 	Arg_ReplicationSites                     = "pi_replication_sites"
+	Arg_ResizePolicy                         = "pi_resize_policy"
 	Arg_ResourceGroupID                      = "pi_resource_group_id"
 	Arg_RetainVirtualSerialNumber            = "pi_retain_virtual_serial_number"
 	Arg_RouteID                              = "pi_route_id"
@@ -192,6 +200,7 @@ const (
 	Attr_AvailableCores                  = "available_cores"
 	Attr_AvailableHosts                  = "available_hosts"
 	Attr_AvailableIPCount                = "available_ip_count"
+	Attr_AvailableIPs                    = "available_ips"
 	Attr_AvailableMemory                 = "available_memory"
 	Attr_Bootable                        = "bootable"
 	Attr_BootVolumeID                    = "boot_volume_id"
@@ -206,11 +215,14 @@ const (
 	Attr_CloudConnectionID               = "cloud_connection_id"
 	Attr_CloudInstanceID                 = "cloud_instance_id"
 	Attr_CloudInstances                  = "cloud_instances"
+	Attr_CloudStorageObjectURL           = "cloud_storage_object_url"
 	Attr_Code                            = "code"
 	Attr_ConnectionMode                  = "connection_mode"
 	Attr_Connections                     = "connections"
 	Attr_ConsistencyGroupName            = "consistency_group_name"
+	Attr_ConsistencyGroupState           = "consistency_group_state"
 	Attr_ConsoleLanguages                = "console_languages"
+	Attr_ConsoleURL                      = "console_url"
 	Attr_ContainerFormat                 = "container_format"
 	Attr_CopyRate                        = "copy_rate"
 	Attr_CopyType                        = "copy_type"
@@ -247,8 +259,11 @@ const (
 	Attr_DhcpLeases                      = "leases"
 	Attr_DhcpManaged                     = "dhcp_managed"
 	Attr_DhcpNetworkDeprecated           = "network" // to deprecate
+	Attr_DhcpPoolEnd                     = "dhcp_pool_end"
+	Attr_DhcpPoolStart                   = "dhcp_pool_start"
 	Attr_DhcpNetworkID                   = "network_id"
 	Attr_DhcpNetworkName                 = "network_name"
+	Attr_DhcpServerLeases                = "dhcp_leases"
 	Attr_DhcpServers                     = "servers"
 	Attr_DhcpStatus                      = "status"
 	Attr_DisasterRecovery                = "disaster_recovery"
@@ -257,9 +272,12 @@ const (
 	Attr_DiskType                        = "disk_type"
 	Attr_DisplayName                     = "display_name"
 	Attr_DNS                             = "dns"
+	Attr_EndingIPAddress                 = "ending_ip_address"
 	Attr_Enabled                         = "enabled"
+	Attr_EnabledCapabilities             = "enabled_capabilities"
 	Attr_Endianness                      = "endianness"
 	Attr_ExternalIP                      = "external_ip"
+	Attr_Failover                        = "failover"
 	Attr_FailureMessage                  = "failure_message"
 	Attr_FailureReason                   = "failure_reason"
 	Attr_Fault                           = "fault"
@@ -300,6 +318,7 @@ const (
 	Attr_InstanceID                      = "instance_id"
 	Attr_InstanceIP                      = "instance_ip"
 	Attr_InstanceMac                     = "instance_mac"
+	Attr_InstanceName                    = "instance_name"
 	Attr_Instances                       = "instances"
 	Attr_InstanceSnapshots               = "instance_snapshots"
 	Attr_InstanceVolumes                 = "instance_volumes"
@@ -310,6 +329,8 @@ const (
 	Attr_IPaddress                       = "ipaddress"
 	Attr_IPOctet                         = "ipoctet"
 	Attr_IsActive                        = "is_active"
+	Attr_JobID                           = "job_id"
+	Attr_JobStatus                       = "job_status"
 	Attr_Key                             = "key"
 	Attr_KeyCreationDate                 = "creation_date"
 	Attr_KeyID                           = "key_id"
@@ -324,6 +345,7 @@ const (
 	Attr_Location                        = "location"
 	Attr_MacAddress                      = "mac_address"
 	Attr_Macaddress                      = "macaddress"
+	Attr_ManagedIPAddressRanges          = "managed_ip_address_ranges"
 	Attr_MasterChangedVolumeName         = "master_changed_volume_name"
 	Attr_MasterVolumeName                = "master_volume_name"
 	Attr_Max                             = "max"
@@ -368,6 +390,7 @@ const (
 	Attr_NetworkSecurityGroupMemberID    = "network_security_group_member_id"
 	Attr_NetworkSecurityGroups           = "network_security_groups"
 	Attr_NetworkSecurityGroupsHref       = "network_security_groups_href"
+	Attr_NetworkSecurityGroupsState      = "network_security_groups_state"
 	Attr_NextHop                         = "next_hop"
 	Attr_NextHopType                     = "next_hop_type"
 	Attr_NumberOfVolumes                 = "number_of_volumes"
@@ -389,6 +412,7 @@ const (
 	Attr_Port                            = "port"
 	Attr_PortID                          = "portid"
 	Attr_PowerEdgeRouter                 = "power_edge_router"
+	Attr_PowerEdgeRouterState            = "power_edge_router_state"
 	Attr_Primary                         = "primary"
 	Attr_PrimaryRole                     = "primary_role"
 	Attr_PrimaryWorkspace                = "primary_workspace"
@@ -419,6 +443,7 @@ const (
 	Attr_ReservedCores                   = "reserved_cores"
 	Attr_ReservedMemory                  = "reserved_memory"
 	Attr_Reset                           = "reset"
+	Attr_ResizeMethod                    = "resize_method"
 	Attr_ResultsOnboardedVolumes         = "results_onboarded_volumes"
 	Attr_ResultsVolumeOnboardingFailures = "results_volume_onboarding_failures"
 	Attr_RouteID                         = "route_id"
@@ -453,6 +478,7 @@ const (
 	Attr_SSHKeyID                        = "ssh_key_id"
 	Attr_Start                           = "start"
 	Attr_StartTime                       = "start_time"
+	Attr_StartingIPAddress               = "starting_ip_address"
 	Attr_State                           = "state"
 	Attr_Status                          = "status"
 	Attr_StatusDescriptionErrors         = "status_description_errors"
@@ -473,6 +499,7 @@ const (
 	Attr_Systems                         = "systems"
 	Attr_SysType                         = "sys_type"
 	Attr_Systype                         = "systype"
+	Attr_SysTypes                        = "sys_types"
 	Attr_Target                          = "target"
 	Attr_TargetLocations                 = "target_locations"
 	Attr_TargetVolumeName                = "target_volume_name"
@@ -490,6 +517,7 @@ const (
 	Attr_TotalStandardStorageConsumed    = "total_standard_storage_consumed"
 	Attr_Type                            = "type"
 	Attr_Uncapped                        = "uncapped"
+	Attr_UnmanagedIPAddressRanges        = "unmanaged_ip_address_ranges"
 	Attr_UpdatedDate                     = "updated_date"
 	Attr_URL                             = "url"
 	Attr_UsedCore                        = "used_core"
@@ -505,6 +533,7 @@ const (
 	Attr_VirtualSerialNumbers            = "virtual_serial_numbers"
 	Attr_Visibility                      = "visibility"
 	Attr_VLanID                          = "vlan_id"
+	Attr_Volume                          = "volume"
 	Attr_VolumeGroupID                   = "volume_group_id"
 	Attr_VolumeGroupName                 = "volume_group_name"
 	Attr_VolumeGroups                    = "volume_groups"
@@ -539,8 +568,11 @@ const (
 	Affinity                  = "affinity"
 	All                       = "all"
 	Allow                     = "allow"
+	AlwaysStop                = "always-stop"
 	AntiAffinity              = "anti-affinity"
+	Append                    = "append"
 	Attach                    = "attach"
+	Auto                      = "auto"
 	AutoAssign                = "auto-assign"
 	Aux                       = "aux"
 	Both                      = "both"
@@ -558,7 +590,9 @@ const (
 	DeploymentTypeVMNoStorage = "VMNoStorage"
 	DestinationUnreach        = "destination-unreach"
 	Detach                    = "detach"
+	DhcpVlan                  = "dhcp-vlan"
 	Disable                   = "disable"
+	DLPAR                     = "dlpar"
 	Echo                      = "echo"
 	EchoReply                 = "echo-reply"
 	Enable                    = "enable"
@@ -567,6 +601,7 @@ const (
 	Host                      = "host"
 	HostGroup                 = "hostGroup"
 	ICMP                      = "icmp"
+	Ignore                    = "ignore"
 	ImageCatalog              = "image-catalog"
 	IPV4_Address              = "ipv4-address"
 	L2                        = "L2"
@@ -577,8 +612,10 @@ const (
 	NAG                       = "network-address-group"
 	Netweaver                 = "Netweaver"
 	Network_Interface         = "network-interface"
+	NeverStop                 = "never-stop"
 	None                      = "none"
 	NSG                       = "network-security-group"
+	Offline                   = "offline"
 	OK                        = "OK"
 	PER                       = "power-edge-router"
 	Prefix                    = "prefix"
@@ -589,6 +626,8 @@ const (
 	Shared                    = "shared"
 	Soft                      = "soft"
 	SourceQuench              = "source-quench"
+	StopStart                 = "stop-start"
+	Strict                    = "strict"
 	Suffix                    = "suffix"
 	TCP                       = "tcp"
 	TimeExceeded              = "time-exceeded"
@@ -653,6 +692,14 @@ const (
 	State_VerifyResize       = "verify_resize"
 	State_Waiting            = "waiting"
 
+	// Replication states of a consistency group
+	State_ConsistentCopying      = "consistent_copying"
+	State_ConsistentStopped      = "consistent_stopped"
+	State_ConsistentSynchronized = "consistent_synchronized"
+	State_Idling                 = "idling"
+	State_InconsistentCopying    = "inconsistent_copying"
+	State_InconsistentStopped    = "inconsistent_stopped"
+
 	// Timeout values
 	Timeout_Active   = 2 * time.Minute
 	Timeout_Delay    = 60 * time.Second
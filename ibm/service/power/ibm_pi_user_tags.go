@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The user tags of Power resources are global tags attached to the CRN of the resource. Tagging calls
+// are retried when they are rate limited or fail on the server or network side, and the search service
+// indexes tag changes with a delay, so updates are read back until they show. The tagging and search
+// clients are called directly, the helpers of flex do not return the HTTP response.
+const userTagsTimeout = 5 * time.Minute
+
+// updateIBMPIUserTags attaches and detaches the user tags of the resource with the given CRN to match the
+// planned change of the tags argument with the given key. Failures are returned as warnings, the
+// resource itself was changed and the next read shows the tags that are actually attached.
+func updateIBMPIUserTags(ctx context.Context, d *schema.ResourceData, meta interface{}, key, crn string) diag.Diagnostics {
+	oldList, newList := d.GetChange(key)
+	removed := oldList.(*schema.Set).Difference(newList.(*schema.Set))
+	added := newList.(*schema.Set).Difference(oldList.(*schema.Set))
+
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return userTagsWarning("update", crn, err)
+	}
+	resources := []globaltaggingv1.Resource{{ResourceID: &crn}}
+
+	if removed.Len() > 0 {
+		err = retry.RetryContext(ctx, userTagsTimeout, func() *retry.RetryError {
+			_, resp, err := gtClient.DetachTagWithContext(ctx, &globaltaggingv1.DetachTagOptions{
+				Resources: resources,
+				TagNames:  flex.FlattenSet(removed),
+				TagType:   flex.PtrToString(UserTagType),
+			})
+			return userTagsRetryError(resp, err)
+		})
+		if err != nil {
+			return userTagsWarning("update", crn, err)
+		}
+	}
+
+	// Tags of the environment, for example of Schematics, are attached with the user tags like flex does
+	add := flex.FlattenSet(added)
+	if envTags := os.Getenv("IC_ENV_TAGS"); envTags != "" {
+		add = append(add, strings.Split(envTags, ",")...)
+	}
+	if len(add) > 0 {
+		err = retry.RetryContext(ctx, userTagsTimeout, func() *retry.RetryError {
+			_, resp, err := gtClient.AttachTagWithContext(ctx, &globaltaggingv1.AttachTagOptions{
+				Resources: resources,
+				TagNames:  add,
+				TagType:   flex.PtrToString(UserTagType),
+			})
+			return userTagsRetryError(resp, err)
+		})
+		if err != nil {
+			return userTagsWarning("update", crn, err)
+		}
+	}
+
+	err = retry.RetryContext(ctx, userTagsTimeout, func() *retry.RetryError {
+		tags, resp, err := getIBMPIUserTags(ctx, meta, crn)
+		if err != nil {
+			return userTagsRetryError(resp, err)
+		}
+		if tags.Intersection(newList.(*schema.Set)).Len() != newList.(*schema.Set).Len() || tags.Intersection(removed).Len() > 0 {
+			return retry.RetryableError(fmt.Errorf("user tags %v are not attached yet, found %v", flex.FlattenSet(newList.(*schema.Set)), flex.FlattenSet(tags)))
+		}
+		return nil
+	})
+	if err != nil {
+		return userTagsWarning("verify", crn, err)
+	}
+
+	return nil
+}
+
+// setIBMPIUserTags sets the tags attribute with the given key to the user tags of the resource with the
+// given CRN. The attribute keeps its value when the tags cannot be read.
+func setIBMPIUserTags(ctx context.Context, d *schema.ResourceData, meta interface{}, key, crn string) diag.Diagnostics {
+	var tags *schema.Set
+	err := retry.RetryContext(ctx, userTagsTimeout, func() *retry.RetryError {
+		var resp *core.DetailedResponse
+		var err error
+		tags, resp, err = getIBMPIUserTags(ctx, meta, crn)
+		return userTagsRetryError(resp, err)
+	})
+	if err != nil {
+		return userTagsWarning("get", crn, err)
+	}
+	d.Set(key, tags)
+
+	return nil
+}
+
+// getIBMPIUserTags returns the user tags of the resource with the given CRN from the search service.
+func getIBMPIUserTags(ctx context.Context, meta interface{}, crn string) (*schema.Set, *core.DetailedResponse, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, nil, err
+	}
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(fmt.Sprintf("crn:\"%s\"", crn))
+	options.SetFields([]string{"tags"})
+	result, resp, err := gsClient.SearchWithContext(ctx, options)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to search the tags of %s: %w", crn, err)
+	}
+
+	tags := []string{}
+	if len(result.Items) > 0 {
+		if t := result.Items[0].GetProperty("tags"); t != nil && reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				tags = append(tags, fmt.Sprintf("%s", s.Index(i)))
+			}
+		}
+	}
+	return flex.NewStringSet(flex.ResourceIBMVPCHash, tags), resp, nil
+}
+
+// userTagsRetryError returns the result of a tagging or search call for retry.RetryContext. The call is
+// retried when it was rate limited, failed with a server error, or got no response because of a network
+// error.
+func userTagsRetryError(resp *core.DetailedResponse, err error) *retry.RetryError {
+	if err == nil {
+		return nil
+	}
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return retry.RetryableError(err)
+	}
+	return retry.NonRetryableError(err)
+}
+
+func userTagsWarning(action, crn string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to %s the user tags of %s", action, crn),
+			Detail:   err.Error(),
+		},
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -121,11 +123,26 @@ func ResourceIBMPICapture() *schema.Resource {
 				Description: "The CRN of the resource.",
 				Type:        schema.TypeString,
 			},
+			Attr_CloudStorageObjectURL: {
+				Computed:    true,
+				Description: "The URL of the exported image object in the Cloud Storage bucket.",
+				Type:        schema.TypeString,
+			},
 			Attr_ImageID: {
 				Computed:    true,
 				Description: "The image id of the capture instance.",
 				Type:        schema.TypeString,
 			},
+			Attr_JobID: {
+				Computed:    true,
+				Description: "The ID of the capture job.",
+				Type:        schema.TypeString,
+			},
+			Attr_JobStatus: {
+				Computed:    true,
+				Description: "The status of the capture job.",
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
@@ -188,12 +205,27 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, capturename, capturedestination))
+	// Keep the job so that destroying a capture that did not complete cancels it
+	d.Set(Attr_JobID, *captureResponse.ID)
 	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	job, err := waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
+	if capturedestination != ImageCatalog {
+		objectURL, err := getIBMPICaptureCosObjectURL(ctx, captureBody.CloudStorageRegion, captureBody.CloudStorageImagePath, captureBody.CloudStorageAccessKey, captureBody.CloudStorageSecretKey, capturename, job.(*models.Job))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to find the exported image of capture %s in Cloud Storage", capturename),
+				Detail:   err.Error(),
+			})
+		}
+		d.Set(Attr_CloudStorageObjectURL, objectURL)
+	}
+
 	if _, ok := d.GetOk(Arg_UserTags); ok && capturedestination != CloudStorage {
 		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 		imagedata, err := imageClient.Get(capturename)
@@ -204,15 +236,11 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 			return diag.Errorf("Error on get of ibm pi capture (%s) while applying pi_user_tags: %s", capturename, err)
 		}
 		if imagedata.Crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imagedata.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(imagedata.Crn))...)
 		}
 	}
 
-	return resourceIBMPICaptureRead(ctx, d, meta)
+	return append(diags, resourceIBMPICaptureRead(ctx, d, meta)...)
 }
 
 func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -227,6 +255,7 @@ func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta
 	cloudInstanceID := parts[0]
 	captureID := parts[1]
 	capturedestination := parts[2]
+	var diags diag.Diagnostics
 	if capturedestination != CloudStorage {
 		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 		imagedata, err := imageClient.Get(captureID)
@@ -245,15 +274,21 @@ func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(Attr_ImageID, imageid)
 		if imagedata.Crn != "" {
 			d.Set(Attr_CRN, imagedata.Crn)
-			tags, err := flex.GetGlobalTagsUsingCRN(meta, string(imagedata.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on get of ibm pi capture (%s) pi_user_tags: %s", *imagedata.ImageID, err)
-			}
-			d.Set(Arg_UserTags, tags)
+			diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(imagedata.Crn))...)
+		}
+	}
+	if jobID, ok := d.GetOk(Attr_JobID); ok {
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		job, err := jobClient.Get(jobID.(string))
+		if err != nil {
+			// Jobs are removed some time after they finished, keep the last known status
+			log.Printf("[DEBUG] get capture job %s failed %v", jobID, err)
+		} else if job.Status != nil && job.Status.State != nil {
+			d.Set(Attr_JobStatus, *job.Status.State)
 		}
 	}
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
-	return nil
+	return diags
 }
 
 func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -268,6 +303,12 @@ func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, met
 	cloudInstanceID := parts[0]
 	captureID := parts[1]
 	capturedestination := parts[2]
+	if jobID, ok := d.GetOk(Attr_JobID); ok {
+		err = cancelIBMPICaptureJob(ctx, instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID), jobID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if capturedestination != CloudStorage {
 		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 		err = imageClient.Delete(captureID)
@@ -292,18 +333,70 @@ func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	captureID := parts[1]
 	capturedestination := parts[2]
 
+	var diags diag.Diagnostics
 	if capturedestination != CloudStorage && d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags: %s", captureID, err)
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
+		}
+	}
+
+	return append(diags, resourceIBMPICaptureRead(ctx, d, meta)...)
+}
+
+// cancelIBMPICaptureJob cancels the capture job when it is still queued or running.
+func cancelIBMPICaptureJob(ctx context.Context, client *instance.IBMPIJobClient, jobID string) error {
+	job, err := client.Get(jobID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			return nil
+		}
+		return err
+	}
+	if job.Status == nil || job.Status.State == nil || *job.Status.State == State_Completed || *job.Status.State == State_Failed {
+		return nil
+	}
+
+	log.Printf("[INFO] cancelling capture job %s in state %s", jobID, *job.Status.State)
+	err = client.Delete(jobID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel capture job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+// getIBMPICaptureCosObjectURL returns the URL of the image exported by the capture job to the Cloud Storage
+// image path. The job does not report the exported object, so the object is the one named after the capture,
+// <capture name>.<extension>, that was written since the job was created.
+func getIBMPICaptureCosObjectURL(ctx context.Context, region, imagePath, accessKey, secretKey, captureName string, job *models.Job) (string, error) {
+	s3Client := newIBMPICosClient(region, accessKey, secretKey)
+	bucket, prefix := splitIBMPICosPath(imagePath, captureName+".")
+	jobCreated := time.Time(job.CreateTimestamp)
+
+	keys := []string{}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			// The exported image is the only object of the capture that is in the folder, not in a sub folder
+			if object.Key == nil || strings.Contains(strings.TrimPrefix(*object.Key, prefix), "/") {
+				continue
+			}
+			if object.LastModified != nil && object.LastModified.Before(jobCreated) {
+				continue
 			}
+			keys = append(keys, *object.Key)
 		}
+		return true
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the objects %s* in bucket %s: %w", prefix, bucket, err)
+	}
+	if len(keys) != 1 {
+		return "", fmt.Errorf("expected one image exported by capture job %s as %s* in bucket %s, found %d", *job.ID, prefix, bucket, len(keys))
 	}
 
-	return resourceIBMPICaptureRead(ctx, d, meta)
+	return fmt.Sprintf("https://%s/%s/%s", getIBMPICosEndpoint(region), bucket, keys[0]), nil
 }
@@ -33,6 +33,8 @@ func TestAccIBMPICaptureBasic(t *testing.T) {
 					testAccCheckIBMPICaptureExists(captureRes),
 					resource.TestCheckResourceAttr(captureRes, "pi_capture_name", name),
 					resource.TestCheckResourceAttrSet(captureRes, "image_id"),
+					resource.TestCheckResourceAttrSet(captureRes, "job_id"),
+					resource.TestCheckResourceAttr(captureRes, "job_status", "completed"),
 				),
 			},
 		},
@@ -107,6 +109,9 @@ func TestAccIBMPICaptureCloudStorage(t *testing.T) {
 				Config: testAccCheckIBMPICaptureCloudStorageConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(captureRes, "pi_capture_name", name),
+					resource.TestCheckResourceAttrSet(captureRes, "cloud_storage_object_url"),
+					resource.TestCheckResourceAttrSet(captureRes, "job_id"),
+					resource.TestCheckResourceAttr(captureRes, "job_status", "completed"),
 				),
 			},
 		},
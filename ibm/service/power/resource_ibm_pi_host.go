@@ -219,15 +219,12 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	host := hosts[0].(map[string]interface{})
 	tags := flex.FlattenSet(host[Attr_UserTags].(*schema.Set))
+	var diags diag.Diagnostics
 	if hostResponse[0].Crn != "" && len(tags) > 0 {
-		oldList, newList := d.GetChange(Arg_Host + ".0." + Attr_UserTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(hostResponse[0].Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on update of pi host (%s) user_tags during creation: %s", hostResponse[0].ID, err)
-		}
+		diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_Host+".0."+Attr_UserTags, string(hostResponse[0].Crn))...)
 	}
 
-	return resourceIBMPIHostRead(ctx, d, meta)
+	return append(diags, resourceIBMPIHostRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -259,13 +256,10 @@ func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta int
 	if host.Capacity != nil {
 		d.Set(Attr_Capacity, hostCapacityToMap(host.Capacity))
 	}
+	var diags diag.Diagnostics
 	if host.Crn != "" {
 		d.Set(Attr_CRN, host.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(host.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi host (%s) user_tags: %s", host.ID, err)
-		}
-		d.Set(Attr_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Attr_UserTags, string(host.Crn))...)
 	}
 	if host.DisplayName != "" {
 		d.Set(Attr_DisplayName, host.DisplayName)
@@ -284,7 +278,7 @@ func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	d.Set(Arg_Host, flattenHostArgumentToList(d))
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -297,6 +291,7 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_Host) {
 		oldHost, newHost := d.GetChange(Arg_Host + ".0")
 
@@ -313,20 +308,13 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			}
 		}
 
-		if crn, ok := d.GetOk(Attr_CRN); ok {
-			userTagsOld := oldHost.(map[string]interface{})[Attr_UserTags].(*schema.Set)
-			userTagsNew := newHost.(map[string]interface{})[Attr_UserTags].(*schema.Set)
-			if !userTagsNew.Equal(userTagsOld) {
-				err = flex.UpdateGlobalTagsUsingCRN(userTagsOld, userTagsNew, meta, crn.(string), "", UserTagType)
-				if err != nil {
-					log.Printf("Error on update of pi host (%s) pi_host user_tags: %s", d.Get(Attr_HostID), err)
-				}
-			}
+		if crn, ok := d.GetOk(Attr_CRN); ok && d.HasChange(Arg_Host+".0."+Attr_UserTags) {
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_Host+".0."+Attr_UserTags, crn.(string))...)
 		}
 
 	}
 
-	return resourceIBMPIHostRead(ctx, d, meta)
+	return append(diags, resourceIBMPIHostRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -5,11 +5,20 @@ package power
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -132,6 +141,15 @@ func ResourceIBMPIImage() *schema.Resource {
 				RequiredWith:  []string{Arg_ImageBucketName},
 				Type:          schema.TypeString,
 			},
+			Arg_ImageChecksum: {
+				ConflictsWith: []string{Arg_ImageID},
+				Description:   "SHA256 checksum of the Cloud Object Storage image file, verified once the image is imported. The file is read from the bucket and hashed",
+				ForceNew:      true,
+				Optional:      true,
+				RequiredWith:  []string{Arg_ImageBucketName},
+				Type:          schema.TypeString,
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[a-fA-F0-9]{64}$`), "must be a hex encoded SHA256 checksum"),
+			},
 			Arg_ImageID: {
 				ConflictsWith: []string{Arg_ImageBucketName},
 				Description:   "Instance image id",
@@ -233,6 +251,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	client := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 	// image copy
+	var diags diag.Diagnostics
 	if v, ok := d.GetOk(Arg_ImageID); ok {
 		imageid := v.(string)
 		source := "root-project"
@@ -259,11 +278,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 
 		if _, ok := d.GetOk(Arg_UserTags); ok {
 			if imageResponse.Crn != "" {
-				oldList, newList := d.GetChange(Arg_UserTags)
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imageResponse.Crn), "", UserTagType)
-				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err)
-				}
+				diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(imageResponse.Crn))...)
 			}
 		}
 	}
@@ -352,19 +367,24 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(err)
 		}
 
+		if checksum, ok := d.GetOk(Arg_ImageChecksum); ok {
+			err = verifyIBMPIImageCosChecksum(ctx, bucketRegion, bucketName, bucketImageFileName, d.Get(Arg_ImageAccessKey).(string), d.Get(Arg_ImageSecretKey).(string), checksum.(string))
+			if err != nil {
+				// Keep the imported image in state, it is tainted and replaced or destroyed by the next apply
+				d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *image.ImageID))
+				return diag.FromErr(err)
+			}
+		}
+
 		if _, ok := d.GetOk(Arg_UserTags); ok {
 			if image.Crn != "" {
-				oldList, newList := d.GetChange(Arg_UserTags)
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(image.Crn), "", UserTagType)
-				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err)
-				}
+				diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(image.Crn))...)
 			}
 		}
 		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *image.ImageID))
 	}
 
-	return resourceIBMPIImageRead(ctx, d, meta)
+	return append(diags, resourceIBMPIImageRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -393,38 +413,32 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	imageid := *imagedata.ImageID
+	var diags diag.Diagnostics
 	if imagedata.Crn != "" {
 		d.Set(Attr_CRN, imagedata.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(imagedata.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of image (%s) pi_user_tags: %s", *imagedata.ImageID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(imagedata.Crn))...)
 	}
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	d.Set(Attr_ImageID, imageid)
 	d.Set(Arg_ImageName, imagedata.Name)
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	_, imageID, err := splitID(d.Id())
+	_, _, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi image (%s) pi_user_tags: %s", imageID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPIImageRead(ctx, d, meta)
+	return append(diags, resourceIBMPIImageRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -480,7 +494,64 @@ func isIBMPIImageRefreshFunc(client *instance.IBMPIImageClient, id string) retry
 	}
 }
 
+// newIBMPICosClient returns a client for the endpoint of the given Cloud Object Storage region,
+// anonymous when no access key is given.
+func newIBMPICosClient(region, accessKey, secretKey string) *s3.S3 {
+	creds := credentials.AnonymousCredentials
+	if accessKey != "" {
+		creds = credentials.NewStaticCredentials(accessKey, secretKey, "")
+	}
+	conf := aws.NewConfig().
+		WithCredentials(creds).
+		WithEndpoint(getIBMPICosEndpoint(region)).
+		WithS3ForcePathStyle(true)
+	return s3.New(session.Must(session.NewSession()), conf)
+}
+
+func getIBMPICosEndpoint(region string) string {
+	return conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region))
+}
+
+// splitIBMPICosPath splits a bucket-name[/optional/folder] path and returns the bucket and the key of
+// the given file name in the folder.
+func splitIBMPICosPath(path, fileName string) (string, string) {
+	bucket, folder, _ := strings.Cut(path, "/")
+	key := fileName
+	if folder = strings.Trim(folder, "/"); folder != "" {
+		key = folder + "/" + fileName
+	}
+	return bucket, key
+}
+
+// verifyIBMPIImageCosChecksum streams the imported Cloud Object Storage file through SHA256 and compares
+// the sum with the given checksum, the image itself does not expose a checksum once imported.
+func verifyIBMPIImageCosChecksum(ctx context.Context, region, bucketName, fileName, accessKey, secretKey, checksum string) error {
+	s3Client := newIBMPICosClient(region, accessKey, secretKey)
+	bucket, key := splitIBMPICosPath(bucketName, fileName)
+
+	object, err := s3Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read image file %s in bucket %s to verify its checksum: %w", key, bucket, err)
+	}
+	defer object.Body.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, object.Body); err != nil {
+		return fmt.Errorf("failed to read image file %s in bucket %s to verify its checksum: %w", key, bucket, err)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(sum, checksum) {
+		return fmt.Errorf("checksum mismatch for image file %s in bucket %s: expected %s, got %s", key, bucket, checksum, sum)
+	}
+	return nil
+}
+
 func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClient, jobID string, timeout time.Duration) (interface{}, error) {
+	var lastState string
+	var lastMessage interface{}
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{State_Queued, State_ReadyForProcessing, State_inProgress, State_Running, State_Waiting},
 		Target:  []string{State_Completed, State_Failed},
@@ -498,11 +569,17 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClie
 				log.Printf("[DEBUG] job status failed with message: %v", job.Status.Message)
 				return nil, State_Failed, fmt.Errorf("job status failed for job id %s with message: %v", jobID, job.Status.Message)
 			}
+			lastState, lastMessage = *job.Status.State, job.Status.Message
+			log.Printf("[INFO] job %s is %s, progress: %v, message: %v", jobID, lastState, job.Status.Progress, job.Status.Message)
 			return job, *job.Status.State, nil
 		},
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	job, err := stateConf.WaitForStateContext(ctx)
+	if _, ok := err.(*retry.TimeoutError); ok && lastState != "" {
+		return job, fmt.Errorf("timeout while waiting for job id %s, last state %s with message: %v", jobID, lastState, lastMessage)
+	}
+	return job, err
 }
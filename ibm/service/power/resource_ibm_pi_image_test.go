@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -134,6 +136,37 @@ func testAccCheckIBMPIImageCOSPublicConfig(name string) string {
 	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name)
 }
 
+func TestAccIBMPIImageCOSChecksumMismatch(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-image-%d", acctest.RandIntRange(10, 100))
+	checksum := strings.Repeat("0", 64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIImageCOSChecksumConfig(name, checksum),
+				ExpectError: regexp.MustCompile("checksum mismatch"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImageCOSChecksumConfig(name, checksum string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image" "cos_image" {
+		pi_image_name       = "%[1]s"
+		pi_cloud_instance_id = "%[2]s"
+		pi_image_bucket_name = "%[3]s"
+		pi_image_bucket_access = "public"
+		pi_image_bucket_region = "us-east"
+		pi_image_bucket_file_name = "%[4]s"
+		pi_image_checksum = "%[5]s"
+		pi_image_storage_type = "tier1"
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name, checksum)
+}
+
 func TestAccIBMPIImageUserTags(t *testing.T) {
 	imageRes := "ibm_pi_image.power_image"
 	userTagsString := `["env:dev","test_tag"]`
@@ -42,6 +42,8 @@ func ResourceIBMPIInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIInstanceResizeCustomizeDiff,
+			resourceIBMPIInstancePlacementCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -231,6 +233,55 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{None, Soft, Hard}),
 			},
+			Arg_Placement: {
+				ConflictsWith: []string{Arg_AffinityInstance, Arg_AffinityPolicy, Arg_AffinityVolume, Arg_AntiAffinityInstances, Arg_AntiAffinityVolumes, Arg_PlacementGroupID},
+				Description:   "Placement of the pvm instance: the storage affinity policy applied when the instance is created, ignored if pi_storage_pool provided, and the placement group of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Instance: {
+							Description: "PVM Instance (ID or Name) to base storage affinity policy against; conflicts with volume",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_Instances: {
+							Description: "List of pvmInstances to base storage anti-affinity policy against; conflicts with volumes",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeList,
+						},
+						Attr_PlacementGroupID: {
+							Description: "The ID of the placement group of the instance, its affinity or anti-affinity policy places the instance on the same or a different host than the other members",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_Policy: {
+							Description:  "Storage affinity policy, affinity requires one of instance or volume, anti-affinity requires one of instances or volumes",
+							ForceNew:     true,
+							Optional:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{Affinity, AntiAffinity}),
+						},
+						Attr_Volume: {
+							Description: "Volume (ID or Name) to base storage affinity policy against; conflicts with instance",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_Volumes: {
+							Description: "List of volumes to base storage anti-affinity policy against; conflicts with instances",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeList,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
 			Arg_PlacementGroupID: {
 				Description: "Placement group ID",
 				Optional:    true,
@@ -282,6 +333,13 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Set:         schema.HashString,
 				Type:        schema.TypeSet,
 			},
+			Arg_ResizePolicy: {
+				Default:      Auto,
+				Description:  "How processor and memory changes are applied to a running instance: auto resizes the running instance (DLPAR) when the new values are within the minimum and maximum of the instance and stops it otherwise, always-stop always stops the instance for the change, never-stop fails changes that would need to stop the instance.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{AlwaysStop, Auto, NeverStop}),
+			},
 			Arg_RetainVirtualSerialNumber: {
 				Default:     false,
 				Description: "Indicates whether to retain virtual serial number when changed or deleted.",
@@ -485,6 +543,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_ResizeMethod: {
+				Computed:    true,
+				Description: "How the last processor or memory change is applied: dlpar, stop-start or offline.",
+				Type:        schema.TypeString,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -565,14 +628,11 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// If user tags are set, make sure tags are set correctly before moving on
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
-		oldList, newList := d.GetChange(Arg_UserTags)
 		for _, s := range *pvmList {
 			if s.Crn != "" {
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(s.Crn), "", UserTagType)
-				if err != nil {
-					log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
-				}
+				diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(s.Crn))...)
 			}
 		}
 	}
@@ -592,7 +652,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -615,13 +675,10 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if powervmdata.Crn != "" {
 		d.Set(Attr_CRN, powervmdata.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(powervmdata.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of ibm pi instance (%s) pi_user_tags: %s", *powervmdata.PvmInstanceID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(powervmdata.Crn))...)
 	}
 	d.Set(Arg_Memory, powervmdata.Memory)
 	d.Set(Arg_Processors, powervmdata.Processors)
@@ -637,7 +694,14 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(Attr_InstanceID, powervmdata.PvmInstanceID)
 	d.Set(Attr_MinProcessors, powervmdata.Minproc)
 	d.Set(Attr_Progress, powervmdata.Progress)
-	if *powervmdata.PlacementGroup != None {
+	if placement, ok := d.GetOk(Arg_Placement); ok && len(placement.([]interface{})) > 0 && placement.([]interface{})[0] != nil {
+		placementMap := placement.([]interface{})[0].(map[string]interface{})
+		placementMap[Attr_PlacementGroupID] = ""
+		if *powervmdata.PlacementGroup != None {
+			placementMap[Attr_PlacementGroupID] = *powervmdata.PlacementGroup
+		}
+		d.Set(Arg_Placement, []interface{}{placementMap})
+	} else if *powervmdata.PlacementGroup != None {
 		d.Set(Arg_PlacementGroupID, powervmdata.PlacementGroup)
 	}
 	d.Set(Arg_SharedProcessorPool, powervmdata.SharedProcessorPool)
@@ -712,7 +776,7 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(Arg_VirtualSerialNumber, nil)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -829,20 +893,30 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	// Start of the change for Memory and Processors
 	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
 
-		maxMemLpar := d.Get(Attr_MaxMemory).(float64)
-		maxCPULpar := d.Get(Attr_MaxProcessors).(float64)
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-			log.Printf("Will require a shutdown to perform the change")
-		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
+		// The resize method is decided again from the current instance, its status or its ranges can
+		// have changed since the plan, for example by the earlier changes of this update
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		instanceState := ""
+		if pvm.Status != nil {
+			instanceState = *pvm.Status
 		}
-
-		instanceState := d.Get(Attr_Status).(string)
 		log.Printf("the instance state is %s", instanceState)
 
-		if (mem > maxMemLpar || procs > maxCPULpar) && strings.ToLower(instanceState) != State_Shutoff {
+		resizeMethod, err := getPIInstanceResizeMethod(d.Get(Arg_ResizePolicy).(string), instanceState, mem, procs,
+			pvm.Minmem, pvm.Maxmem, pvm.Minproc, pvm.Maxproc)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if plannedMethod := d.Get(Attr_ResizeMethod).(string); plannedMethod != "" && plannedMethod != resizeMethod {
+			return diag.Errorf("the processor and memory change was planned with the %s method but the instance now requires the %s method, run the plan again", plannedMethod, resizeMethod)
+		}
+		log.Printf("the processor and memory change is applied with method %s", resizeMethod)
+		d.Set(Attr_ResizeMethod, resizeMethod)
+
+		if resizeMethod == StopStart {
 			err = performChangeAndReboot(ctx, client, d, instanceID, mem, procs)
 			if err != nil {
 				return diag.FromErr(err)
@@ -935,12 +1009,23 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(Arg_PlacementGroupID) {
-		pgClient := instance.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
+	if d.HasChange(Arg_PinPolicy) {
+		pinPolicy := d.Get(Arg_PinPolicy).(string)
+		body := &models.PVMInstanceUpdate{
+			PinPolicy: models.PinPolicy(pinPolicy),
+		}
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.Errorf("failed to update the lpar with the change for pin policy: %v", err)
+		}
+		_, err = isWaitForPIInstancePinPolicyUpdated(ctx, client, instanceID, pinPolicy, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-		oldRaw, newRaw := d.GetChange(Arg_PlacementGroupID)
-		old := oldRaw.(string)
-		new := newRaw.(string)
+	if old, new := getPIInstancePlacementGroupChange(d); old != new {
+		pgClient := instance.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
 
 		if len(strings.TrimSpace(old)) > 0 {
 			placementGroupID := old
@@ -1010,13 +1095,10 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			return diag.FromErr(err)
 		}
 	}
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
@@ -1142,7 +1224,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1487,6 +1569,30 @@ func isPIInstanceRefreshFuncOff(client *instance.IBMPIInstanceClient, id string)
 	}
 }
 
+func isWaitForPIInstancePinPolicyUpdated(ctx context.Context, client *instance.IBMPIInstanceClient, id, pinPolicy string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for PIInstance (%s) pin policy to be %s", id, pinPolicy)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Retry},
+		Target:  []string{State_Available},
+		Refresh: func() (interface{}, string, error) {
+			pvm, err := client.Get(id)
+			if err != nil {
+				return nil, "", err
+			}
+			if string(pvm.PinPolicy) != pinPolicy {
+				return pvm, State_Retry, nil
+			}
+			return pvm, State_Available, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
 func stopLparForResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, d *schema.ResourceData) error {
 	body := &models.PVMInstanceAction{
 		//Action: flex.PtrToString("stop"),
@@ -1592,6 +1698,185 @@ func isPIInstanceShutAfterResourceChange(client *instance.IBMPIInstanceClient, i
 	}
 }
 
+// getPIInstanceResizeMethod decides how a processor and memory change is applied to an instance.
+// A running instance can only be resized in place (DLPAR) within its minimum and maximum values.
+func getPIInstanceResizeMethod(policy, status string, mem, procs, minMem, maxMem, minProcs, maxProcs float64) (string, error) {
+	if strings.ToLower(status) == State_Shutoff {
+		return Offline, nil
+	}
+	if policy == AlwaysStop {
+		return StopStart, nil
+	}
+	if mem >= minMem && mem <= maxMem && procs >= minProcs && procs <= maxProcs {
+		return DLPAR, nil
+	}
+	if policy == NeverStop {
+		return "", fmt.Errorf("%s is %s but the change to %v memory and %v processors is outside of the memory range %v-%v or processor range %v-%v that can be applied without stopping the instance",
+			Arg_ResizePolicy, NeverStop, mem, procs, minMem, maxMem, minProcs, maxProcs)
+	}
+	return StopStart, nil
+}
+
+// resourceIBMPIInstanceResizeCustomizeDiff shows the resize method of a processor or memory change in the plan.
+func resourceIBMPIInstanceResizeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !(diff.HasChange(Arg_Memory) || diff.HasChange(Arg_Processors)) {
+		return nil
+	}
+	if !diff.NewValueKnown(Arg_Memory) || !diff.NewValueKnown(Arg_Processors) {
+		return diff.SetNewComputed(Attr_ResizeMethod)
+	}
+	resizeMethod, err := getPIInstanceResizeMethod(diff.Get(Arg_ResizePolicy).(string), diff.Get(Attr_Status).(string),
+		diff.Get(Arg_Memory).(float64), diff.Get(Arg_Processors).(float64),
+		diff.Get(Attr_MinMemory).(float64), diff.Get(Attr_MaxMemory).(float64), diff.Get(Attr_MinProcessors).(float64), diff.Get(Attr_MaxProcessors).(float64))
+	if err != nil {
+		return err
+	}
+	return diff.SetNew(Attr_ResizeMethod, resizeMethod)
+}
+
+// resourceIBMPIInstancePlacementCustomizeDiff rejects a pi_placement whose targets do not match its policy.
+func resourceIBMPIInstancePlacementCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	placement, ok := diff.GetOk(Arg_Placement)
+	if !ok || len(placement.([]interface{})) == 0 || placement.([]interface{})[0] == nil {
+		return nil
+	}
+	prefix := Arg_Placement + ".0."
+	if !diff.NewValueKnown(prefix + Attr_Policy) {
+		return nil
+	}
+	if r, ok := diff.GetOk(Arg_Replicants); ok && r.(int) > 1 && isSetPlacementGroup(diff, prefix+Attr_PlacementGroupID) {
+		return fmt.Errorf("%s.%s cannot be used with %s, the instances of a placement group must be provisioned one at a time", Arg_Placement, Attr_PlacementGroupID, Arg_Replicants)
+	}
+
+	// Targets that are not known yet, for example the ID of an instance created in the same plan, count as set
+	isSet := func(key string) bool {
+		if !diff.NewValueKnown(prefix + key) {
+			return true
+		}
+		switch value := diff.Get(prefix + key).(type) {
+		case string:
+			return value != ""
+		case []interface{}:
+			return len(value) > 0
+		}
+		return false
+	}
+	return validatePIInstancePlacement(diff.Get(prefix+Attr_Policy).(string), isSet(Attr_Instance), isSet(Attr_Volume), isSet(Attr_Instances), isSet(Attr_Volumes))
+}
+
+func isSetPlacementGroup(diff *schema.ResourceDiff, key string) bool {
+	return !diff.NewValueKnown(key) || diff.Get(key).(string) != ""
+}
+
+// validatePIInstancePlacement checks that affinity uses exactly one of instance or volume,
+// anti-affinity exactly one of instances or volumes and that no target is set without a policy.
+func validatePIInstancePlacement(policy string, instance, volume, instances, volumes bool) error {
+	if policy == "" {
+		if instance || volume || instances || volumes {
+			return fmt.Errorf("%s requires %s when %s, %s, %s or %s is set", Arg_Placement, Attr_Policy, Attr_Instance, Attr_Volume, Attr_Instances, Attr_Volumes)
+		}
+		return nil
+	}
+	if policy == Affinity {
+		if instances || volumes {
+			return fmt.Errorf("%s with policy %s does not accept %s or %s", Arg_Placement, Affinity, Attr_Instances, Attr_Volumes)
+		}
+		if instance == volume {
+			return fmt.Errorf("%s with policy %s requires exactly one of %s or %s", Arg_Placement, Affinity, Attr_Instance, Attr_Volume)
+		}
+		return nil
+	}
+	if instance || volume {
+		return fmt.Errorf("%s with policy %s does not accept %s or %s", Arg_Placement, AntiAffinity, Attr_Instance, Attr_Volume)
+	}
+	if instances == volumes {
+		return fmt.Errorf("%s with policy %s requires exactly one of %s or %s", Arg_Placement, AntiAffinity, Attr_Instances, Attr_Volumes)
+	}
+	return nil
+}
+
+// expandPIInstanceStorageAffinity builds the storage affinity from pi_placement or the scalar affinity arguments.
+func expandPIInstanceStorageAffinity(d *schema.ResourceData) *models.StorageAffinity {
+	if v, ok := d.GetOk(Arg_Placement); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		placement := v.([]interface{})[0].(map[string]interface{})
+		policy := placement[Attr_Policy].(string)
+		if policy == "" {
+			return nil
+		}
+		affinity := &models.StorageAffinity{
+			AffinityPolicy: &policy,
+		}
+		if policy == Affinity {
+			if volume := placement[Attr_Volume].(string); volume != "" {
+				affinity.AffinityVolume = &volume
+			}
+			if instance := placement[Attr_Instance].(string); instance != "" {
+				affinity.AffinityPVMInstance = &instance
+			}
+		} else {
+			if volumes := placement[Attr_Volumes].([]interface{}); len(volumes) > 0 {
+				affinity.AntiAffinityVolumes = flex.ExpandStringList(volumes)
+			}
+			if instances := placement[Attr_Instances].([]interface{}); len(instances) > 0 {
+				affinity.AntiAffinityPVMInstances = flex.ExpandStringList(instances)
+			}
+		}
+		return affinity
+	}
+
+	ap, ok := d.GetOk(Arg_AffinityPolicy)
+	if !ok {
+		return nil
+	}
+	policy := ap.(string)
+	affinity := &models.StorageAffinity{
+		AffinityPolicy: &policy,
+	}
+
+	if policy == Affinity {
+		if av, ok := d.GetOk(Arg_AffinityVolume); ok {
+			afvol := av.(string)
+			affinity.AffinityVolume = &afvol
+		}
+		if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
+			afins := ai.(string)
+			affinity.AffinityPVMInstance = &afins
+		}
+	} else {
+		if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
+			afvols := flex.ExpandStringList(avs.([]interface{}))
+			affinity.AntiAffinityVolumes = afvols
+		}
+		if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
+			afinss := flex.ExpandStringList(ais.([]interface{}))
+			affinity.AntiAffinityPVMInstances = afinss
+		}
+	}
+	return affinity
+}
+
+// getPIInstancePlacementGroupID returns the placement group from pi_placement or pi_placement_group_id.
+func getPIInstancePlacementGroupID(d *schema.ResourceData) string {
+	if v, ok := d.GetOk(Arg_Placement); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})[Attr_PlacementGroupID].(string)
+	}
+	return d.Get(Arg_PlacementGroupID).(string)
+}
+
+// getPIInstancePlacementGroupChange returns the old and new placement group, the placement group
+// can move between pi_placement_group_id and pi_placement.
+func getPIInstancePlacementGroupChange(d *schema.ResourceData) (string, string) {
+	placementGroupID := func(placement, placementGroup interface{}) string {
+		if p := placement.([]interface{}); len(p) > 0 && p[0] != nil {
+			return p[0].(map[string]interface{})[Attr_PlacementGroupID].(string)
+		}
+		return placementGroup.(string)
+	}
+	oldPlacement, newPlacement := d.GetChange(Arg_Placement)
+	oldPG, newPG := d.GetChange(Arg_PlacementGroupID)
+	return placementGroupID(oldPlacement, oldPG), placementGroupID(newPlacement, newPG)
+}
+
 func expandPVMNetworks(networks []interface{}) []*models.PVMInstanceAddNetwork {
 	pvmNetworks := make([]*models.PVMInstanceAddNetwork, 0, len(networks))
 	for _, v := range networks {
@@ -1699,36 +1984,10 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 		body.StoragePool = sp.(string)
 	}
 
-	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
-		policy := ap.(string)
-		affinity := &models.StorageAffinity{
-			AffinityPolicy: &policy,
-		}
+	body.StorageAffinity = expandPIInstanceStorageAffinity(d)
 
-		if policy == Affinity {
-			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
-				afvol := av.(string)
-				affinity.AffinityVolume = &afvol
-			}
-			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
-				afins := ai.(string)
-				affinity.AffinityPVMInstance = &afins
-			}
-		} else {
-			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
-				afvols := flex.ExpandStringList(avs.([]interface{}))
-				affinity.AntiAffinityVolumes = afvols
-			}
-			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
-				afinss := flex.ExpandStringList(ais.([]interface{}))
-				affinity.AntiAffinityPVMInstances = afinss
-			}
-		}
-		body.StorageAffinity = affinity
-	}
-
-	if pg, ok := d.GetOk(Arg_PlacementGroupID); ok {
-		body.PlacementGroup = pg.(string)
+	if pg := getPIInstancePlacementGroupID(d); pg != "" {
+		body.PlacementGroup = pg
 	}
 	if deploymentTarget, ok := d.GetOk(Arg_DeploymentTarget); ok {
 		body.DeploymentTarget = expandDeploymentTarget(deploymentTarget.(*schema.Set).List())
@@ -1847,40 +2106,14 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 		body.DeploymentType = dt.(string)
 	}
 
-	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
-		policy := ap.(string)
-		affinity := &models.StorageAffinity{
-			AffinityPolicy: &policy,
-		}
-
-		if policy == Affinity {
-			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
-				afvol := av.(string)
-				affinity.AffinityVolume = &afvol
-			}
-			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
-				afins := ai.(string)
-				affinity.AffinityPVMInstance = &afins
-			}
-		} else {
-			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
-				afvols := flex.ExpandStringList(avs.([]interface{}))
-				affinity.AntiAffinityVolumes = afvols
-			}
-			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
-				afinss := flex.ExpandStringList(ais.([]interface{}))
-				affinity.AntiAffinityPVMInstances = afinss
-			}
-		}
-		body.StorageAffinity = affinity
-	}
+	body.StorageAffinity = expandPIInstanceStorageAffinity(d)
 
 	if sc, ok := d.GetOk(Arg_StorageConnection); ok {
 		body.StorageConnection = sc.(string)
 	}
 
-	if pg, ok := d.GetOk(Arg_PlacementGroupID); ok {
-		body.PlacementGroup = pg.(string)
+	if pg := getPIInstancePlacementGroupID(d); pg != "" {
+		body.PlacementGroup = pg
 	}
 
 	if spp, ok := d.GetOk(Arg_SharedProcessorPool); ok {
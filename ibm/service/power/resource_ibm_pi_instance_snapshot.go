@@ -159,17 +159,14 @@ func resourceIBMPIInstanceSnapshotCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if snapshotResponse.Crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(snapshotResponse.Crn))...)
 		}
 	}
 
-	return resourceIBMPIInstanceSnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceSnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -191,13 +188,10 @@ func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceDa
 
 	d.Set(Arg_SnapshotName, snapshotdata.Name)
 	d.Set(Attr_CreationDate, snapshotdata.CreationDate.String())
+	var diags diag.Diagnostics
 	if snapshotdata.Crn != "" {
 		d.Set(Attr_CRN, snapshotdata.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(snapshotdata.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi snapshot (%s) pi_user_tags: %s", *snapshotdata.SnapshotID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(snapshotdata.Crn))...)
 	}
 	d.Set(Attr_LastUpdateDate, snapshotdata.LastUpdateDate.String())
 	d.Set(Attr_SnapshotID, *snapshotdata.SnapshotID)
@@ -205,7 +199,7 @@ func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceDa
 	d.Set(Attr_StatusDetail, snapshotdata.StatusDetail)
 	d.Set(Attr_VolumeSnapshots, snapshotdata.VolumeSnapshots)
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -237,17 +231,14 @@ func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPIInstanceSnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceSnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -690,12 +691,183 @@ func TestAccIBMPIInstanceUpdateStoppedState(t *testing.T) {
 					testAccCheckIBMPIInstanceStatus(instanceRes, strings.ToUpper(power.State_Shutoff)),
 					testAccCheckIBMPIInstanceExists(instanceRes),
 					resource.TestCheckResourceAttr(instanceRes, "pi_instance_name", name),
+					resource.TestCheckResourceAttr(instanceRes, "resize_method", power.Offline),
 				),
 			},
 		},
 	})
 }
 
+func TestAccIBMPIInstanceResizePolicy(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceResizePolicyConfig(name, "0.25", "2", power.AlwaysStop),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_resize_policy", power.AlwaysStop),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceResizePolicyConfig(name, "0.5", "4", power.AlwaysStop),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceStatus(instanceRes, strings.ToUpper(power.State_Active)),
+					resource.TestCheckResourceAttr(instanceRes, "resize_method", power.StopStart),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "4"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIInstanceResizePolicyConfig(name, "0.5", "64", power.NeverStop),
+				ExpectError: regexp.MustCompile("outside of the memory range"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceResizePolicyConfig(name, proc, memory, resizePolicy string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_health_status     = "OK"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_instance_name     = "%[2]s"
+		pi_memory            = "%[6]s"
+		pi_proc_type         = "shared"
+		pi_processors        = "%[5]s"
+		pi_resize_policy     = "%[7]s"
+		pi_storage_pool      = data.ibm_pi_image.power_image.storage_pool
+		pi_sys_type          = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, proc, memory, resizePolicy)
+}
+
+func TestAccIBMPIInstancePlacement(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIInstancePlacementConfig(name, "affinity", "volumes = [ibm_pi_volume.power_volume.volume_id]"),
+				ExpectError: regexp.MustCompile("does not accept instances or volumes"),
+			},
+			{
+				Config: testAccCheckIBMPIInstancePlacementConfig(name, "anti-affinity", "volumes = [ibm_pi_volume.power_volume.volume_id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_placement.0.policy", "anti-affinity"),
+					resource.TestCheckResourceAttr(instanceRes, "pi_placement.0.volumes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstancePlacementConfig(name, policy, target string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_volume" "power_volume" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_volume_name       = "%[2]s-volume"
+		pi_volume_size       = 1
+		pi_volume_type       = "tier3"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_instance_name     = "%[2]s"
+		pi_memory            = "2"
+		pi_proc_type         = "shared"
+		pi_processors        = "0.25"
+		pi_sys_type          = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+		pi_placement {
+			policy = "%[5]s"
+			%[6]s
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, policy, target)
+}
+
+func TestAccIBMPIInstancePinPolicy(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstancePinPolicyConfig(name, power.None),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pin_policy", power.None),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstancePinPolicyConfig(name, power.Soft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pin_policy", power.Soft),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstancePinPolicyConfig(name, pinPolicy string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_instance_name     = "%[2]s"
+		pi_memory            = "2"
+		pi_pin_policy        = "%[5]s"
+		pi_proc_type         = "shared"
+		pi_processors        = "0.25"
+		pi_sys_type          = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, pinPolicy)
+}
+
 func testAccCheckIBMPIActiveInstanceConfigUpdate(name, instanceHealthStatus, proc, memory string) string {
 	return fmt.Sprintf(`
 	data "ibm_pi_image" "power_image" {
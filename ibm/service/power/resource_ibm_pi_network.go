@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_networks"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -45,6 +46,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPINetworkIPRangeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +78,14 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_DhcpEnabled: {
+				ConflictsWith: []string{Arg_IPAddressRange},
+				Default:       false,
+				Description:   "Let a DHCP server assign the addresses of the network instead of fixed ip address ranges. Only supported for `vlan` network type in satellite locations.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeBool,
+			},
 			Arg_DNS: {
 				Computed:    true,
 				Description: "The DNS Servers for the network.",
@@ -83,6 +93,13 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeSet,
 			},
+			Arg_FirstUsableOffset: {
+				ConflictsWith: []string{Arg_DhcpEnabled, Arg_IPAddressRange},
+				Description:   "The host index of the first usable ip address of the generated ip address range. Defaults to 4, which reserves the first addresses of the CIDR for the data center. Only used for `vlan` network type when pi_ipaddress_range is not set.",
+				Optional:      true,
+				Type:          schema.TypeInt,
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
 			Arg_Gateway: {
 				Computed:    true,
 				Description: "The gateway ip address.",
@@ -111,6 +128,13 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Optional: true,
 				Type:     schema.TypeList,
 			},
+			Arg_IPRangeManagement: {
+				Default:      Strict,
+				Description:  "How ip address ranges that differ from pi_ipaddress_range are handled: strict replaces them with the configured ranges, append keeps ranges added outside of Terraform next to the configured ones and ignore leaves the ranges of the network unchanged.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Append, Ignore, Strict}),
+			},
 			Arg_NetworkMTU: {
 				Computed:    true,
 				Description: "Maximum Transmission Unit option of the network. Minimum is 1450 and maximum is 9000.",
@@ -186,6 +210,51 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Description: "The CRN of this resource.",
 				Type:        schema.TypeString,
 			},
+			Attr_DhcpManaged: {
+				Computed:    true,
+				Description: "Indicates if the addresses of the network are assigned by a DHCP server.",
+				Type:        schema.TypeBool,
+			},
+			Attr_DhcpPoolEnd: {
+				Computed:    true,
+				Description: "The last address of the address pool of the DHCP server of the network.",
+				Type:        schema.TypeString,
+			},
+			Attr_DhcpPoolStart: {
+				Computed:    true,
+				Description: "The first address of the address pool of the DHCP server of the network.",
+				Type:        schema.TypeString,
+			},
+			Attr_DhcpServerLeases: {
+				Computed:    true,
+				Description: "The leases of the DHCP servers of the network.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_DhcpID: {
+							Computed:    true,
+							Description: "ID of the DHCP Server.",
+							Type:        schema.TypeString,
+						},
+						Attr_DhcpLeaseInstanceIP: {
+							Computed:    true,
+							Description: "IP of the PVM Instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_DhcpLeaseInstanceMac: {
+							Computed:    true,
+							Description: "MAC Address of the PVM Instance.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_ManagedIPAddressRanges: {
+				Computed:    true,
+				Description: "The ip address ranges of the network that were added by Terraform.",
+				Elem:        ipAddressRangeAttributeSchema(),
+				Type:        schema.TypeList,
+			},
 			Attr_NetworkAddressTranslation: {
 				Computed:    true,
 				Deprecated:  "This field is deprecated",
@@ -213,6 +282,12 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Description: "Network Peer ID (for on-prem locations only).",
 				Type:        schema.TypeString,
 			},
+			Attr_UnmanagedIPAddressRanges: {
+				Computed:    true,
+				Description: "The ip address ranges of the network that were not added by Terraform.",
+				Elem:        ipAddressRangeAttributeSchema(),
+				Type:        schema.TypeList,
+			},
 			Attr_VLanID: {
 				Computed:    true,
 				Description: "The ID of the VLAN that your network is attached to.",
@@ -222,6 +297,23 @@ func ResourceIBMPINetwork() *schema.Resource {
 	}
 }
 
+func ipAddressRangeAttributeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			Attr_EndingIPAddress: {
+				Computed:    true,
+				Description: "The ending ip address.",
+				Type:        schema.TypeString,
+			},
+			Attr_StartingIPAddress: {
+				Computed:    true,
+				Description: "The starting ip address.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -230,12 +322,19 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	networkname := d.Get(Arg_NetworkName).(string)
 	networktype := d.Get(Arg_NetworkType).(string)
+	dhcpEnabled := d.Get(Arg_DhcpEnabled).(bool)
+	if dhcpEnabled && networktype != Vlan {
+		return diag.Errorf("%s can only be set when %s is vlan", Arg_DhcpEnabled, Arg_NetworkType)
+	}
 
 	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 	var body = &models.NetworkCreate{
 		Type: &networktype,
 		Name: networkname,
 	}
+	if dhcpEnabled {
+		body.Type = flex.PtrToString(DhcpVlan)
+	}
 	if v, ok := d.GetOk(Arg_DNS); ok {
 		networkdns := flex.ExpandStringList((v.(*schema.Set)).List())
 		if len(networkdns) > 0 {
@@ -263,7 +362,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 			return diag.Errorf("%s is required when %s is vlan", Arg_Cidr, Arg_NetworkType)
 		}
 
-		gateway, firstip, lastip, err := generateIPData(networkcidr)
+		gateway, firstip, lastip, err := generateIPData(networkcidr, getFirstUsableOffset(d))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -278,7 +377,11 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 			ipBodyRanges = getIPAddressRanges(ips.([]interface{}))
 		}
 
-		body.IPAddressRanges = ipBodyRanges
+		// The DHCP server manages the address pool of the network
+		if !dhcpEnabled {
+			body.IPAddressRanges = ipBodyRanges
+			d.Set(Attr_ManagedIPAddressRanges, flattenIPAddressRanges(ipBodyRanges))
+		}
 		body.Gateway = gateway
 		body.Cidr = networkcidr
 	}
@@ -324,17 +427,14 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkResponse.Crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(networkResponse.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(networkResponse.Crn))...)
 		}
 	}
 
-	return resourceIBMPINetworkRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -353,13 +453,10 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if networkdata.Crn != "" {
 		d.Set(Attr_CRN, networkdata.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(networkdata.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi network (%s) pi_user_tags: %s", *networkdata.NetworkID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(networkdata.Crn))...)
 	}
 
 	if !sess.IsOnPrem() {
@@ -374,7 +471,7 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 				return diag.FromErr(err)
 			}
 
-			if *networkdata.Type == Vlan {
+			if *networkdata.Type == Vlan || *networkdata.Type == DhcpVlan {
 				d.Set(Arg_Advertise, networkdata.Advertise)
 				d.Set(Arg_ARPBroadcast, networkdata.ArpBroadcast)
 			}
@@ -386,7 +483,14 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(Arg_Gateway, networkdata.Gateway)
 	d.Set(Arg_NetworkMTU, networkdata.Mtu)
 	d.Set(Arg_NetworkName, networkdata.Name)
-	d.Set(Arg_NetworkType, networkdata.Type)
+	dhcpEnabled := d.Get(Arg_DhcpEnabled).(bool) || *networkdata.Type == DhcpVlan
+	if *networkdata.Type == DhcpVlan {
+		d.Set(Arg_DhcpEnabled, true)
+		d.Set(Arg_NetworkType, Vlan)
+	} else {
+		d.Set(Arg_DhcpEnabled, false)
+		d.Set(Arg_NetworkType, networkdata.Type)
+	}
 	d.Set(Attr_NetworkID, networkdata.NetworkID)
 	networkAddressTranslation := []map[string]interface{}{}
 	if networkdata.NetworkAddressTranslation != nil {
@@ -410,7 +514,77 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set(Arg_IPAddressRange, ipRangesMap)
 
-	return nil
+	// The address pool of the DHCP server is the ip address range of a dhcp-vlan network
+	dhcpPoolStart, dhcpPoolEnd := "", ""
+	if *networkdata.Type == DhcpVlan && len(networkdata.IPAddressRanges) > 0 && networkdata.IPAddressRanges[0] != nil {
+		dhcpPoolStart = flex.StringValue(networkdata.IPAddressRanges[0].StartingIPAddress)
+		dhcpPoolEnd = flex.StringValue(networkdata.IPAddressRanges[0].EndingIPAddress)
+	}
+	d.Set(Attr_DhcpPoolEnd, dhcpPoolEnd)
+	d.Set(Attr_DhcpPoolStart, dhcpPoolStart)
+
+	// Ranges that are on the network but were not added by Terraform are reported as drift
+	managed := ipAddressRangeKeys(d.Get(Attr_ManagedIPAddressRanges).([]interface{}), Attr_StartingIPAddress, Attr_EndingIPAddress)
+	unmanagedRanges := []*models.IPAddressRange{}
+	for _, n := range networkdata.IPAddressRanges {
+		if n != nil && !managed[ipAddressRangeKey(*n.StartingIPAddress, *n.EndingIPAddress)] {
+			unmanagedRanges = append(unmanagedRanges, n)
+		}
+	}
+	d.Set(Attr_UnmanagedIPAddressRanges, flattenIPAddressRanges(unmanagedRanges))
+
+	// Only the DHCP networks have DHCP servers, and DHCP servers are not available in every workspace,
+	// a failed lookup leaves the leases unset
+	if !dhcpEnabled {
+		d.Set(Attr_DhcpManaged, false)
+		d.Set(Attr_DhcpServerLeases, []map[string]interface{}{})
+		return diags
+	}
+	dhcpLeases, err := getNetworkDhcpLeases(ctx, sess, cloudInstanceID, networkID)
+	d.Set(Attr_DhcpManaged, *networkdata.Type == DhcpVlan)
+	if err != nil {
+		log.Printf("[WARN] Error getting the DHCP leases of network %s: %s", networkID, err)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to get the DHCP leases of network %s", networkID),
+			Detail:   err.Error(),
+		})
+	} else {
+		d.Set(Attr_DhcpServerLeases, dhcpLeases)
+	}
+
+	return diags
+}
+
+// getNetworkDhcpLeases returns the leases of the DHCP servers of the network
+func getNetworkDhcpLeases(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, networkID string) ([]map[string]interface{}, error) {
+	client := instance.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID)
+	dhcpServers, err := client.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	leases := []map[string]interface{}{}
+	for _, dhcpServer := range dhcpServers {
+		if dhcpServer.ID == nil || dhcpServer.Network == nil || dhcpServer.Network.ID == nil || *dhcpServer.Network.ID != networkID {
+			continue
+		}
+		dhcpServerDetail, err := client.Get(*dhcpServer.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, lease := range dhcpServerDetail.Leases {
+			if lease == nil {
+				continue
+			}
+			leases = append(leases, map[string]interface{}{
+				Attr_DhcpID:               *dhcpServer.ID,
+				Attr_DhcpLeaseInstanceIP:  flex.StringValue(lease.InstanceIP),
+				Attr_DhcpLeaseInstanceMac: flex.StringValue(lease.InstanceMacAddress),
+			})
+		}
+	}
+	return leases, nil
 }
 
 func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -424,7 +598,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges(Arg_Advertise, Arg_ARPBroadcast, Arg_DNS, Arg_Gateway, Arg_IPAddressRange, Arg_NetworkName) {
+	if d.HasChanges(Arg_Advertise, Arg_ARPBroadcast, Arg_DNS, Arg_FirstUsableOffset, Arg_Gateway, Arg_IPAddressRange, Arg_NetworkName) {
 		client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 		body := &models.NetworkUpdate{}
 
@@ -441,10 +615,17 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		networkType := d.Get(Arg_NetworkType).(string)
-		if d.HasChange(Arg_IPAddressRange) || d.HasChange(Arg_Gateway) {
+		if d.HasChange(Arg_IPAddressRange) || d.HasChange(Arg_Gateway) || d.HasChange(Arg_FirstUsableOffset) {
 			if networkType == Vlan {
 				if d.HasChange(Arg_IPAddressRange) {
 					body.IPAddressRanges = getIPAddressRanges(d.Get(Arg_IPAddressRange).([]interface{}))
+				} else if d.HasChange(Arg_FirstUsableOffset) {
+					_, firstip, lastip, err := generateIPData(d.Get(Arg_Cidr).(string), getFirstUsableOffset(d))
+					if err != nil {
+						return diag.FromErr(err)
+					}
+					body.IPAddressRanges = []*models.IPAddressRange{{EndingIPAddress: &lastip, StartingIPAddress: &firstip}}
+					d.Set(Attr_ManagedIPAddressRanges, flattenIPAddressRanges(body.IPAddressRanges))
 				}
 				if d.HasChange(Arg_Gateway) {
 					body.Gateway = flex.PtrToString(d.Get(Arg_Gateway).(string))
@@ -469,17 +650,14 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network (%s) pi_user_tags: %s", networkID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPINetworkRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -652,7 +830,135 @@ func isIBMPINetworkRefreshUpdateFunc(client *instance.IBMPINetworkClient, update
 	}
 }
 
-func generateIPData(cdir string) (gway, firstip, lastip string, err error) {
+// resourceIBMPINetworkIPRangeCustomizeDiff applies pi_ip_range_management to a planned change of the ip address ranges
+// and keeps track of the ranges that are added by Terraform.
+func resourceIBMPINetworkIPRangeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown(Arg_IPAddressRange) {
+		return nil
+	}
+	if rawRanges := diff.GetRawConfig().GetAttr(Arg_IPAddressRange); rawRanges.IsNull() || rawRanges.LengthInt() == 0 {
+		// the ranges are generated from the cidr, they are tracked by the create and update of the network
+		return nil
+	}
+	oldRanges, newRanges := diff.GetChange(Arg_IPAddressRange)
+	configured := newRanges
+	mode := diff.Get(Arg_IPRangeManagement).(string)
+	if mode == Ignore {
+		if diff.HasChange(Arg_IPAddressRange) {
+			log.Printf("[WARN] %s differs from the ip address ranges of network %s, not applied because %s is %s", Arg_IPAddressRange, diff.Id(), Arg_IPRangeManagement, Ignore)
+			return diff.Clear(Arg_IPAddressRange)
+		}
+		return nil
+	}
+
+	oldManaged, _ := diff.GetChange(Attr_ManagedIPAddressRanges)
+	managed := ipAddressRangeKeys(oldManaged.([]interface{}), Attr_StartingIPAddress, Attr_EndingIPAddress)
+	wanted := ipAddressRangeKeys(configured.([]interface{}), Arg_StartingIPAddress, Arg_EndingIPAddress)
+
+	ranges := newRanges.([]interface{})
+	if mode == Append {
+		// Keep the ranges of the network that were not added by Terraform, drop the ones that left the
+		// configuration and add the configured ranges that are missing
+		ranges = []interface{}{}
+		seen := map[string]bool{}
+		for _, r := range append(append([]interface{}{}, oldRanges.([]interface{})...), newRanges.([]interface{})...) {
+			if r == nil {
+				continue
+			}
+			ipRange := r.(map[string]interface{})
+			key := ipAddressRangeKey(ipRange[Arg_StartingIPAddress].(string), ipRange[Arg_EndingIPAddress].(string))
+			if seen[key] || (managed[key] && !wanted[key]) {
+				continue
+			}
+			seen[key] = true
+			if !wanted[key] {
+				log.Printf("[WARN] ip address range %s of network %s was not added by Terraform, kept because %s is %s", key, diff.Id(), Arg_IPRangeManagement, Append)
+			}
+			ranges = append(ranges, ipRange)
+		}
+		if ipAddressRangesEqual(ranges, oldRanges.([]interface{})) {
+			if err := diff.Clear(Arg_IPAddressRange); err != nil {
+				return err
+			}
+		} else if err := diff.SetNew(Arg_IPAddressRange, ranges); err != nil {
+			return err
+		}
+	}
+
+	if ipAddressRangesEqual(ranges, oldRanges.([]interface{})) && ipAddressRangeKeysEqual(managed, wanted) {
+		return nil
+	}
+	managedRanges := []interface{}{}
+	for _, r := range configured.([]interface{}) {
+		if r != nil {
+			ipRange := r.(map[string]interface{})
+			managedRanges = append(managedRanges, map[string]interface{}{
+				Attr_EndingIPAddress:   ipRange[Arg_EndingIPAddress],
+				Attr_StartingIPAddress: ipRange[Arg_StartingIPAddress],
+			})
+		}
+	}
+	if err := diff.SetNew(Attr_ManagedIPAddressRanges, managedRanges); err != nil {
+		return err
+	}
+	return diff.SetNewComputed(Attr_UnmanagedIPAddressRanges)
+}
+
+func ipAddressRangeKey(start, end string) string {
+	return start + "-" + end
+}
+
+// ipAddressRangeKeys returns the set of the ranges of a list of ip address ranges with the given starting and ending keys.
+func ipAddressRangeKeys(ranges []interface{}, startKey, endKey string) map[string]bool {
+	keys := map[string]bool{}
+	for _, r := range ranges {
+		if r != nil {
+			ipRange := r.(map[string]interface{})
+			keys[ipAddressRangeKey(ipRange[startKey].(string), ipRange[endKey].(string))] = true
+		}
+	}
+	return keys
+}
+
+func ipAddressRangesEqual(a, b []interface{}) bool {
+	return ipAddressRangeKeysEqual(ipAddressRangeKeys(a, Arg_StartingIPAddress, Arg_EndingIPAddress), ipAddressRangeKeys(b, Arg_StartingIPAddress, Arg_EndingIPAddress))
+}
+
+func ipAddressRangeKeysEqual(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key := range a {
+		if !b[key] {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenIPAddressRanges(ranges []*models.IPAddressRange) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		if r != nil {
+			result = append(result, map[string]interface{}{
+				Attr_EndingIPAddress:   r.EndingIPAddress,
+				Attr_StartingIPAddress: r.StartingIPAddress,
+			})
+		}
+	}
+	return result
+}
+
+// getFirstUsableOffset returns the configured host index of the first usable ip address, or the
+// default of 4 when pi_first_usable_offset is not set.
+func getFirstUsableOffset(d *schema.ResourceData) int {
+	if v, ok := d.GetOk(Arg_FirstUsableOffset); ok {
+		return v.(int)
+	}
+	return 4
+}
+
+func generateIPData(cdir string, firstUsableOffset int) (gway, firstip, lastip string, err error) {
 	_, ipv4Net, err := net.ParseCIDR(cdir)
 
 	if err != nil {
@@ -681,8 +987,11 @@ func generateIPData(cdir string) (gway, firstip, lastip string, err error) {
 	ad := cidr.AddressCount(ipv4Net)
 
 	convertedad := strconv.FormatUint(ad, 10)
-	// Powervc in wdc04 has to reserve 3 ip address hence we start from the 4th. This will be the default behaviour
-	firstusable, err := cidr.Host(ipv4Net, 4)
+	// Powervc in wdc04 has to reserve 3 ip address hence the default is to start from the 4th
+	if uint64(firstUsableOffset)+2 > ad {
+		return "", "", "", fmt.Errorf("%s %d is outside of the usable addresses of cidr %s", Arg_FirstUsableOffset, firstUsableOffset, cdir)
+	}
+	firstusable, err := cidr.Host(ipv4Net, firstUsableOffset)
 	if err != nil {
 		log.Print(err)
 		return "", "", "", err
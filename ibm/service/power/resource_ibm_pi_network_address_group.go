@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -117,18 +116,15 @@ func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkAddressGroup.Crn != nil {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkAddressGroup.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*networkAddressGroup.Crn))...)
 		}
 	}
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *networkAddressGroup.ID))
 
-	return resourceIBMPINetworkAddressGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkAddressGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkAddressGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -151,13 +147,10 @@ func resourceIBMPINetworkAddressGroupRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 	d.Set(Arg_Name, networkAddressGroup.Name)
+	var diags diag.Diagnostics
 	if networkAddressGroup.Crn != nil {
 		d.Set(Attr_CRN, networkAddressGroup.Crn)
-		userTags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkAddressGroup.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of network address group (%s) pi_user_tags: %s", nagID, err)
-		}
-		d.Set(Arg_UserTags, userTags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*networkAddressGroup.Crn))...)
 	}
 
 	d.Set(Attr_NetworkAddressGroupID, networkAddressGroup.ID)
@@ -172,7 +165,7 @@ func resourceIBMPINetworkAddressGroupRead(ctx context.Context, d *schema.Resourc
 		d.Set(Attr_Members, nil)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -187,13 +180,10 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 	}
 	hasChange := false
 	body := &models.NetworkAddressGroupUpdate{}
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 	if d.HasChange(Arg_Name) {
@@ -208,7 +198,7 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	return resourceIBMPINetworkAddressGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkAddressGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkAddressGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
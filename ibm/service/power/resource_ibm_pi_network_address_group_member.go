@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"slices"
 	"strings"
 	"time"
@@ -158,13 +157,10 @@ func resourceIBMPINetworkAddressGroupMemberRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if networkAddressGroup.Crn != nil {
 		d.Set(Attr_CRN, networkAddressGroup.Crn)
-		userTags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkAddressGroup.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi network address group (%s) user_tags: %s", parts[1], err)
-		}
-		d.Set(Attr_UserTags, userTags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Attr_UserTags, string(*networkAddressGroup.Crn))...)
 	}
 	if len(networkAddressGroup.Members) > 0 {
 		members := []map[string]interface{}{}
@@ -178,7 +174,7 @@ func resourceIBMPINetworkAddressGroupMemberRead(ctx context.Context, d *schema.R
 	}
 	d.Set(Attr_Name, networkAddressGroup.Name)
 
-	return nil
+	return diags
 }
 func resourceIBMPINetworkAddressGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
@@ -179,13 +179,10 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	crn := networkInterface.Crn
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if crn != nil {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *crn, "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, *crn)...)
 		}
 	}
 	if v, ok := d.GetOk(Arg_InstanceID); ok {
@@ -204,7 +201,7 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, networkID, networkInterfaceID))
 
-	return resourceIBMPINetworkInterfaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkInterfaceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -219,9 +216,21 @@ func resourceIBMPINetworkInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	networkC := instance.NewIBMPINetworkClient(ctx, sess, parts[0])
 	networkInterface, err := networkC.GetNetworkInterface(parts[1], parts[2])
 	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[WARN] Removing network interface (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
+	d.Set(Arg_CloudInstanceID, parts[0])
+	d.Set(Arg_NetworkID, parts[1])
+	if networkInterface.Instance != nil {
+		d.Set(Arg_InstanceID, networkInterface.Instance.InstanceID)
+	} else {
+		d.Set(Arg_InstanceID, "")
+	}
 	d.Set(Attr_IPAddress, networkInterface.IPAddress)
 	d.Set(Attr_MacAddress, networkInterface.MacAddress)
 	d.Set(Attr_Name, networkInterface.Name)
@@ -237,16 +246,13 @@ func resourceIBMPINetworkInterfaceRead(ctx context.Context, d *schema.ResourceDa
 		d.Set(Attr_Instance, nil)
 	}
 	d.Set(Attr_Status, networkInterface.Status)
+	var diags diag.Diagnostics
 	if networkInterface.Crn != nil {
 		d.Set(Attr_CRN, networkInterface.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkInterface.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of network interface (%s) pi_user_tags: %s", *networkInterface.ID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*networkInterface.Crn))...)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -262,13 +268,10 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 	body := &models.NetworkInterfaceUpdate{}
 
 	hasChange := false
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", parts[2], err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 	if d.HasChange(Arg_Name) {
@@ -288,14 +291,18 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 			return diag.FromErr(err)
 		}
 		if d.HasChange(Arg_InstanceID) {
-			_, err = isWaitForIBMPINetworkPortUpdateAvailable(ctx, networkC, parts[1], parts[2], d.Get(Arg_InstanceID).(string), d.Timeout(schema.TimeoutUpdate))
+			if instanceID := d.Get(Arg_InstanceID).(string); instanceID != "" {
+				_, err = isWaitForIBMPINetworkPortUpdateAvailable(ctx, networkC, parts[1], parts[2], instanceID, d.Timeout(schema.TimeoutUpdate))
+			} else {
+				_, err = isWaitForIBMPINetworkInterfaceDetached(ctx, networkC, parts[1], parts[2], d.Timeout(schema.TimeoutUpdate))
+			}
 			if err != nil {
 				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceIBMPINetworkInterfaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkInterfaceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -308,10 +315,37 @@ func resourceIBMPINetworkInterfaceDelete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	networkC := instance.NewIBMPINetworkClient(ctx, sess, parts[0])
+
+	// Detach the interface first so that the instance is not left with a
+	// half removed adapter
+	networkInterface, err := networkC.GetNetworkInterface(parts[1], parts[2])
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if networkInterface.Instance != nil && networkInterface.Instance.InstanceID != "" {
+		detach := ""
+		_, err = networkC.UpdateNetworkInterface(parts[1], parts[2], &models.NetworkInterfaceUpdate{InstanceID: &detach})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPINetworkInterfaceDetached(ctx, networkC, parts[1], parts[2], d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = networkC.DeleteNetworkInterface(parts[1], parts[2])
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	_, err = isWaitForIBMPINetworkInterfaceDeleted(ctx, networkC, parts[1], parts[2], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("")
 
 	return nil
@@ -364,9 +398,61 @@ func isIBMPINetworkInterfaceUpdateRefreshFunc(client *instance.IBMPINetworkClien
 		if err != nil {
 			return nil, "", err
 		}
-		if strings.ToLower(*networkInterface.Status) == State_Active && networkInterface.Instance.InstanceID == instanceid {
+		if strings.ToLower(*networkInterface.Status) == State_Active && networkInterface.Instance != nil && networkInterface.Instance.InstanceID == instanceid {
 			return networkInterface, State_Active, nil
 		}
 		return networkInterface, State_Build, nil
 	}
 }
+
+func isWaitForIBMPINetworkInterfaceDetached(ctx context.Context, client *instance.IBMPINetworkClient, networkID, networkInterfaceID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Build},
+		Target:     []string{State_Down},
+		Refresh:    isIBMPINetworkInterfaceDetachRefreshFunc(client, networkID, networkInterfaceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPINetworkInterfaceDetachRefreshFunc(client *instance.IBMPINetworkClient, networkID, networkInterfaceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		networkInterface, err := client.GetNetworkInterface(networkID, networkInterfaceID)
+		if err != nil {
+			return nil, "", err
+		}
+		if strings.ToLower(*networkInterface.Status) == State_Down && (networkInterface.Instance == nil || networkInterface.Instance.InstanceID == "") {
+			return networkInterface, State_Down, nil
+		}
+		return networkInterface, State_Build, nil
+	}
+}
+
+func isWaitForIBMPINetworkInterfaceDeleted(ctx context.Context, client *instance.IBMPINetworkClient, networkID, networkInterfaceID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Found},
+		Target:     []string{State_NotFound},
+		Refresh:    isIBMPINetworkInterfaceDeleteRefreshFunc(client, networkID, networkInterfaceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPINetworkInterfaceDeleteRefreshFunc(client *instance.IBMPINetworkClient, networkID, networkInterfaceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		networkInterface, err := client.GetNetworkInterface(networkID, networkInterfaceID)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+				return "", State_NotFound, nil
+			}
+			return nil, "", err
+		}
+		return networkInterface, State_Found, nil
+	}
+}
@@ -73,6 +73,39 @@ func TestAccIBMPINetworkInterfaceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMPINetworkInterfaceAttachDetach(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-name-%d", acctest.RandIntRange(10, 100))
+	netInterRes := "ibm_pi_network_interface.network_interface"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkInterfaceConfigAttach(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMPINetworkInterfaceExists(netInterRes),
+					resource.TestCheckResourceAttrPair(netInterRes, power.Arg_InstanceID, "data.ibm_pi_instance.instance", "id"),
+					resource.TestCheckResourceAttr(netInterRes, "instance.#", "1"),
+				),
+			},
+			{
+				ResourceName:      netInterRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckIBMPINetworkInterfaceConfigAttach(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMPINetworkInterfaceExists(netInterRes),
+					resource.TestCheckResourceAttr(netInterRes, power.Arg_InstanceID, ""),
+					resource.TestCheckResourceAttr(netInterRes, "instance.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPINetworkInterfaceConfigBasic(name string) string {
 	return fmt.Sprintf(`
 		resource "ibm_pi_network_interface" "network_interface" {
@@ -92,6 +125,24 @@ func testAccCheckIBMPINetworkInterfaceConfig(name, userTags string) string {
 		}`, acc.Pi_cloud_instance_id, acc.Pi_network_id, name, userTags)
 }
 
+func testAccCheckIBMPINetworkInterfaceConfigAttach(name string, attach bool) string {
+	instanceID := `""`
+	if attach {
+		instanceID = "data.ibm_pi_instance.instance.id"
+	}
+	return fmt.Sprintf(`
+		data "ibm_pi_instance" "instance" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_name = "%[2]s"
+		}
+		resource "ibm_pi_network_interface" "network_interface" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_network_id = "%[3]s"
+			pi_name = "%[4]s"
+			pi_instance_id = %[5]s
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, acc.Pi_network_id, name, instanceID)
+}
+
 func testAccCheckIBMPINetworkInterfaceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
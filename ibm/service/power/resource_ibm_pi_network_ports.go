@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPINetworkPorts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPINetworkPortsCreate,
+		ReadContext:   resourceIBMPINetworkPortsRead,
+		UpdateContext: resourceIBMPINetworkPortsUpdate,
+		DeleteContext: resourceIBMPINetworkPortsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_NetworkID: {
+				Description:  "The network ID.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_NetworkPort: {
+				Description: "The ports reserved on the network. Ports are added, updated and removed individually when the set changes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Description: {
+							Default:     "Port Created via Terraform",
+							Description: "The description of the port.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_IPAddress: {
+							Description:  "The requested ip address of the port. It identifies the port in the set.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+					},
+				},
+				MinItems: 1,
+				Required: true,
+				Type:     schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_NetworkPorts: {
+				Computed:    true,
+				Description: "The ports reserved on the network.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Description: {
+							Computed:    true,
+							Description: "The description of the port.",
+							Type:        schema.TypeString,
+						},
+						Attr_IPAddress: {
+							Computed:    true,
+							Description: "The ip address of the port.",
+							Type:        schema.TypeString,
+						},
+						Attr_MacAddress: {
+							Computed:    true,
+							Description: "The MAC address of the port.",
+							Type:        schema.TypeString,
+						},
+						Attr_NetworkPortID: {
+							Computed:    true,
+							Description: "The ID of the port.",
+							Type:        schema.TypeString,
+						},
+						Attr_PublicIP: {
+							Computed:    true,
+							Description: "The external ip address of the port, for ports on a public network. It is assigned by the Power API and cannot be requested.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the port.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPINetworkPortsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	networkID := d.Get(Arg_NetworkID).(string)
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, networkID))
+
+	ports := d.Get(Arg_NetworkPort).(*schema.Set).List()
+	if err := createIBMPINetworkPorts(ctx, client, networkID, ports, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPINetworkPortsRead(ctx, d, meta)
+}
+
+func resourceIBMPINetworkPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID, networkID := parts[0], parts[1]
+
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	allPorts, err := client.GetAllPorts(networkID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[WARN] Removing network ports (%s) from state because the network is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Only the ports of the configured ip addresses are managed, all ports of the network on import
+	managedIPs := map[string]bool{}
+	for _, v := range d.Get(Arg_NetworkPort).(*schema.Set).List() {
+		managedIPs[v.(map[string]interface{})[Attr_IPAddress].(string)] = true
+	}
+	networkPorts := []map[string]interface{}{}
+	portList := []interface{}{}
+	for _, port := range allPorts.Ports {
+		if port == nil || port.IPAddress == nil {
+			continue
+		}
+		if len(managedIPs) > 0 && !managedIPs[*port.IPAddress] {
+			continue
+		}
+		portList = append(portList, map[string]interface{}{
+			Attr_Description: flex.StringValue(port.Description),
+			Attr_IPAddress:   *port.IPAddress,
+		})
+		networkPorts = append(networkPorts, map[string]interface{}{
+			Attr_Description:   flex.StringValue(port.Description),
+			Attr_IPAddress:     *port.IPAddress,
+			Attr_MacAddress:    flex.StringValue(port.MacAddress),
+			Attr_NetworkPortID: flex.StringValue(port.PortID),
+			Attr_PublicIP:      port.ExternalIP,
+			Attr_Status:        flex.StringValue(port.Status),
+		})
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_NetworkID, networkID)
+	d.Set(Arg_NetworkPort, portList)
+	d.Set(Attr_NetworkPorts, networkPorts)
+
+	return nil
+}
+
+func resourceIBMPINetworkPortsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange(Arg_NetworkPort) {
+		return resourceIBMPINetworkPortsRead(ctx, d, meta)
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID, networkID := parts[0], parts[1]
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+	oldPorts := map[string]map[string]interface{}{}
+	newPorts := map[string]map[string]interface{}{}
+	o, n := d.GetChange(Arg_NetworkPort)
+	for _, v := range o.(*schema.Set).List() {
+		port := v.(map[string]interface{})
+		oldPorts[port[Attr_IPAddress].(string)] = port
+	}
+	for _, v := range n.(*schema.Set).List() {
+		port := v.(map[string]interface{})
+		newPorts[port[Attr_IPAddress].(string)] = port
+	}
+
+	portIDs, err := getIBMPINetworkPortIDs(client, networkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Remove the ports first so that their ip addresses can be reused by the added ports
+	for ip := range oldPorts {
+		if _, ok := newPorts[ip]; ok {
+			continue
+		}
+		if portID, ok := portIDs[ip]; ok {
+			if err := client.DeletePort(networkID, portID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	toCreate := []interface{}{}
+	for ip, port := range newPorts {
+		oldPort, ok := oldPorts[ip]
+		if !ok {
+			toCreate = append(toCreate, port)
+			continue
+		}
+		if oldPort[Attr_Description] != port[Attr_Description] {
+			description := port[Attr_Description].(string)
+			if _, err := client.UpdatePort(networkID, portIDs[ip], &models.NetworkPortUpdate{Description: &description}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if err := createIBMPINetworkPorts(ctx, client, networkID, toCreate, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPINetworkPortsRead(ctx, d, meta)
+}
+
+func resourceIBMPINetworkPortsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID, networkID := parts[0], parts[1]
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+	portIDs, err := getIBMPINetworkPortIDs(client, networkID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	for _, v := range d.Get(Arg_NetworkPort).(*schema.Set).List() {
+		portID, ok := portIDs[v.(map[string]interface{})[Attr_IPAddress].(string)]
+		if !ok {
+			continue
+		}
+		if err := client.DeletePort(networkID, portID); err != nil && !strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createIBMPINetworkPorts creates the given ports and waits until all of them are available.
+func createIBMPINetworkPorts(ctx context.Context, client *instance.IBMPINetworkClient, networkID string, ports []interface{}, timeout time.Duration) error {
+	if len(ports) == 0 {
+		return nil
+	}
+	pending := map[string]bool{}
+	for _, v := range ports {
+		port := v.(map[string]interface{})
+		body := &models.NetworkPortCreate{
+			Description: port[Attr_Description].(string),
+			IPAddress:   port[Attr_IPAddress].(string),
+		}
+		networkPort, err := client.CreatePort(networkID, body)
+		if err != nil {
+			return fmt.Errorf("failed to create port with ip address %s: %w", body.IPAddress, err)
+		}
+		pending[*networkPort.PortID] = true
+	}
+
+	_, err := isWaitForIBMPINetworkPortsAvailable(ctx, client, networkID, pending, timeout)
+	return err
+}
+
+// getIBMPINetworkPortIDs returns the port IDs of a network by ip address.
+func getIBMPINetworkPortIDs(client *instance.IBMPINetworkClient, networkID string) (map[string]string, error) {
+	allPorts, err := client.GetAllPorts(networkID)
+	if err != nil {
+		return nil, err
+	}
+	portIDs := map[string]string{}
+	for _, port := range allPorts.Ports {
+		if port != nil && port.IPAddress != nil && port.PortID != nil {
+			portIDs[*port.IPAddress] = *port.PortID
+		}
+	}
+	return portIDs, nil
+}
+
+func isWaitForIBMPINetworkPortsAvailable(ctx context.Context, client *instance.IBMPINetworkClient, networkID string, portIDs map[string]bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for %d ports of network (%s) to be available.", len(portIDs), networkID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Build},
+		Target:     []string{State_Available},
+		Refresh:    isIBMPINetworkPortsRefreshFunc(client, networkID, portIDs),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPINetworkPortsRefreshFunc(client *instance.IBMPINetworkClient, networkID string, portIDs map[string]bool) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allPorts, err := client.GetAllPorts(networkID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		available := 0
+		for _, port := range allPorts.Ports {
+			if port == nil || port.PortID == nil || !portIDs[*port.PortID] || port.Status == nil {
+				continue
+			}
+			status := strings.ToLower(*port.Status)
+			if status == State_Down || status == State_Active {
+				available++
+			}
+		}
+		if available == len(portIDs) {
+			return allPorts, State_Available, nil
+		}
+
+		return allPorts, State_Build, nil
+	}
+}
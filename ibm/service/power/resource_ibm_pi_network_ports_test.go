@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPINetworkPortsBasic(t *testing.T) {
+	networkName := fmt.Sprintf("tf-pi-network-ports-test-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkPortsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkPortsConfig(networkName, `
+					pi_network_port {
+						ip_address = "192.168.16.10"
+					}
+					pi_network_port {
+						description = "db"
+						ip_address  = "192.168.16.11"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkPortsExists("ibm_pi_network_ports.power_network_ports", 2),
+					resource.TestCheckResourceAttr("ibm_pi_network_ports.power_network_ports", "pi_network_port.#", "2"),
+					resource.TestCheckResourceAttr("ibm_pi_network_ports.power_network_ports", "network_ports.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPINetworkPortsConfig(networkName, `
+					pi_network_port {
+						description = "db primary"
+						ip_address  = "192.168.16.11"
+					}
+					pi_network_port {
+						ip_address = "192.168.16.12"
+					}
+					pi_network_port {
+						ip_address = "192.168.16.13"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkPortsExists("ibm_pi_network_ports.power_network_ports", 3),
+					resource.TestCheckResourceAttr("ibm_pi_network_ports.power_network_ports", "pi_network_port.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_pi_network_ports.power_network_ports", "pi_network_port.*", map[string]string{
+						"description": "db primary",
+						"ip_address":  "192.168.16.11",
+					}),
+				),
+			},
+			{
+				ResourceName:      "ibm_pi_network_ports.power_network_ports",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkPortsDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_network_ports" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		networkC := instance.NewIBMPINetworkClient(context.Background(), sess, parts[0])
+		ports, err := networkC.GetAllPorts(parts[1])
+		if err == nil && len(ports.Ports) > 0 {
+			return fmt.Errorf("PI Network Ports still exist: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPINetworkPortsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPINetworkClient(context.Background(), sess, parts[0])
+		ports, err := client.GetAllPorts(parts[1])
+		if err != nil {
+			return err
+		}
+		if len(ports.Ports) != count {
+			return fmt.Errorf("expected %d ports on network %s, found %d", count, parts[1], len(ports.Ports))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPINetworkPortsConfig(networkName, ports string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_network" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_cidr              = "192.168.16.0/24"
+			pi_network_name      = "%[2]s"
+			pi_network_type      = "vlan"
+		}
+		resource "ibm_pi_network_ports" "power_network_ports" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_network_id        = ibm_pi_network.power_network.network_id
+			%[3]s
+		}
+	`, acc.Pi_cloud_instance_id, networkName, ports)
+}
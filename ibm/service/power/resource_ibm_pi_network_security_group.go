@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -247,19 +246,16 @@ func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkSecurityGroup.Crn != nil {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkSecurityGroup.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*networkSecurityGroup.Crn))...)
 		}
 	}
 	nsgID := *networkSecurityGroup.ID
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, nsgID))
 
-	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -282,13 +278,10 @@ func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.Resour
 	}
 	d.Set(Arg_Name, networkSecurityGroup.Name)
 	crn := networkSecurityGroup.Crn
+	var diags diag.Diagnostics
 	if crn != nil {
 		d.Set(Attr_CRN, networkSecurityGroup.Crn)
-		userTags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkSecurityGroup.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of network security group (%s) pi_user_tags: %s", nsgID, err)
-		}
-		d.Set(Arg_UserTags, userTags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*networkSecurityGroup.Crn))...)
 	}
 	d.Set(Attr_Default, networkSecurityGroup.Default)
 
@@ -316,7 +309,7 @@ func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.Resour
 		d.Set(Attr_Rules, []string{})
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -328,13 +321,10 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 	if d.HasChange(Arg_Name) {
@@ -347,7 +337,7 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
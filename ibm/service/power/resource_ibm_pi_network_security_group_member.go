@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"slices"
 	"strings"
 	"time"
@@ -306,13 +305,10 @@ func resourceIBMPINetworkSecurityGroupMemberRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if networkSecurityGroup.Crn != nil {
 		d.Set(Attr_CRN, networkSecurityGroup.Crn)
-		userTags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkSecurityGroup.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of network security group (%s) user_tags: %s", parts[1], err)
-		}
-		d.Set(Attr_UserTags, userTags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Attr_UserTags, string(*networkSecurityGroup.Crn))...)
 	}
 	d.Set(Attr_Default, networkSecurityGroup.Default)
 	if len(networkSecurityGroup.Members) > 0 {
@@ -339,7 +335,7 @@ func resourceIBMPINetworkSecurityGroupMemberRead(ctx context.Context, d *schema.
 		d.Set(Attr_Rules, nil)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPINetworkSecurityGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
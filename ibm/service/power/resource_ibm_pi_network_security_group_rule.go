@@ -487,24 +487,32 @@ func resourceIBMPINetworkSecurityGroupRuleRead(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	cloudInstanceID, nsgID, err := splitID(d.Id())
+	ids, err := flex.IdParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	cloudInstanceID, nsgID := ids[0], ids[1]
 	nsgClient := instance.NewIBMIPINetworkSecurityGroupClient(ctx, sess, cloudInstanceID)
 	networkSecurityGroup, err := nsgClient.Get(nsgID)
 	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[WARN] Removing network security group rule (%s) from state because the network security group is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
+	if len(ids) == 3 && !hasNetworkSecurityGroupRule(networkSecurityGroup, ids[2]) {
+		log.Printf("[WARN] Removing network security group rule (%s) from state because it is not found", d.Id())
+		d.SetId("")
+		return nil
+	}
 	d.Set(Attr_Name, networkSecurityGroup.Name)
 
+	var diags diag.Diagnostics
 	if networkSecurityGroup.Crn != nil {
 		d.Set(Attr_CRN, networkSecurityGroup.Crn)
-		userTags, err := flex.GetGlobalTagsUsingCRN(meta, string(*networkSecurityGroup.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of network security group (%s) user_tags: %s", nsgID, err)
-		}
-		d.Set(Attr_UserTags, userTags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Attr_UserTags, string(*networkSecurityGroup.Crn))...)
 	}
 	d.Set(Attr_Default, networkSecurityGroup.Default)
 
@@ -530,7 +538,7 @@ func resourceIBMPINetworkSecurityGroupRuleRead(ctx context.Context, d *schema.Re
 	} else {
 		d.Set(Attr_Rules, []string{})
 	}
-	return nil
+	return diags
 }
 
 func resourceIBMPINetworkSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -620,20 +628,21 @@ func isIBMPINetworkSecurityGroupRuleRemoveRefreshFunc(client *instance.IBMPINetw
 			return nil, "", err
 		}
 
-		if networkSecurityGroup.Rules != nil {
-			foundRule := false
-			for _, rule := range networkSecurityGroup.Rules {
-				if *rule.ID == ruleID {
-					foundRule = true
-					return networkSecurityGroup, State_Pending, nil
-				}
-			}
-			if !foundRule {
-				return networkSecurityGroup, State_Removed, nil
-			}
+		// The rules are omitted once the last rule of the network security group is removed
+		if hasNetworkSecurityGroupRule(networkSecurityGroup, ruleID) {
+			return networkSecurityGroup, State_Pending, nil
+		}
+		return networkSecurityGroup, State_Removed, nil
+	}
+}
+
+func hasNetworkSecurityGroupRule(networkSecurityGroup *models.NetworkSecurityGroup, ruleID string) bool {
+	for _, rule := range networkSecurityGroup.Rules {
+		if rule != nil && rule.ID != nil && *rule.ID == ruleID {
+			return true
 		}
-		return networkSecurityGroup, State_Pending, nil
 	}
+	return false
 }
 
 func networkSecurityGroupRuleMapToPort(portMap map[string]interface{}) *models.NetworkSecurityGroupRulePort {
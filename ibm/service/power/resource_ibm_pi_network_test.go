@@ -120,6 +120,109 @@ func TestAccIBMPINetworkGatewaybasicSatellite(t *testing.T) {
 	})
 }
 
+func TestAccIBMPINetworkDhcpEnabled(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkDhcpEnabledConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_network_type", "vlan"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_dhcp_enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "dhcp_managed", "true"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network.power_networks", "dhcp_pool_start"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network.power_networks", "dhcp_pool_end"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network.power_networks", "pi_ipaddress_range.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPINetworkFirstUsableOffset(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkFirstUsableOffsetConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_gateway", "192.168.19.1"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_ipaddress_range.0.pi_starting_ip_address", "192.168.19.2"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_ipaddress_range.0.pi_ending_ip_address", "192.168.19.254"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPINetworkFirstUsableOffsetConfig(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists("ibm_pi_network.power_networks"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_ipaddress_range.0.pi_starting_ip_address", "192.168.19.10"),
+					resource.TestCheckResourceAttr("ibm_pi_network.power_networks", "pi_ipaddress_range.0.pi_ending_ip_address", "192.168.19.254"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPINetworkIPRangeManagement(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
+	networkRes := "ibm_pi_network.power_networks"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkIPRangeManagementConfig(name, "strict", "192.168.21.10", "192.168.21.50"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkExists(networkRes),
+					resource.TestCheckResourceAttr(networkRes, "pi_ipaddress_range.#", "1"),
+					resource.TestCheckResourceAttr(networkRes, "managed_ip_address_ranges.#", "1"),
+					resource.TestCheckResourceAttr(networkRes, "unmanaged_ip_address_ranges.#", "0"),
+				),
+			},
+			{
+				// the range added by Terraform in the previous step left the configuration and is removed
+				Config: testAccCheckIBMPINetworkIPRangeManagementConfig(name, "append", "192.168.21.100", "192.168.21.150"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(networkRes, "pi_ipaddress_range.#", "1"),
+					resource.TestCheckResourceAttr(networkRes, "pi_ipaddress_range.0.pi_starting_ip_address", "192.168.21.100"),
+					resource.TestCheckResourceAttr(networkRes, "managed_ip_address_ranges.0.starting_ip_address", "192.168.21.100"),
+					resource.TestCheckResourceAttr(networkRes, "unmanaged_ip_address_ranges.#", "0"),
+				),
+			},
+			{
+				Config:   testAccCheckIBMPINetworkIPRangeManagementConfig(name, "ignore", "192.168.21.200", "192.168.21.250"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkIPRangeManagementConfig(name, mode, start, end string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id   = "%[1]s"
+			pi_cidr                = "192.168.21.0/24"
+			pi_gateway             = "192.168.21.1"
+			pi_ip_range_management = "%[3]s"
+			pi_network_name        = "%[2]s"
+			pi_network_type        = "vlan"
+			pi_ipaddress_range {
+				pi_ending_ip_address   = "%[5]s"
+				pi_starting_ip_address = "%[4]s"
+			}
+		}
+	`, acc.Pi_cloud_instance_id, name, mode, start, end)
+}
+
 func TestAccIBMPINetworkUserTags(t *testing.T) {
 	name := fmt.Sprintf("tf-pi-network-%d", acctest.RandIntRange(10, 100))
 	networkRes := "ibm_pi_network.power_networks"
@@ -288,6 +391,30 @@ func testAccCheckIBMPINetworkGatewayConfigSatellite(name string) string {
 	`, acc.Pi_cloud_instance_id, name)
 }
 
+func testAccCheckIBMPINetworkDhcpEnabledConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id = "%s"
+			pi_network_name      = "%s"
+			pi_network_type      = "vlan"
+			pi_cidr              = "192.168.18.0/24"
+			pi_dhcp_enabled      = true
+		}
+	`, acc.Pi_cloud_instance_id, name)
+}
+
+func testAccCheckIBMPINetworkFirstUsableOffsetConfig(name string, offset int) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network" "power_networks" {
+			pi_cloud_instance_id   = "%s"
+			pi_network_name        = "%s"
+			pi_network_type        = "vlan"
+			pi_cidr                = "192.168.19.0/24"
+			pi_first_usable_offset = %d
+		}
+	`, acc.Pi_cloud_instance_id, name, offset)
+}
+
 func testAccCheckIBMPINetworkConfigGatewayUpdateDNS(name string) string {
 	return fmt.Sprintf(`
 		resource "ibm_pi_network" "power_networks" {
@@ -119,16 +119,13 @@ func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("Printing the placement group %+v", &response)
+	var diags diag.Diagnostics
 	if response.Crn != "" {
-		oldList, newList := d.GetChange(Arg_UserTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(response.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on update of pi spp placement group (%s) pi_user_tags during creation: %s", *response.ID, err)
-		}
+		diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(response.Crn))...)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *response.ID))
-	return resourceIBMPIPlacementGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPIPlacementGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -154,34 +151,28 @@ func resourceIBMPIPlacementGroupRead(ctx context.Context, d *schema.ResourceData
 	d.Set(Arg_PlacementGroupPolicy, response.Policy)
 	d.Set(Attr_Members, response.Members)
 	d.Set(Attr_PlacementGroupID, response.ID)
+	var diags diag.Diagnostics
 	if response.Crn != "" {
 		d.Set(Attr_CRN, response.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(response.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of ibm pi placement group (%s) pi_user_tags: %s", *response.ID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(response.Crn))...)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	_, pgID, err := splitID(d.Id())
+	_, _, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi placement group (%s) pi_user_tags: %s", pgID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPIPlacementGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPIPlacementGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -164,20 +163,17 @@ func resourceIBMPIRouteCreate(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if route.Crn != nil {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*route.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *route.ID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*route.Crn))...)
 		}
 	}
 
 	routeID := *route.ID
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, routeID))
 
-	return resourceIBMPIRouteRead(ctx, d, meta)
+	return append(diags, resourceIBMPIRouteRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -203,13 +199,10 @@ func resourceIBMPIRouteRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	d.Set(Arg_Action, route.Action)
 	d.Set(Arg_Advertise, route.Advertise)
+	var diags diag.Diagnostics
 	if route.Crn != nil {
 		d.Set(Attr_CRN, route.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(*route.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi route (%s) pi_user_tags: %s", *route.ID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(*route.Crn))...)
 	}
 	d.Set(Arg_Destination, route.Destination)
 	d.Set(Arg_DestinationType, route.DestinationType)
@@ -220,7 +213,7 @@ func resourceIBMPIRouteRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set(Arg_NextHopType, route.NextHopType)
 	d.Set(Attr_State, route.State)
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -281,16 +274,13 @@ func resourceIBMPIRouteUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags: %s", routeID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
-	return resourceIBMPIRouteRead(ctx, d, meta)
+	return append(diags, resourceIBMPIRouteRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -162,17 +162,14 @@ func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if snapshotResponse.Crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(snapshotResponse.Crn))...)
 		}
 	}
 
-	return resourceIBMPISnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPISnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -195,20 +192,17 @@ func resourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set(Arg_SnapShotName, snapshotdata.Name)
 	d.Set(Attr_CreationDate, snapshotdata.CreationDate.String())
+	var diags diag.Diagnostics
 	if snapshotdata.Crn != "" {
 		d.Set(Attr_CRN, snapshotdata.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(snapshotdata.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of pi snapshot (%s) pi_user_tags: %s", *snapshotdata.SnapshotID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(snapshotdata.Crn))...)
 	}
 	d.Set(Attr_LastUpdateDate, snapshotdata.LastUpdateDate.String())
 	d.Set(Attr_SnapshotID, *snapshotdata.SnapshotID)
 	d.Set(Attr_Status, snapshotdata.Status)
 	d.Set(Attr_VolumeSnapshots, snapshotdata.VolumeSnapshots)
 
-	return nil
+	return diags
 }
 
 func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -241,17 +235,14 @@ func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPISnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPISnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,7 +6,6 @@ package power
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -117,17 +116,14 @@ func resourceIBMPISPPPlacementGroupCreate(ctx context.Context, d *schema.Resourc
 	if err != nil || response == nil {
 		return diag.Errorf("error creating the spp placement group: %v", err)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if response.Crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(response.Crn), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi spp placement group (%s) pi_user_tags during creation: %s", *response.ID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(response.Crn))...)
 		}
 	}
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *response.ID))
-	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPISPPPlacementGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -154,35 +150,29 @@ func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceD
 	d.Set(Attr_SPPPlacementGroupMembers, response.MemberSharedProcessorPools)
 	d.Set(Arg_SPPPlacementGroupName, response.Name)
 	d.Set(Arg_SPPPlacementGroupPolicy, response.Policy)
+	var diags diag.Diagnostics
 	if response.Crn != "" {
 		d.Set(Attr_CRN, response.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(response.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of ibm pi spp placement group (%s) pi_user_tags: %s", *response.ID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(response.Crn))...)
 	}
 
-	return nil
+	return diags
 
 }
 
 func resourceIBMPISPPPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	_, spppgID, err := splitID(d.Id())
+	_, _, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi spp placement group (%s) pi_user_tags: %s", spppgID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
 
-	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPISPPPlacementGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISPPPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
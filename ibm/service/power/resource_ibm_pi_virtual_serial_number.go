@@ -52,10 +52,9 @@ func ResourceIBMPIVirtualSerialNumber() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			Arg_RetainVirtualSerialNumber: {
-				Description:  "Indicates whether to retain virtual serial number after unassigning from PVM instance during deletion.",
-				Optional:     true,
-				RequiredWith: []string{Arg_InstanceID},
-				Type:         schema.TypeBool,
+				Description: "Indicates whether to retain virtual serial number during deletion instead of deleting it, whether it is assigned to a PVM instance or not.",
+				Optional:    true,
+				Type:        schema.TypeBool,
 			},
 			Arg_Serial: {
 				Description:      "Virtual serial number.",
@@ -247,6 +246,7 @@ func resourceIBMPIVirtualSerialNumberRead(ctx context.Context, d *schema.Resourc
 		}
 		return diag.FromErr(err)
 	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	d.Set(Arg_Description, vsn.Description)
 	d.Set(Arg_InstanceID, vsn.PvmInstanceID)
 	d.Set(Arg_Serial, vsn.Serial)
@@ -268,18 +268,30 @@ func resourceIBMPIVirtualSerialNumberDelete(ctx context.Context, d *schema.Resou
 	cloudInstanceID := idArr[0]
 	client := instance.NewIBMPIVSNClient(ctx, sess, cloudInstanceID)
 
-	if v, ok := d.GetOk(Arg_InstanceID); ok {
-		pvmInstanceId := v.(string)
-		instanceClient := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-		restartInstance, err := stopLparForVSNChange(ctx, instanceClient, pvmInstanceId, d.Timeout(schema.TimeoutDelete))
+	retainVSN := false
+	if v, ok := d.GetOk(Arg_RetainVirtualSerialNumber); ok {
+		retainVSN = v.(bool)
+	}
+
+	instanceClient := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvmInstanceId := d.Get(Arg_InstanceID).(string)
+	if pvmInstanceId != "" {
+		// The instance may already be gone, e.g. when it was replaced, in which case the VSN is no longer assigned
+		found, err := isPIInstanceFoundForVSNChange(instanceClient, pvmInstanceId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if !found {
+			pvmInstanceId = ""
+		}
+	}
 
-		retainVSN := false
-		if v, ok := d.GetOk(Arg_RetainVirtualSerialNumber); ok {
-			retainVSN = v.(bool)
+	if pvmInstanceId != "" {
+		restartInstance, err := stopLparForVSNChange(ctx, instanceClient, pvmInstanceId, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
 		}
+
 		deleteBody := &models.DeleteServerVirtualSerialNumber{
 			RetainVSN: retainVSN,
 		}
@@ -302,11 +314,10 @@ func resourceIBMPIVirtualSerialNumberDelete(ctx context.Context, d *schema.Resou
 			}
 		}
 
-	} else {
-		// TODO: allow user to use pi_retain_virtual_serial_number to retain VSN instead of deleting it when not attached to PVM instance
+	} else if !retainVSN {
 		serialNumber := d.Get(Arg_Serial).(string)
 		err = client.Delete(serialNumber)
-		if err != nil {
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), NotFound) {
 			return diag.FromErr(err)
 		}
 	}
@@ -408,6 +419,17 @@ func resourceIBMPIVirtualSerialNumberUpdate(ctx context.Context, d *schema.Resou
 			return diag.Errorf("cannot set '%s' unless '%s' is specified", Arg_SoftwareTier, Arg_InstanceID)
 		}
 
+		if oldIdString != "" {
+			// Moving from a replaced or deleted instance, nothing to unassign
+			found, err := isPIInstanceFoundForVSNChange(instanceClient, oldIdString)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !found {
+				oldIdString = ""
+			}
+		}
+
 		if oldIdString != "" {
 			restartInstance, err := stopLparForVSNChange(ctx, instanceClient, oldIdString, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -496,6 +518,18 @@ func resourceIBMPIVirtualSerialNumberUpdate(ctx context.Context, d *schema.Resou
 	return resourceIBMPIVirtualSerialNumberRead(ctx, d, meta)
 }
 
+// isPIInstanceFoundForVSNChange returns whether the pvm instance a VSN is assigned to still exists.
+func isPIInstanceFoundForVSNChange(client *instance.IBMPIInstanceClient, id string) (bool, error) {
+	_, err := client.Get(id)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get pvm instance (%s): %v", id, err)
+	}
+	return true, nil
+}
+
 func startLparAfterVSNChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		Action: flex.PtrToString(Action_Start),
@@ -75,6 +75,12 @@ func TestAccIBMPIVirtualSerialNumberWithInstance(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resLocator, "pi_instance_id"),
 				),
 			},
+			{
+				ResourceName:            resLocator,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pi_retain_virtual_serial_number"},
+			},
 		},
 	})
 }
@@ -329,15 +329,12 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk(Arg_UserTags); ok {
-		oldList, newList := d.GetChange(Arg_UserTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(vol.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err)
-		}
+		diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(vol.Crn))...)
 	}
 
-	return resourceIBMPIVolumeRead(ctx, d, meta)
+	return append(diags, resourceIBMPIVolumeRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -361,13 +358,10 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 	if vol.VolumeID != nil {
 		d.Set(Attr_VolumeID, vol.VolumeID)
 	}
+	var diags diag.Diagnostics
 	if vol.Crn != "" {
 		d.Set(Attr_CRN, vol.Crn)
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, string(vol.Crn), "", UserTagType)
-		if err != nil {
-			log.Printf("Error on get of volume (%s) pi_user_tags: %s", *vol.VolumeID, err)
-		}
-		d.Set(Arg_UserTags, tags)
+		diags = append(diags, setIBMPIUserTags(ctx, d, meta, Arg_UserTags, string(vol.Crn))...)
 	}
 	d.Set(Arg_VolumeName, vol.Name)
 	d.Set(Arg_VolumePool, vol.VolumePool)
@@ -396,7 +390,7 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(Attr_VolumeStatus, vol.State)
 	d.Set(Attr_WWN, vol.Wwn)
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -450,17 +444,14 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		crn := d.Get(Attr_CRN)
 		if crn != nil && crn != "" {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
-	return resourceIBMPIVolumeRead(ctx, d, meta)
+	return append(diags, resourceIBMPIVolumeRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
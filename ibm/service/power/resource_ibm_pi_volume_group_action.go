@@ -6,6 +6,8 @@ package power
 import (
 	"context"
 	"fmt"
+	"log"
+	"slices"
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -13,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/softlayer/softlayer-go/sl"
@@ -39,9 +42,25 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 			Arg_VolumeGroupAction: {
-				Description: "Performs an action (start stop reset ) on a volume group(one at a time).",
+				Description: "Performs an action (start stop reset failover) on a volume group(one at a time).",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						Attr_Failover: {
+							Description: "Performs failover on a volume group, stops the replication with access to the aux volumes and restarts it with aux as the source.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Enabled: {
+										Description: "Indicates whether the failover is performed.",
+										Required:    true,
+										Type:        schema.TypeBool,
+									},
+								},
+							},
+							ForceNew: true,
+							MaxItems: 1,
+							Optional: true,
+							Type:     schema.TypeList,
+						},
 						Attr_Start: {
 							Description: "Performs start action on a volume group.",
 							Elem: &schema.Resource{
@@ -109,11 +128,31 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 			},
 
 			// Attributes
+			Attr_ConsistencyGroupName: {
+				Computed:    true,
+				Description: "The name of consistency group at storage controller level.",
+				Type:        schema.TypeString,
+			},
+			Attr_ConsistencyGroupState: {
+				Computed:    true,
+				Description: "The replication state of the consistency group.",
+				Type:        schema.TypeString,
+			},
+			Attr_PrimaryRole: {
+				Computed:    true,
+				Description: "Indicates whether master/aux volume is playing the primary role.",
+				Type:        schema.TypeString,
+			},
 			Attr_ReplicationStatus: {
 				Computed:    true,
 				Description: "Volume Group Replication Status",
 				Type:        schema.TypeString,
 			},
+			Attr_Synchronized: {
+				Computed:    true,
+				Description: "Indicates whether the relationship is synchronized.",
+				Type:        schema.TypeString,
+			},
 			Attr_VolumeGroupName: {
 				Computed:    true,
 				Description: "Volume Group ID",
@@ -135,25 +174,32 @@ func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	vgID := d.Get(Arg_VolumeGroupID).(string)
-	vgAction, err := expandVolumeGroupAction(d.Get(Arg_VolumeGroupAction).([]interface{}))
+	vgActions, err := expandVolumeGroupActions(d.Get(Arg_VolumeGroupAction).([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
-	body := vgAction
-
 	client := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
-	_, err = client.VolumeGroupAction(vgID, body)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
-
-	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
-	if err != nil {
-		return diag.FromErr(err)
+	for _, body := range vgActions {
+		_, err = client.VolumeGroupAction(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if target := getVolumeGroupActionReplicationStates(body); len(target) > 0 {
+			_, err = isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, target, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
@@ -181,6 +227,15 @@ func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceD
 	d.Set(Attr_VolumeGroupStatus, vg.Status)
 	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
 
+	vgLiveDetails, err := client.GetVolumeGroupLiveDetails(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Attr_ConsistencyGroupName, vgLiveDetails.ConsistencyGroupName)
+	d.Set(Attr_ConsistencyGroupState, vgLiveDetails.State)
+	d.Set(Attr_PrimaryRole, vgLiveDetails.PrimaryRole)
+	d.Set(Attr_Synchronized, vgLiveDetails.Sync)
+
 	return nil
 }
 
@@ -190,6 +245,32 @@ func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// expandVolumeGroupActions retrieve the volume group actions to perform in order, a failover is a stop
+// with access to the aux volumes followed by a start from aux
+func expandVolumeGroupActions(data []interface{}) ([]*models.VolumeGroupAction, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("[ERROR] no pi_volume_group_action received")
+	}
+
+	action := data[0].(map[string]interface{})
+	if v, ok := action[Attr_Failover]; ok && len(v.([]interface{})) != 0 {
+		failover := v.([]interface{})[0].(map[string]interface{})
+		if !failover[Attr_Enabled].(bool) {
+			return nil, fmt.Errorf("[ERROR] failover of pi_volume_group_action must be enabled")
+		}
+		return []*models.VolumeGroupAction{
+			{Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)}},
+			{Start: &models.VolumeGroupActionStart{Source: sl.String(Aux)}},
+		}, nil
+	}
+
+	vgAction, err := expandVolumeGroupAction(data)
+	if err != nil {
+		return nil, err
+	}
+	return []*models.VolumeGroupAction{vgAction}, nil
+}
+
 // expandVolumeGroupAction retrieve volume group action resource
 func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
 	if len(data) == 0 {
@@ -251,3 +332,48 @@ func expandVolumeGroupResetAction(reset []interface{}) *models.VolumeGroupAction
 		Status: sl.String(s[Attr_Status].(string)),
 	}
 }
+
+// getVolumeGroupActionReplicationStates returns the consistency group states that complete the action
+func getVolumeGroupActionReplicationStates(vgAction *models.VolumeGroupAction) []string {
+	switch {
+	case vgAction.Start != nil:
+		return []string{State_ConsistentCopying, State_ConsistentSynchronized, State_InconsistentCopying}
+	case vgAction.Stop != nil && vgAction.Stop.Access != nil && *vgAction.Stop.Access:
+		return []string{State_Idling}
+	case vgAction.Stop != nil:
+		return []string{State_ConsistentStopped, State_InconsistentStopped}
+	}
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, target []string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) replication to be %v.", id, target)
+
+	pending := []string{}
+	for _, state := range []string{State_ConsistentCopying, State_ConsistentStopped, State_ConsistentSynchronized, State_Idling, State_InconsistentCopying, State_InconsistentStopped} {
+		if !slices.Contains(target, state) {
+			pending = append(pending, state)
+		}
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return vg, vg.State, nil
+	}
+}
@@ -45,6 +45,26 @@ func TestAccIBMPIVolumeGroupActionbasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIVolumeGroupActionFailover(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-action-%d", acctest.RandIntRange(10, 100))
+	vgActionRes := "ibm_pi_volume_group_action.power_volume_group_action"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverActionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupActionExists(vgActionRes),
+					resource.TestCheckResourceAttr(vgActionRes, "primary_role", "aux"),
+					resource.TestCheckResourceAttrSet(vgActionRes, "consistency_group_name"),
+					resource.TestCheckResourceAttrSet(vgActionRes, "consistency_group_state"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIVolumeGroupActionExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -105,3 +125,17 @@ func testAccCheckIBMPIVolumeGroupStartActionConfig(name string) string {
 	  }
 	`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIVolumeGroupFailoverActionConfig(name string) string {
+	return testAccCheckIBMPIVolumeGroupConfig(name) + fmt.Sprintf(`
+	  resource "ibm_pi_volume_group_action" "power_volume_group_action" {
+		pi_cloud_instance_id   = "%[1]s"
+		pi_volume_group_id     = ibm_pi_volume_group.power_volume_group.volume_group_id
+		pi_volume_group_action {
+			failover {
+				enabled = true
+			}
+		}
+	  }
+	`, acc.Pi_cloud_instance_id)
+}
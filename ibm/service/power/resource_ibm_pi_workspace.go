@@ -120,16 +120,13 @@ func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// Add user tags for newly created workspace
+	var diags diag.Diagnostics
 	if tags, ok := d.GetOk(Arg_UserTags); ok {
 		if len(flex.FlattenSet(tags.(*schema.Set))) > 0 {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *controller.CRN, "", UserTagType)
-			if err != nil {
-				log.Printf("Error on creation of workspace (%s) pi_user_tags: %s", *controller.CRN, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, *controller.CRN)...)
 		}
 	}
-	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIWorkspaceRead(ctx, d, meta)...)
 }
 
 func waitForResourceWorkspaceCreate(ctx context.Context, client *instance.IBMPIWorkspacesClient, id string, timeout time.Duration) (interface{}, error) {
@@ -171,11 +168,7 @@ func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 	d.Set(Arg_Name, controller.Name)
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, *controller.CRN, "", UserTagType)
-	if err != nil {
-		log.Printf("Error on get of workspace (%s) pi_user_tags: %s", cloudInstanceID, err)
-	}
-	d.Set(Arg_UserTags, tags)
+	diags := setIBMPIUserTags(ctx, d, meta, Arg_UserTags, *controller.CRN)
 
 	d.Set(Attr_CRN, controller.CRN)
 
@@ -186,7 +179,7 @@ func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 	}
 	d.Set(Attr_WorkspaceDetails, flex.Flatten(wsDetails))
 
-	return nil
+	return diags
 }
 
 func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -243,14 +236,11 @@ func isIBMPIResourceDeleteRefreshFunc(client *instance.IBMPIWorkspacesClient, id
 }
 
 func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
-			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
-			if err != nil {
-				log.Printf("Error on update of workspace (%s) pi_user_tags: %s", crn, err)
-			}
+			diags = append(diags, updateIBMPIUserTags(ctx, d, meta, Arg_UserTags, crn.(string))...)
 		}
 	}
-	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIWorkspaceRead(ctx, d, meta)...)
 }
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func DataIBMContainerRegistryImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists images that are stored in the specified namespace only.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists images that are stored in the specified repository, under your namespaces.",
+			},
+			"include_ibm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes IBM-provided public images in the list of images.",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry Images",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The image ID.",
+						},
+						"repo_digests": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The digests of the image, in the format repository@digest.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"repo_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags of the image.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"created": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The unix timestamp when the image was created.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes.",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the image manifest.",
+						},
+						"vulnerable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vulnerability Advisor security status of the image.",
+						},
+						"vulnerability_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vulnerabilities that were found in the image.",
+						},
+						"configuration_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of configuration issues that were found in the image.",
+						},
+						"issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of security issues that were found in the image.",
+						},
+						"exempt_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of security issues that are exempted by an exemption policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+	listImagesOptions.SetVulnerabilities(true)
+	listImagesOptions.SetIncludeIBM(d.Get("include_ibm").(bool))
+
+	if namespace, ok := d.GetOk("namespace"); ok {
+		listImagesOptions.SetNamespace(namespace.(string))
+	}
+	if repository, ok := d.GetOk("repository"); ok {
+		listImagesOptions.SetRepository(repository.(string))
+	}
+
+	imageList, response, err := containerRegistryClient.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListImagesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	images := []map[string]interface{}{}
+	for _, remoteImage := range imageList {
+		image := map[string]interface{}{}
+		image["id"] = remoteImage.ID
+		image["repo_digests"] = remoteImage.RepoDigests
+		image["repo_tags"] = remoteImage.RepoTags
+		image["created"] = flex.IntValue(remoteImage.Created)
+		image["size"] = flex.IntValue(remoteImage.Size)
+		image["manifest_type"] = remoteImage.ManifestType
+		image["vulnerable"] = remoteImage.Vulnerable
+		image["vulnerability_count"] = flex.IntValue(remoteImage.VulnerabilityCount)
+		image["configuration_issue_count"] = flex.IntValue(remoteImage.ConfigurationIssueCount)
+		image["issue_count"] = flex.IntValue(remoteImage.IssueCount)
+		image["exempt_issue_count"] = flex.IntValue(remoteImage.ExemptIssueCount)
+		images = append(images, image)
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImagesDataSourceBasic(t *testing.T) {
+	namespaceName := fmt.Sprintf("terraform-tf-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImagesDataSourceConfig(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cr_images.images", "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImagesDataSourceConfig(namespaceName string) string {
+	return testAccCheckIBMCrNamespaceConfigBasic(namespaceName) + `
+	data "ibm_cr_images" "images" {
+		namespace = ibm_cr_namespace.cr_namespace.name
+	}
+`
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
+)
+
+func ResourceIBMCrExemption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrExemptionCreate,
+		ReadContext:   resourceIBMCrExemptionRead,
+		DeleteContext: resourceIBMCrExemptionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The registry resource that the exemption applies to, in the format namespace, namespace/repository or namespace/repository:tag. The exemption applies to the whole account if it is not set.",
+			},
+			"issue_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the exempted issue, for example cve, sn or configuration.",
+			},
+			"issue_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the exempted issue, for example CVE-2018-9999.",
+			},
+			"scope_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of scope the exemption applies to: account, namespace, repository or image.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account of the exemption.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrExemptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType := d.Get("issue_type").(string)
+	issueID := d.Get("issue_id").(string)
+	if resource, ok := d.GetOk("resource"); ok {
+		createExemptionResourceOptions := vulnerabilityAdvisorClient.NewCreateExemptionResourceOptions(resource.(string), issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionResourceWithContext(context, createExemptionResourceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	} else {
+		createExemptionAccountOptions := vulnerabilityAdvisorClient.NewCreateExemptionAccountOptions(issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionAccountWithContext(context, createExemptionAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", issueType, issueID, d.Get("resource").(string)))
+
+	return resourceIBMCrExemptionRead(context, d, meta)
+}
+
+func resourceIBMCrExemptionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := parseIBMCrExemptionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var exemption *vulnerabilityadvisorv4.Exemption
+	var response *core.DetailedResponse
+	if resource != "" {
+		getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(resource, issueType, issueID)
+		exemption, response, err = vulnerabilityAdvisorClient.GetExemptionResourceWithContext(context, getExemptionResourceOptions)
+	} else {
+		getExemptionAccountOptions := vulnerabilityAdvisorClient.NewGetExemptionAccountOptions(issueType, issueID)
+		exemption, response, err = vulnerabilityAdvisorClient.GetExemptionAccountWithContext(context, getExemptionAccountOptions)
+	}
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetExemptionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("resource", resource); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource: %s", err))
+	}
+	if err = d.Set("issue_type", exemption.IssueType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting issue_type: %s", err))
+	}
+	if err = d.Set("issue_id", exemption.IssueID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting issue_id: %s", err))
+	}
+	if err = d.Set("account_id", exemption.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if exemption.Scope != nil {
+		if err = d.Set("scope_type", exemption.Scope.ScopeType); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting scope_type: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCrExemptionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := parseIBMCrExemptionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resource != "" {
+		deleteExemptionResourceOptions := vulnerabilityAdvisorClient.NewDeleteExemptionResourceOptions(resource, issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionResourceWithContext(context, deleteExemptionResourceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	} else {
+		deleteExemptionAccountOptions := vulnerabilityAdvisorClient.NewDeleteExemptionAccountOptions(issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionAccountWithContext(context, deleteExemptionAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// The ID is <issue_type>/<issue_id>/<resource>, the resource is last because it can contain slashes
+// and it is empty for account wide exemptions
+func parseIBMCrExemptionID(id string) (issueType, issueID, resource string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of issueType/issueID/resource", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
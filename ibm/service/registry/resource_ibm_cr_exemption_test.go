@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCrExemptionBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf_namespace_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCrExemptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrExemptionConfig(namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "resource", namespace),
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "issue_type", "cve"),
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "issue_id", "CVE-2018-9999"),
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "scope_type", "namespace"),
+					resource.TestCheckResourceAttrSet("ibm_cr_exemption.cr_exemption", "account_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cr_exemption.cr_exemption",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrExemptionConfig(namespace string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_exemption" "cr_exemption" {
+			resource   = ibm_cr_namespace.cr_namespace.name
+			issue_type = "cve"
+			issue_id   = "CVE-2018-9999"
+		}
+	`, namespace)
+}
+
+func testAccCheckIBMCrExemptionDestroy(s *terraform.State) error {
+	vulnerabilityAdvisorClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_exemption" {
+			continue
+		}
+
+		issueType := rs.Primary.Attributes["issue_type"]
+		issueID := rs.Primary.Attributes["issue_id"]
+		var response *core.DetailedResponse
+		if crResource := rs.Primary.Attributes["resource"]; crResource != "" {
+			getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(crResource, issueType, issueID)
+			_, response, err = vulnerabilityAdvisorClient.GetExemptionResource(getExemptionResourceOptions)
+		} else {
+			getExemptionAccountOptions := vulnerabilityAdvisorClient.NewGetExemptionAccountOptions(issueType, issueID)
+			_, response, err = vulnerabilityAdvisorClient.GetExemptionAccount(getExemptionAccountOptions)
+		}
+
+		if err == nil {
+			return fmt.Errorf("cr_exemption still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for cr_exemption (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,13 +32,21 @@ func ResourceIBMResourceKey() *schema.Resource {
 		UpdateContext: resourceIBMResourceKeyUpdate,
 		DeleteContext: resourceIBMResourceKeyDelete,
 		Exists:        resourceIBMResourceKeyExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMResourceKeyImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMResourceKeyRotationCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -51,10 +60,66 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Description: "Name of the user role.Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles.",
+				Description: "Name of the user role.Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles. The CRN of a service or custom role is also accepted.",
 				// ValidateFunc: validateRole,
 			},
 
+			"hmac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Generate HMAC credentials for the key. Only supported for Cloud Object Storage instances.",
+			},
+
+			"cos_hmac_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The HMAC credentials of the key, when it was created with HMAC enabled",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HMAC access key ID",
+						},
+						"secret_access_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The HMAC secret access key",
+						},
+					},
+				},
+			},
+
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing this value rotates the key: a new key is created with the same settings before the current key is revoked",
+			},
+
+			"keep_previous_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Keep the previous key after a rotation until the next rotation, or until this is set to false, so that both keys are valid during the overlap window",
+			},
+
+			"previous_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key replaced by the last rotation, while it is kept",
+			},
+
+			"previous_credentials_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Credentials of the key replaced by the last rotation in json string, while it is kept",
+			},
+
 			"resource_instance_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -220,10 +285,23 @@ func ResourceIBMResourceKeyValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	resourceKey, err := createResourceKey(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	d.SetId(*resourceKey.ID)
+
+	return resourceIBMResourceKeyRead(context, d, meta)
+}
+
+// createResourceKey creates a key from the configuration of d, it is used both to
+// create the resource and to rotate it
+func createResourceKey(d *schema.ResourceData, meta interface{}) (*rc.ResourceKey, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
 	name := d.Get("name").(string)
 
 	var instanceID, aliasID string
@@ -236,7 +314,7 @@ func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceDat
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`"))
+		return nil, fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`")
 	}
 
 	keyParameters := rc.ResourceKeyPostParameters{}
@@ -252,22 +330,29 @@ func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceDat
 			}
 		}
 	}
+	if d.Get("hmac").(bool) {
+		keyParameters.SetProperty("HMAC", true)
+	}
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err))
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err)
 	}
 
 	serviceID := resourceInstance.ResourceID
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err))
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err)
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err))
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err)
+	}
+
+	if err = validateResourceKeyParameters(service.Name, d); err != nil {
+		return nil, err
 	}
 
 	resourceKeyCreate := rc.CreateResourceKeyOptions{
@@ -279,7 +364,7 @@ func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceDat
 		role := r.(string)
 		serviceRole, err := getRoleFromName(role, service.Name, meta)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err))
+			return nil, fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
 		}
 		if role != "NONE" {
 			keyParameters.SetProperty("role_crn", serviceRole.RoleID)
@@ -289,15 +374,99 @@ func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceDat
 
 	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp))
+		return nil, fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
 	}
 
-	d.SetId(*resourceKey.ID)
+	return resourceKey, nil
+}
 
-	return resourceIBMResourceKeyRead(context, d, meta)
+// validateResourceKeyParameters checks the parameters that only some services
+// accept, so that they fail before the key is created
+func validateResourceKeyParameters(serviceName string, d *schema.ResourceData) error {
+	parameters := d.Get("parameters").(map[string]interface{})
+
+	hmac, hmacParameter := parameters["HMAC"]
+	if hmacParameter && hmac != "true" && hmac != "false" {
+		return fmt.Errorf("[ERROR] Invalid value %q for parameter HMAC, it must be true or false", hmac)
+	}
+	if (d.Get("hmac").(bool) || hmac == "true") && serviceName != "cloud-object-storage" {
+		return fmt.Errorf("[ERROR] HMAC credentials are only supported for cloud-object-storage instances, not %s", serviceName)
+	}
+
+	if serviceIDCRN, ok := parameters["serviceid_crn"]; ok && !strings.HasPrefix(serviceIDCRN.(string), "crn:") {
+		return fmt.Errorf("[ERROR] Invalid value %q for parameter serviceid_crn, it must be the CRN of a service ID", serviceIDCRN)
+	}
+
+	return nil
 }
 
 func resourceIBMResourceKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("rotation_trigger") {
+		resourceKey, err := createResourceKey(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error rotating resource key %s: %s", d.Id(), err))
+		}
+
+		// The replaced key is only revoked once the new one exists. The key it
+		// replaced in turn, if still kept, is revoked now. The CustomizeDiff marks
+		// the key attributes as unknown, their values in the state are the old ones.
+		oldPreviousKeyID, _ := d.GetChange("previous_key_id")
+		oldCredentialsJSON, _ := d.GetChange("credentials_json")
+		previousKeyID := d.Id()
+		d.SetId(*resourceKey.ID)
+		d.Set("previous_key_id", previousKeyID)
+		d.Set("previous_credentials_json", oldCredentialsJSON.(string))
+		if oldPreviousKeyID.(string) != "" {
+			if err = deleteResourceKeyIfExists(rsContClient, oldPreviousKeyID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if previousKeyID := d.Get("previous_key_id").(string); previousKeyID != "" && !d.Get("keep_previous_key").(bool) {
+		if err = deleteResourceKeyIfExists(rsContClient, previousKeyID); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("previous_key_id", "")
+		d.Set("previous_credentials_json", "")
+	}
+
+	return resourceIBMResourceKeyRead(context, d, meta)
+}
+
+// resourceIBMResourceKeyRotationCustomizeDiff marks the attributes of the key as unknown when a
+// rotation replaces it, so that dependent resources are planned with the new credentials.
+// The resource ID itself is not part of the schema and cannot be marked here.
+func resourceIBMResourceKeyRotationCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("rotation_trigger") {
+		return nil
+	}
+
+	for _, key := range []string{"credentials", "credentials_json", "cos_hmac_keys", "crn", "guid", "url", "status", "state", "created_at", "updated_at", "previous_key_id", "previous_credentials_json"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteResourceKeyIfExists(rsContClient *rc.ResourceControllerV2, resourceKeyID string) error {
+	resourceKeyDelete := rc.DeleteResourceKeyOptions{
+		ID: &resourceKeyID,
+	}
+	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting resource key %s: %s with resp code: %s", resourceKeyID, err, resp)
+	}
 	return nil
 }
 
@@ -356,6 +525,14 @@ func resourceIBMResourceKeyRead(context context.Context, d *schema.ResourceData,
 	cred, _ := json.Marshal(resourceKey.Credentials)
 	json.Unmarshal(cred, &credInterface)
 	d.Set("credentials", flex.Flatten(credInterface))
+	cosHmacKeys := []map[string]interface{}{}
+	if hmacKeys, ok := credInterface["cos_hmac_keys"].(map[string]interface{}); ok {
+		cosHmacKeys = append(cosHmacKeys, map[string]interface{}{
+			"access_key_id":     hmacKeys["access_key_id"],
+			"secret_access_key": hmacKeys["secret_access_key"],
+		})
+	}
+	d.Set("cos_hmac_keys", cosHmacKeys)
 
 	creds, err := json.Marshal(resourceKey.Credentials)
 	if err != nil {
@@ -389,8 +566,12 @@ func resourceIBMResourceKeyRead(context context.Context, d *schema.ResourceData,
 			if err == nil && len(roles) > 0 {
 				for _, role := range roles {
 					if *role.RoleID == roleCrn {
-						RoleName := role.DisplayName
-						d.Set("role", RoleName)
+						if strings.HasPrefix(d.Get("role").(string), "crn:") {
+							d.Set("role", roleCrn)
+						} else {
+							RoleName := role.DisplayName
+							d.Set("role", RoleName)
+						}
 					}
 				}
 			}
@@ -436,6 +617,23 @@ func resourceIBMResourceKeyRead(context context.Context, d *schema.ResourceData,
 	d.Set("updated_by", *resourceKey.UpdatedBy)
 	d.Set("deleted_by", *resourceKey.DeletedBy)
 
+	if previousKeyID := d.Get("previous_key_id").(string); previousKeyID != "" {
+		previousKey, resp, err := rsContClient.GetResourceKey(&rc.GetResourceKeyOptions{ID: &previousKeyID})
+		if err != nil && (resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410)) {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving previous resource key %s: %s with resp : %s", previousKeyID, err, resp))
+		}
+		if err != nil || previousKey.State == nil || *previousKey.State == "removed" {
+			d.Set("previous_key_id", "")
+			d.Set("previous_credentials_json", "")
+		} else {
+			previousCreds, err := json.Marshal(previousKey.Credentials)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling previous resource key credentials: %s", err))
+			}
+			d.Set("previous_credentials_json", string(previousCreds))
+		}
+	}
+
 	return nil
 }
 
@@ -455,11 +653,25 @@ func resourceIBMResourceKeyDelete(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp))
 	}
 
+	if previousKeyID := d.Get("previous_key_id").(string); previousKeyID != "" {
+		if err = deleteResourceKeyIfExists(rsContClient, previousKeyID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId("")
 
 	return nil
 }
 
+// Defaults are not applied on import, set the ones of the arguments that are
+// not read back from the key
+func resourceIBMResourceKeyImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("hmac", false)
+	d.Set("keep_previous_key", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -548,7 +760,11 @@ func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicym
 
 	roles := flex.MapRoleListToPolicyRoles(*roleList)
 
-	role, err = flex.FindRoleByName(roles, roleName)
+	if strings.HasPrefix(roleName, "crn:") {
+		role, err = flex.FindRoleByCRN(roles, roleName)
+	} else {
+		role, err = flex.FindRoleByName(roles, roleName)
+	}
 	if err != nil {
 		return iampolicymanagementv1.PolicyRole{}, err
 	}
@@ -5,6 +5,7 @@ package resourcecontroller_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -98,6 +99,101 @@ func TestAccIBMResourceKey_Parameters(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_HmacRotation(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "hmac", "true"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "cos_hmac_keys.0.access_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "cos_hmac_keys.0.secret_access_key"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous_key_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_credentials_json"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceKey_HmacRotationTwice(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	var firstKeyID, secondKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					testAccCheckIBMResourceKeySaveID("ibm_resource_key.resourceKey", &firstKeyID),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					testAccCheckIBMResourceKeyPreviousID("ibm_resource_key.resourceKey", &firstKeyID),
+					testAccCheckIBMResourceKeySaveID("ibm_resource_key.resourceKey", &secondKeyID),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_credentials_json"),
+				),
+			},
+			{
+				// The second rotation keeps the key of the first one and revokes the original key
+				Config: testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, "3", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					testAccCheckIBMResourceKeyPreviousID("ibm_resource_key.resourceKey", &secondKeyID),
+					testAccCheckIBMResourceKeyRemoved(&firstKeyID),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_credentials_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceKey_InvalidParameters(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceKeyInvalidParameters(resourceName, resourceKey),
+				ExpectError: regexp.MustCompile("Invalid value \"yes\" for parameter HMAC"),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceKey_WithCustomRole(t *testing.T) {
 	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
@@ -177,6 +273,52 @@ func testAccCheckIBMResourceKeyExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckIBMResourceKeySaveID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceKeyPreviousID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("Resource key %s was not rotated", *id)
+		}
+		if previousKeyID := rs.Primary.Attributes["previous_key_id"]; previousKeyID != *id {
+			return fmt.Errorf("Expected previous_key_id %s, got %q", *id, previousKeyID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceKeyRemoved(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		resourceKeyGet := rc.GetResourceKeyOptions{
+			ID: id,
+		}
+		key, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
+		if err == nil && *key.State != "removed" {
+			return fmt.Errorf("Resource key %s was not revoked", *id)
+		} else if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("Get resource key error: %s with resp code: %s", err, resp)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMResourceKeyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -319,3 +461,39 @@ func testAccCheckIBMResourceKeyRoleNone(resourceName, resourceKey string) string
 		}
 	`, resourceName, resourceKey)
 }
+
+func testAccCheckIBMResourceKeyHmacRotation(resourceName, resourceKey, rotationTrigger string, keepPreviousKey bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role                 = "Writer"
+			hmac                 = true
+			rotation_trigger     = "%s"
+			keep_previous_key    = %t
+		}
+	`, resourceName, resourceKey, rotationTrigger, keepPreviousKey)
+}
+
+func testAccCheckIBMResourceKeyInvalidParameters(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role                 = "Writer"
+			parameters           = {"HMAC" = "yes"}
+		}
+	`, resourceName, resourceKey)
+}
@@ -26,6 +26,16 @@ func DataSourceIbmSccLatestReports() *schema.Resource {
 				Optional:    true,
 				Description: "This field sorts results by using a valid sort field. To learn more, see [Sorting](https://cloud.ibm.com/docs/api-handbook?topic=api-handbook-sorting).",
 			},
+			"attachment_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the latest report of the profile attachment with this ID.",
+			},
+			"profile_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the latest reports of attachments of the profile with this ID.",
+			},
 			"home_account_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -360,6 +370,9 @@ func dataSourceIbmSccLatestReportsRead(context context.Context, d *schema.Resour
 	reports := []map[string]interface{}{}
 	if reportLatest.Reports != nil {
 		for _, modelItem := range reportLatest.Reports {
+			if !dataSourceIbmSccLatestReportsMatches(d, &modelItem) {
+				continue
+			}
 			modelMap, err := dataSourceIbmSccLatestReportsReportToMap(&modelItem)
 			if err != nil {
 				return diag.FromErr(err)
@@ -374,6 +387,21 @@ func dataSourceIbmSccLatestReportsRead(context context.Context, d *schema.Resour
 	return nil
 }
 
+// dataSourceIbmSccLatestReportsMatches reports whether a report belongs to the attachment and profile to filter on.
+func dataSourceIbmSccLatestReportsMatches(d *schema.ResourceData, model *securityandcompliancecenterapiv3.Report) bool {
+	if attachmentID, ok := d.GetOk("attachment_id"); ok {
+		if model.Attachment == nil || model.Attachment.ID == nil || *model.Attachment.ID != attachmentID.(string) {
+			return false
+		}
+	}
+	if profileID, ok := d.GetOk("profile_id"); ok {
+		if model.Profile == nil || model.Profile.ID == nil || *model.Profile.ID != profileID.(string) {
+			return false
+		}
+	}
+	return true
+}
+
 // dataSourceIbmSccLatestReportsID returns a reasonable ID for the list.
 func dataSourceIbmSccLatestReportsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
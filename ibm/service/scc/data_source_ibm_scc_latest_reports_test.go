@@ -27,6 +27,22 @@ func TestAccIbmSccLatestReportsDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSccLatestReportsDataSourceFilterByProfile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckScc(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSccLatestReportsDataSourceConfigFilterByProfile(acc.SccInstanceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_scc_latest_reports.scc_latest_reports_filtered", "id"),
+					resource.TestCheckResourceAttrPair("data.ibm_scc_latest_reports.scc_latest_reports_filtered", "reports.0.profile.0.id", "data.ibm_scc_latest_reports.scc_latest_reports_instance", "reports.0.profile.0.id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSccLatestReportsDataSourceConfigBasic(instanceID string) string {
 	return fmt.Sprintf(`
 		data "ibm_scc_latest_reports" "scc_latest_reports_instance" {
@@ -35,3 +51,16 @@ func testAccCheckIbmSccLatestReportsDataSourceConfigBasic(instanceID string) str
 		}
 	`, instanceID)
 }
+
+func testAccCheckIbmSccLatestReportsDataSourceConfigFilterByProfile(instanceID string) string {
+	return fmt.Sprintf(`
+		data "ibm_scc_latest_reports" "scc_latest_reports_instance" {
+			instance_id = "%s"
+		}
+
+		data "ibm_scc_latest_reports" "scc_latest_reports_filtered" {
+			instance_id = data.ibm_scc_latest_reports.scc_latest_reports_instance.instance_id
+			profile_id = data.ibm_scc_latest_reports.scc_latest_reports_instance.reports.0.profile.0.id
+		}
+	`, instanceID)
+}
@@ -116,6 +116,30 @@ func DataSourceIBMSchematicsWorkspace() *schema.Resource {
 				Computed:    true,
 				Description: "The timestamp when the last health check was performed by Schematics.",
 			},
+			"last_job": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information about the last job that ran against the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the last job.",
+						},
+						"job_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the last job.",
+						},
+						"job_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the last job.",
+						},
+					},
+				},
+			},
 			"location": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -698,6 +722,14 @@ func dataSourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Res
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	if workspaceResponse.LastJob != nil {
+		err = d.Set("last_job", dataSourceWorkspaceResponseFlattenLastJob(*workspaceResponse.LastJob))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMSchematicsWorkspaceRead failed with error: %s", err), "ibm_schematics_workspace", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	if err = d.Set("location", workspaceResponse.Location); err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMSchematicsWorkspaceRead failed with error: %s", err), "ibm_schematics_workspace", "read")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -953,6 +985,23 @@ func dataSourceWorkspaceResponseCatalogRefToMap(catalogRefItem schematicsv1.Cata
 	return catalogRefMap
 }
 
+func dataSourceWorkspaceResponseFlattenLastJob(result schematicsv1.LastJob) (lastJob []map[string]interface{}) {
+	lastJobMap := map[string]interface{}{}
+
+	if result.JobID != nil {
+		lastJobMap["job_id"] = result.JobID
+	}
+	if result.JobName != nil {
+		lastJobMap["job_name"] = result.JobName
+	}
+	if result.JobStatus != nil {
+		lastJobMap["job_status"] = result.JobStatus
+	}
+	lastJob = append(lastJob, lastJobMap)
+
+	return lastJob
+}
+
 func dataSourceWorkspaceResponseFlattenRuntimeData(result []schematicsv1.TemplateRunTimeDataResponse) (runtimeData []map[string]interface{}) {
 	for _, runtimeDataItem := range result {
 		runtimeData = append(runtimeData, dataSourceWorkspaceResponseRuntimeDataToMap(runtimeDataItem))
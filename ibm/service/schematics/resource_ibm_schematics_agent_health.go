@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -25,6 +27,11 @@ func ResourceIbmSchematicsAgentHealth() *schema.Resource {
 		DeleteContext: resourceIbmSchematicsAgentHealthDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"agent_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -114,9 +121,58 @@ func resourceIbmSchematicsAgentHealthCreate(context context.Context, d *schema.R
 
 	d.SetId(fmt.Sprintf("%s/%s", *healthCheckAgentJobOptions.AgentID, *agentHealthJob.JobID))
 
+	_, err = isWaitForAgentHealthJobComplete(context, schematicsClient, *healthCheckAgentJobOptions.AgentID, *agentHealthJob.JobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIbmSchematicsAgentHealthCreate failed with error: %s", err), "ibm_schematics_agent_health", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	return resourceIbmSchematicsAgentHealthRead(context, d, meta)
 }
 
+// The agent only reports its most recent health job, the job started by the resource is waited for
+// by its ID so that an older job of the agent is not taken for it.
+func isWaitForAgentHealthJobComplete(context context.Context, schematicsClient *schematicsv1.SchematicsV1, id string, jobID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for agent (%s) health check job (%s) to complete.", id, jobID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", agentProvisioningStatusCodeJobInProgress, agentProvisioningStatusCodeJobPending, agentProvisioningStatusCodeJobReadyToExecute, agentProvisioningStatusCodeJobStopInProgress},
+		Target:     []string{agentProvisioningStatusCodeJobFinished},
+		Refresh:    agentHealthJobRefreshFunc(schematicsClient, id, jobID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func agentHealthJobRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getAgentDataOptions := &schematicsv1.GetAgentDataOptions{
+			AgentID: core.StringPtr(id),
+			Profile: core.StringPtr("detailed"),
+		}
+
+		agent, response, err := schematicsClient.GetAgentData(getAgentDataOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Agent: %s\n%s", err, response)
+		}
+		job := agent.RecentHealthJob
+		if job == nil || job.JobID == nil || *job.JobID != jobID || job.StatusCode == nil {
+			return agent, agentProvisioningStatusCodeJobPending, nil
+		}
+		switch *job.StatusCode {
+		case agentProvisioningStatusCodeJobFailed, agentProvisioningStatusCodeJobCancelled, agentProvisioningStatusCodeJobStopped:
+			statusMessage := ""
+			if job.StatusMessage != nil {
+				statusMessage = *job.StatusMessage
+			}
+			return agent, *job.StatusCode, fmt.Errorf("[ERROR] The health check job %s of agent %s ended with status %s: %s", jobID, id, *job.StatusCode, statusMessage)
+		}
+		return agent, *job.StatusCode, nil
+	}
+}
+
 func resourceIbmSchematicsAgentHealthRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
 	if err != nil {
@@ -251,6 +307,13 @@ func resourceIbmSchematicsAgentHealthUpdate(context context.Context, d *schema.R
 			return tfErr.GetDiag()
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *healthCheckAgentJobOptions.AgentID, *agentHealthJob.JobID))
+
+		_, err = isWaitForAgentHealthJobComplete(context, schematicsClient, parts[0], *agentHealthJob.JobID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIbmSchematicsAgentHealthUpdate failed with error: %s", err), "ibm_schematics_agent_health", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 	}
 
 	return resourceIbmSchematicsAgentHealthRead(context, d, meta)
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -136,6 +138,8 @@ func ResourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Specifies the maximum allowed time for a Code Engine task to be completed. After this time elapses, the task state will changed to failed.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*[smh]$`),
+					"must be a positive number of seconds, minutes or hours, for example 30s, 10m or 1h"),
 			},
 			"updated_at": &schema.Schema{
 				Type:        schema.TypeString,
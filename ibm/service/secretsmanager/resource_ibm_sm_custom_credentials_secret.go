@@ -29,6 +29,7 @@ func ResourceIbmSmCustomCredentialsSecret() *schema.Resource {
 		UpdateContext: resourceIbmSmCustomCredentialsSecretUpdate,
 		DeleteContext: resourceIbmSmCustomCredentialsSecretDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIbmSmCustomCredentialsSecretCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"configuration": &schema.Schema{
@@ -831,6 +832,105 @@ func resourceIbmSmCustomCredentialsSecretMapToParameters(modelMap map[string]int
 	return parameters, nil
 }
 
+// Validate the parameters of the secret against the schema of its configuration at plan time, the parameters
+// that are required by the Code Engine job must be set with a value of the expected type.
+func resourceIbmSmCustomCredentialsSecretCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("configuration") || !diff.NewValueKnown("instance_id") || !diff.NewValueKnown("parameters") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("parameters") {
+		return nil
+	}
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		return err
+	}
+	region := getRegion(secretsManagerClient, diff)
+	instanceId := diff.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, diff), endpointsFile)
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+	getConfigurationOptions.SetName(diff.Get("configuration").(string))
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		// The configuration may be created in the same apply, it is validated by the API then
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return nil
+	}
+	configuration, ok := configurationIntf.(*secretsmanagerv2.CustomCredentialsConfiguration)
+	if !ok || configuration.Schema == nil {
+		return nil
+	}
+
+	parameters := map[string]interface{}{}
+	if p, ok := diff.GetOk("parameters"); ok && len(p.([]interface{})) > 0 && p.([]interface{})[0] != nil {
+		parameters = p.([]interface{})[0].(map[string]interface{})
+	}
+	var errs []string
+	for _, parameter := range configuration.Schema.Parameters {
+		if parameter.Name == nil || parameter.Format == nil {
+			continue
+		}
+		if err := customCredentialsSecretValidateParameter(*parameter.Name, *parameter.Format, parameters); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] The parameters of the secret do not match the configuration %s:\n%s", diff.Get("configuration").(string), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Validate one parameter of the secret against its format in the configuration schema, for example
+// "required:true,type:enum[small|medium|large]". The parameters are set in the map of their type.
+func customCredentialsSecretValidateParameter(name, format string, parameters map[string]interface{}) error {
+	required := false
+	paramType := "string"
+	for _, attr := range strings.Split(format, ",") {
+		kv := strings.SplitN(attr, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[0])) {
+		case "required":
+			required = strings.EqualFold(strings.TrimSpace(kv[1]), "true")
+		case "type":
+			paramType = strings.TrimSpace(kv[1])
+		}
+	}
+
+	valuesKey := "string_values"
+	switch strings.ToLower(paramType) {
+	case "integer", "int":
+		valuesKey = "integer_values"
+	case "boolean", "bool":
+		valuesKey = "boolean_values"
+	}
+	values, _ := parameters[valuesKey].(map[string]interface{})
+	value, ok := values[name]
+	if !ok {
+		if required {
+			return fmt.Errorf("the required parameter %q must be set in %s", name, valuesKey)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(paramType), "enum[") && strings.HasSuffix(paramType, "]") {
+		allowed := strings.Split(paramType[len("enum["):len(paramType)-1], "|")
+		for _, a := range allowed {
+			if strings.TrimSpace(a) == fmt.Sprintf("%v", value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("the parameter %q must be one of [%s], got %q", name, strings.Join(allowed, ", "), value)
+	}
+	return nil
+}
+
 // Convert either a map of custom credentials parameters or a map of credentials to the model map with int, bool and string values
 func customCredentialsSecretFieldsToMap(fields map[string]interface{}) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
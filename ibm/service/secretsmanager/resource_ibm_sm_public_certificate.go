@@ -26,6 +26,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
@@ -36,6 +37,7 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 		UpdateContext: resourceIbmSmPublicCertificateUpdate,
 		DeleteContext: resourceIbmSmPublicCertificateDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIbmSmPublicCertificateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"secret_type": &schema.Schema{
@@ -215,6 +217,29 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 					},
 				},
 			},
+			"cis": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Cloud Internet Services domain where the provider creates the DNS challenge records, `dns` must be set to `manual`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cis_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the Cloud Internet Services instance.",
+						},
+						"zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the domain in the Cloud Internet Services instance.",
+						},
+					},
+				},
+			},
 			"created_by": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -708,6 +733,13 @@ func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.Resou
 		}
 		return resourceIbmSmPublicCertificateRead(context, d, meta)
 	}
+	if _, ok := d.GetOk("cis"); ok && d.Get("state_description").(string) == "pre_activation" {
+		err := setChallengesWithCisAndValidateManualDns(context, d, meta, secret, secretsManagerClient)
+		if err != nil {
+			return err
+		}
+		return resourceIbmSmPublicCertificateRead(context, d, meta)
+	}
 	return nil
 }
 
@@ -828,6 +860,18 @@ func resourceIbmSmPublicCertificateDelete(context context.Context, d *schema.Res
 	return nil
 }
 
+// The DNS challenge records are created in Cloud Internet Services when the cis block is set, the
+// certificate is then ordered with manual DNS validation.
+func resourceIbmSmPublicCertificateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("cis"); !ok || !diff.NewValueKnown("dns") {
+		return nil
+	}
+	if dns := diff.Get("dns").(string); dns != "manual" {
+		return fmt.Errorf("`dns` must be set to `manual` when the `cis` block is set, got %q", dns)
+	}
+	return nil
+}
+
 func resourceIbmSmPublicCertificateMapToSecretPrototype(d *schema.ResourceData) (secretsmanagerv2.SecretPrototypeIntf, error) {
 	model := &secretsmanagerv2.PublicCertificatePrototype{}
 	model.SecretType = core.StringPtr("public_cert")
@@ -1154,6 +1198,83 @@ func setChallengesWithAkamaiAndValidateManualDns(context context.Context, d *sch
 	return validateManualDns(context, d, secretsManagerClient)
 }
 
+// Create the DNS challenge records in Cloud Internet Services and validate them. The records are only
+// needed until the certificate is issued, they are deleted once the validation completes or fails.
+func setChallengesWithCisAndValidateManualDns(context context.Context, d *schema.ResourceData, meta interface{}, secret *secretsmanagerv2.PublicCertificate, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) diag.Diagnostics {
+	if secret.IssuanceInfo == nil || len(secret.IssuanceInfo.Challenges) == 0 {
+		resourceIbmSmPublicCertificateDelete(context, d, meta)
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("failed to find the DNS challenges of the certificate: %s", d.Get("common_name").(string)), PublicCertSecretResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	cisData := d.Get("cis").([]interface{})[0].(map[string]interface{})
+
+	sess, err := newSmCisDNSRecordsClient(meta, cisData["cis_crn"].(string), cisData["zone_id"].(string))
+	if err != nil {
+		resourceIbmSmPublicCertificateDelete(context, d, meta)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("error creating the CIS DNS records client: %s", err), PublicCertSecretResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	var recordIds []string
+	defer func() {
+		for _, recordId := range recordIds {
+			_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(recordId))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[WARN] Error deleting the DNS challenge record %s in CIS: %s\n%s", recordId, err, response)
+			}
+		}
+	}()
+
+	ttl := int64(120)
+	createdRecords := make(map[string]bool)
+	for _, challengeItem := range secret.IssuanceInfo.Challenges {
+		if challengeItem.TxtRecordName == nil || challengeItem.TxtRecordValue == nil {
+			continue
+		}
+		// A wildcard domain and its base domain share the same record name, with a value per challenge
+		txtRecordName := strings.TrimSuffix(*challengeItem.TxtRecordName, ".")
+		if createdRecords[txtRecordName+" "+*challengeItem.TxtRecordValue] {
+			continue
+		}
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetType("TXT")
+		opt.SetName(txtRecordName)
+		opt.SetContent(*challengeItem.TxtRecordValue)
+		opt.SetTTL(ttl)
+		result, response, err := sess.CreateDnsRecord(opt)
+		if err != nil || result == nil || result.Result == nil || result.Result.ID == nil {
+			resourceIbmSmPublicCertificateDelete(context, d, meta)
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("error creating the DNS challenge record %s in CIS: %s\n%s", txtRecordName, err, response), PublicCertSecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
+		recordIds = append(recordIds, *result.Result.ID)
+		createdRecords[txtRecordName+" "+*challengeItem.TxtRecordValue] = true
+	}
+
+	return validateManualDns(context, d, secretsManagerClient)
+}
+
+// newSmCisDNSRecordsClient builds a CIS DNS records client for the zone of the certificate, the
+// client of the provider session is only used for its endpoint, authenticator and HTTP settings.
+func newSmCisDNSRecordsClient(meta interface{}, cisCrn, zoneId string) (*dnsrecordsv1.DnsRecordsV1, error) {
+	sessionClient, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	client, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            sessionClient.Service.GetServiceURL(),
+		Authenticator:  sessionClient.Service.Options.Authenticator,
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: core.StringPtr(zoneId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client.Service.SetDefaultHeaders(sessionClient.Service.DefaultHeaders)
+	client.Service.SetHTTPClient(sessionClient.Service.GetHTTPClient())
+	return client, nil
+}
+
 func configureAkamai(d *schema.ResourceData) (edgegrid.Config, diag.Diagnostics) {
 	var config edgegrid.Config
 	var err error
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretRotationCreate,
+		ReadContext:   resourceIbmSmSecretRotationRead,
+		UpdateContext: resourceIbmSmSecretRotationUpdate,
+		DeleteContext: resourceIbmSmSecretRotationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A UUID identifier of the secret to rotate.",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that rotate the secret again when they change.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"version_custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The secret version metadata that a user can customize.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A UUID identifier of the secret version created by the rotation.",
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state after the rotation.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceIbmSmSecretRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretId := d.Get("secret_id").(string)
+
+	// A version without a payload rotates the secret, the new credentials are generated by
+	// Secrets Manager. The secret types with a payload provided by the user cannot be rotated so.
+	versionModel := &secretsmanagerv2.SecretVersionPrototype{}
+	if _, ok := d.GetOk("version_custom_metadata"); ok {
+		versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	}
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionModel)
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionWithContext failed %s\n%s", err, response), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	version, err := smModelToMap(secretVersionIntf)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error reading the new version of the secret %s: %s", secretId, err), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	versionId, _ := version["id"].(string)
+	if versionId == "" {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("The rotation of the secret %s did not return the ID of the new version", secretId), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, versionId))
+	d.Set("version_id", versionId)
+
+	secret, err := waitForIbmSmSecretRotation(context, secretsManagerClient, d, secretId, versionId)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for the version %s of the secret %s to become active: %s", versionId, secretId, err), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	d.Set("secret_type", secret["secret_type"])
+	d.Set("state_description", secret["state_description"])
+
+	return nil
+}
+
+// Wait for the version created by the rotation to become the current version of an active secret,
+// the rotation of certificates is asynchronous and the previous version stays current meanwhile.
+func waitForIbmSmSecretRotation(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, secretId, versionId string) (map[string]interface{}, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID("current")
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"rotating"},
+		Target:  []string{"rotated"},
+		Refresh: func() (interface{}, string, error) {
+			versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			}
+			version, err := smModelToMap(versionMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if id, _ := version["id"].(string); id != versionId {
+				return version, "rotating", nil
+			}
+
+			secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+			}
+			secret, err := smModelToMap(secretMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			state, _ := secret["state_description"].(string)
+			failStates := map[string]bool{"suspended": true, "deactivated": true, "destroyed": true}
+			if failStates[state] {
+				return secret, state, fmt.Errorf("The secret %s is %s", secretId, state)
+			}
+			if state != "active" {
+				return secret, "rotating", nil
+			}
+			return secret, "rotated", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	secret, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return secret.(map[string]interface{}), nil
+}
+
+func resourceIbmSmSecretRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 4 {
+		tfErr := flex.TerraformErrorf(nil, "Wrong format of resource ID. To import the rotation of a secret use the format `<region>/<instance_id>/<secret_id>/<version_id>`", SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretMetadataWithContext failed %s\n%s", err, response), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secret, err := smModelToMap(secretMetadataIntf)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	// The older versions of a secret are not kept, the rotation stays in the state after its version
+	// is replaced by the next one.
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("version_id", versionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("secret_type", secret["secret_type"]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("state_description", secret["state_description"]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state_description: %s", err))
+	}
+
+	return nil
+}
+
+// Only endpoint_type can change without rotating the secret again, it is only used by the calls of
+// the provider, so the rotation is read again with the new endpoint.
+func resourceIbmSmSecretRotationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIbmSmSecretRotationRead(context, d, meta)
+}
+
+func resourceIbmSmSecretRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The versions of a secret cannot be deleted, the rotation is only removed from the state.
+	d.SetId("")
+	return nil
+}
+
+// Convert a model of any secret type to a map of its JSON fields.
+func smModelToMap(model interface{}) (map[string]interface{}, error) {
+	modelJSON, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	modelMap := map[string]interface{}{}
+	if err = json.Unmarshal(modelJSON, &modelMap); err != nil {
+		return nil, err
+	}
+	return modelMap, nil
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+var secretRotationSecretName = "terraform-test-rotation-secret"
+
+func TestAccIbmSmSecretRotationBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_rotation.sm_secret_rotation"
+	var firstVersionId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmUsernamePasswordSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretRotationConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "username_password"),
+					resource.TestCheckResourceAttr(resourceName, "state_description", "active"),
+					testAccCheckIbmSmSecretRotationVersionId(resourceName, &firstVersionId, false),
+				),
+			},
+			resource.TestStep{
+				Config: secretRotationConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+					testAccCheckIbmSmSecretRotationVersionId(resourceName, &firstVersionId, true),
+				),
+			},
+		},
+	})
+}
+
+// Save the version_id of the first rotation, or check that the next rotation created another version.
+func testAccCheckIbmSmSecretRotationVersionId(n string, versionId *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		current := rs.Primary.Attributes["version_id"]
+		if !changed {
+			*versionId = current
+			return nil
+		}
+		if current == *versionId {
+			return fmt.Errorf("The secret was not rotated again, the version is still %s", current)
+		}
+		return nil
+	}
+}
+
+func secretRotationConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_rotated" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "%s"
+			username = "%s"
+		}
+
+		resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret_rotated.secret_id
+			triggers = {
+				rotation = "%s"
+			}
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretRotationSecretName, username,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, trigger)
+}
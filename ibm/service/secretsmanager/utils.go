@@ -52,13 +52,21 @@ const (
 	PublicCertConfigDnsCISResourceName                   = "ibm_sm_public_certificate_configuration_dns_cis"
 	PublicCertConfigDnsClassicInfrastructureResourceName = "ibm_sm_public_certificate_configuration_dns_classic_infrastructure"
 	PublicCertConfigActionValidateManualDNSResourceName  = "ibm_sm_public_certificate_action_validate_manual_dns"
+	SecretRotationResourceName                           = "ibm_sm_secret_rotation"
 
 	SecretGroupResourceName  = "ibm_sm_secret_group"
 	SecretGroupsResourceName = "ibm_sm_secret_groups"
 	SecretsResourceName      = "ibm_sm_secrets"
 )
 
-func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
+// schemaGetter reads the configuration of a resource, from a schema.ResourceData or, at plan time,
+// from a schema.ResourceDiff
+type schemaGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d schemaGetter) string {
 	_, ok := d.GetOk("region")
 	if ok {
 		return d.Get("region").(string)
@@ -72,7 +80,7 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 }
 
 // Clone the base secrets manager client and set the API endpoint per the instance
-func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
+func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d schemaGetter) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+func DataSourceIBMBillingUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"month": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}$`), "must be in the format yyyy-mm"),
+				Description:  "The billing month for which the usage is requested. Format is yyyy-mm.",
+			},
+			"resource_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the usage of this resource group.",
+			},
+			"resource_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the usage of this service, for example `cloud-object-storage`.",
+			},
+			"budget": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Spending limit of the month, compared with the billable cost of the reported usage.",
+			},
+			"currency_code": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency of the costs.",
+			},
+			"billable_cost": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total billable cost of the reported usage.",
+			},
+			"non_billable_cost": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total non-billable cost of the reported usage.",
+			},
+			"budget_used_percentage": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Billable cost as a percentage of the budget, when a budget is set.",
+			},
+			"over_budget": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the billable cost exceeds the budget, when a budget is set.",
+			},
+			"resources": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage of each service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service.",
+						},
+						"resource_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service.",
+						},
+						"billable_cost": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable cost of the service.",
+						},
+						"non_billable_cost": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The non-billable cost of the service.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_billing_usage", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_billing_usage", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	month := d.Get("month").(string)
+	var currencyCode *string
+	var usageResources []usagereportsv4.Resource
+	if resourceGroupID, ok := d.GetOk("resource_group_id"); ok {
+		getResourceGroupUsageOptions := &usagereportsv4.GetResourceGroupUsageOptions{}
+		getResourceGroupUsageOptions.SetAccountID(userDetails.UserAccount)
+		getResourceGroupUsageOptions.SetResourceGroupID(resourceGroupID.(string))
+		getResourceGroupUsageOptions.SetBillingmonth(month)
+		getResourceGroupUsageOptions.SetNames(true)
+
+		resourceGroupUsage, _, err := usageReportsClient.GetResourceGroupUsageWithContext(context, getResourceGroupUsageOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetResourceGroupUsageWithContext failed: %s", err.Error()), "(Data) ibm_billing_usage", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		currencyCode = resourceGroupUsage.CurrencyCode
+		usageResources = resourceGroupUsage.Resources
+	} else {
+		getAccountUsageOptions := &usagereportsv4.GetAccountUsageOptions{}
+		getAccountUsageOptions.SetAccountID(userDetails.UserAccount)
+		getAccountUsageOptions.SetBillingmonth(month)
+		getAccountUsageOptions.SetNames(true)
+
+		accountUsage, _, err := usageReportsClient.GetAccountUsageWithContext(context, getAccountUsageOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetAccountUsageWithContext failed: %s", err.Error()), "(Data) ibm_billing_usage", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		currencyCode = accountUsage.CurrencyCode
+		usageResources = accountUsage.Resources
+	}
+
+	resourceID := d.Get("resource_id").(string)
+	var billableCost, nonBillableCost float64
+	resources := []map[string]interface{}{}
+	for _, usageResource := range usageResources {
+		if resourceID != "" && flex.StringValue(usageResource.ResourceID) != resourceID {
+			continue
+		}
+		modelMap := dataSourceIBMBillingUsageResourceToMap(usageResource)
+		billableCost += modelMap["billable_cost"].(float64)
+		nonBillableCost += modelMap["non_billable_cost"].(float64)
+		resources = append(resources, modelMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", userDetails.UserAccount, month, d.Get("resource_group_id").(string), resourceID))
+
+	if err = d.Set("currency_code", flex.StringValue(currencyCode)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting currency_code: %s", err), "(Data) ibm_billing_usage", "read", "set-currency_code").GetDiag()
+	}
+	if err = d.Set("billable_cost", billableCost); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting billable_cost: %s", err), "(Data) ibm_billing_usage", "read", "set-billable_cost").GetDiag()
+	}
+	if err = d.Set("non_billable_cost", nonBillableCost); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting non_billable_cost: %s", err), "(Data) ibm_billing_usage", "read", "set-non_billable_cost").GetDiag()
+	}
+	if err = d.Set("resources", resources); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resources: %s", err), "(Data) ibm_billing_usage", "read", "set-resources").GetDiag()
+	}
+	if budget, ok := d.GetOk("budget"); ok && budget.(float64) > 0 {
+		d.Set("budget_used_percentage", billableCost*100/budget.(float64))
+		d.Set("over_budget", billableCost > budget.(float64))
+	}
+
+	return nil
+}
+
+func dataSourceIBMBillingUsageResourceToMap(model usagereportsv4.Resource) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["resource_id"] = flex.StringValue(model.ResourceID)
+	modelMap["resource_name"] = flex.StringValue(model.ResourceName)
+	modelMap["billable_cost"] = float64(0)
+	if model.BillableCost != nil {
+		modelMap["billable_cost"] = *model.BillableCost
+	}
+	modelMap["non_billable_cost"] = float64(0)
+	if model.NonBillableCost != nil {
+		modelMap["non_billable_cost"] = *model.NonBillableCost
+	}
+	return modelMap
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMBillingUsageDataSourceBasic(t *testing.T) {
+	month := acc.Snapshot_month
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckUsage(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMBillingUsageDataSourceConfigBasic(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "billable_cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "budget_used_percentage"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "over_budget"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMBillingUsageDataSourceService(t *testing.T) {
+	month := acc.Snapshot_month
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckUsage(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMBillingUsageDataSourceConfigService(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.billing_usage_instance", "id"),
+					resource.TestCheckResourceAttr("data.ibm_billing_usage.billing_usage_instance", "resource_id", "cloud-object-storage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingUsageDataSourceConfigBasic(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_billing_usage" "billing_usage_instance" {
+			month  = "%s"
+			budget = 1000
+		}
+	`, month)
+}
+
+func testAccCheckIBMBillingUsageDataSourceConfigService(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "group" {
+			is_default = true
+		}
+		data "ibm_billing_usage" "billing_usage_instance" {
+			month             = "%s"
+			resource_group_id = data.ibm_resource_group.group.id
+			resource_id       = "cloud-object-storage"
+		}
+	`, month)
+}
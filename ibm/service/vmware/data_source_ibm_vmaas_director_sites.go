@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+func DataSourceIbmVmaasDirectorSites() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmVmaasDirectorSitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the director site with this name.",
+			},
+			"accept_language": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Language.",
+			},
+			"director_sites": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Cloud Director site instances of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Cloud Director site.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Cloud Director site.",
+						},
+						"crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the Cloud Director site.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the Cloud Director site.",
+						},
+						"pvdcs": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resource pools (provider virtual data centers) of the Cloud Director site.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the resource pool, used in `ibm_vmaas_vdc.director_site.pvdc.id`.",
+									},
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the resource pool.",
+									},
+									"data_center_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Data center location of the resource pool.",
+									},
+									"status": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status of the resource pool.",
+									},
+									"clusters": &schema.Schema{
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The clusters of the resource pool.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": &schema.Schema{
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "ID of the cluster.",
+												},
+												"name": &schema.Schema{
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Name of the cluster.",
+												},
+												"host_count": &schema.Schema{
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "Number of hosts in the cluster.",
+												},
+												"host_profile": &schema.Schema{
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The host profile of the cluster.",
+												},
+												"status": &schema.Schema{
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The status of the cluster.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmVmaasDirectorSitesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareClient, err := meta.(conns.ClientSession).VmwareV1()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_vmaas_director_sites", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	listDirectorSitesOptions := &vmwarev1.ListDirectorSitesOptions{}
+	if _, ok := d.GetOk("accept_language"); ok {
+		listDirectorSitesOptions.SetAcceptLanguage(d.Get("accept_language").(string))
+	}
+
+	directorSiteCollection, _, err := vmwareClient.ListDirectorSitesWithContext(context, listDirectorSitesOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListDirectorSitesWithContext failed: %s", err.Error()), "(Data) ibm_vmaas_director_sites", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	name := d.Get("name").(string)
+	directorSites := []map[string]interface{}{}
+	for _, directorSite := range directorSiteCollection.DirectorSites {
+		if name != "" && (directorSite.Name == nil || *directorSite.Name != name) {
+			continue
+		}
+		directorSites = append(directorSites, dataSourceIbmVmaasDirectorSitesDirectorSiteToMap(directorSite))
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err = d.Set("director_sites", directorSites); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting director_sites: %s", err), "(Data) ibm_vmaas_director_sites", "read", "set-director_sites").GetDiag()
+	}
+
+	return nil
+}
+
+func dataSourceIbmVmaasDirectorSitesDirectorSiteToMap(model vmwarev1.DirectorSite) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["id"] = flex.StringValue(model.ID)
+	modelMap["name"] = flex.StringValue(model.Name)
+	modelMap["crn"] = flex.StringValue(model.Crn)
+	modelMap["status"] = flex.StringValue(model.Status)
+	pvdcs := []map[string]interface{}{}
+	for _, pvdc := range model.Pvdcs {
+		pvdcMap := make(map[string]interface{})
+		pvdcMap["id"] = flex.StringValue(pvdc.ID)
+		pvdcMap["name"] = flex.StringValue(pvdc.Name)
+		pvdcMap["data_center_name"] = flex.StringValue(pvdc.DataCenterName)
+		pvdcMap["status"] = flex.StringValue(pvdc.Status)
+		clusters := []map[string]interface{}{}
+		for _, cluster := range pvdc.Clusters {
+			clusterMap := make(map[string]interface{})
+			clusterMap["id"] = flex.StringValue(cluster.ID)
+			clusterMap["name"] = flex.StringValue(cluster.Name)
+			clusterMap["host_count"] = flex.IntValue(cluster.HostCount)
+			clusterMap["host_profile"] = flex.StringValue(cluster.HostProfile)
+			clusterMap["status"] = flex.StringValue(cluster.Status)
+			clusters = append(clusters, clusterMap)
+		}
+		pvdcMap["clusters"] = clusters
+		pvdcs = append(pvdcs, pvdcMap)
+	}
+	modelMap["pvdcs"] = pvdcs
+	return modelMap
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmVmaasDirectorSitesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckVMwareService(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmVmaasDirectorSitesDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_vmaas_director_sites.vmaas_director_sites_instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_vmaas_director_sites.vmaas_director_sites_instance", "director_sites.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_vmaas_director_sites.vmaas_director_sites_instance", "director_sites.*", map[string]string{
+						"id": acc.Vmaas_Directorsite_id,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmVmaasDirectorSitesDataSourceConfigBasic() string {
+	return `
+		data "ibm_vmaas_director_sites" "vmaas_director_sites_instance" {
+		}
+	`
+}
@@ -68,6 +68,13 @@ func DataSourceIBMISImages() *schema.Resource {
 				Description: "Filters the collection to images with a user_data_format property matching one of the specified comma-separated values.",
 			},
 
+			"exclude_deprecated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Excludes the images that are deprecated or obsolete, or whose deprecation date and time has passed",
+			},
+
 			isImages: {
 				Type:        schema.TypeList,
 				Description: "List of images",
@@ -118,6 +125,16 @@ func DataSourceIBMISImages() *schema.Resource {
 							Computed:    true,
 							Description: "Whether the image is publicly visible or private to the account",
 						},
+						isImageDeprecationAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
+						},
+						isImageObsolescenceAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
+						},
 						"operating_system": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -481,6 +498,20 @@ func imageList(context context.Context, d *schema.ResourceData, meta interface{}
 		allrecs = allrecsTemp
 	}
 
+	if d.Get("exclude_deprecated").(bool) {
+		allrecsTemp := []vpcv1.Image{}
+		for _, image := range allrecs {
+			if *image.Status == "deprecated" || *image.Status == "obsolete" {
+				continue
+			}
+			if image.DeprecationAt != nil && !time.Time(*image.DeprecationAt).After(time.Now()) {
+				continue
+			}
+			allrecsTemp = append(allrecsTemp, image)
+		}
+		allrecs = allrecsTemp
+	}
+
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
 
@@ -496,6 +527,12 @@ func imageList(context context.Context, d *schema.ResourceData, meta interface{}
 		if image.UserDataFormat != nil {
 			l["user_data_format"] = *image.UserDataFormat
 		}
+		if image.DeprecationAt != nil {
+			l[isImageDeprecationAt] = flex.DateTimeToString(image.DeprecationAt)
+		}
+		if image.ObsolescenceAt != nil {
+			l[isImageObsolescenceAt] = flex.DateTimeToString(image.ObsolescenceAt)
+		}
 		if len(image.StatusReasons) > 0 {
 			l["status_reasons"] = dataSourceIBMIsImageFlattenStatusReasons(image.StatusReasons)
 		}
@@ -31,6 +31,23 @@ func TestAccIBMISImagesDataSource_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImagesDataSource_excludeDeprecated(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceExcludeDeprecatedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "images.0.name"),
+					resource.TestCheckResourceAttr(resName, "images.0.status", "available"),
+				),
+			},
+		},
+	})
+}
 func TestAccIBMISImagesDataSource_All(t *testing.T) {
 	resName := "data.ibm_is_images.test1"
 	imageName := fmt.Sprintf("tfimage-name-%d", acctest.RandIntRange(10, 100))
@@ -143,6 +160,13 @@ func testAccCheckIBMISImagesDataSourceConfig() string {
       data "ibm_is_images" "test1" {
       }`)
 }
+func testAccCheckIBMISImagesDataSourceExcludeDeprecatedConfig() string {
+	return fmt.Sprintf(`
+      data "ibm_is_images" "test1" {
+        visibility         = "public"
+        exclude_deprecated = true
+      }`)
+}
 func testAccCheckIBMISImagesDataSourceAllConfig(imageName string) string {
 	return fmt.Sprintf(`
 	data "ibm_is_images" "test1" {
@@ -7,21 +7,27 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	isInstances         = "instances"
 	isInstanceGroupName = "instance_group_name"
+
+	isInstancesSearchLimit = 1000
 )
 
 func DataSourceIBMISInstances() *schema.Resource {
-	return &schema.Resource{
+	dataSource := &schema.Resource{
 		ReadContext: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -109,6 +115,33 @@ func DataSourceIBMISInstances() *schema.Resource {
 				Description:   "ID of the placement group to filter the instances attached to it",
 			},
 
+			"zone_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the zone to filter the instances in it",
+			},
+
+			"lifecycle_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"deleting", "failed", "pending", "stable", "suspended", "updating", "waiting"}, false),
+				Description:  "Lifecycle state to filter the instances",
+			},
+
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User tag to filter the instances attached to it",
+			},
+
+			"fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The attributes of the instances to read, the id, crn and name are always read. All the attributes are read by default",
+			},
+
 			isInstances: {
 				Type:        schema.TypeList,
 				Description: "List of instances",
@@ -1273,6 +1306,18 @@ func DataSourceIBMISInstances() *schema.Resource {
 			},
 		},
 	}
+
+	// fields takes the names of the attributes of the instances
+	instanceFields := []string{}
+	for field := range dataSource.Schema[isInstances].Elem.(*schema.Resource).Schema {
+		instanceFields = append(instanceFields, field)
+	}
+	sort.Strings(instanceFields)
+	dataSource.Schema["fields"].Elem = &schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice(instanceFields, false),
+	}
+	return dataSource
 }
 
 func dataSourceIBMISInstancesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1462,6 +1507,53 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 		allrecs = allrecs[:i]
 	}
 
+	// The list instances API has no zone.name, lifecycle_state or tag query parameters, so the
+	// instances are filtered here, before the tags and network interfaces of each instance are read.
+	zoneName := d.Get("zone_name").(string)
+	lifecycleState := d.Get("lifecycle_state").(string)
+	if zoneName != "" || lifecycleState != "" {
+		i := 0
+		for _, ins := range allrecs {
+			if zoneName != "" && (ins.Zone == nil || ins.Zone.Name == nil || *ins.Zone.Name != zoneName) {
+				continue
+			}
+			if lifecycleState != "" && (ins.LifecycleState == nil || *ins.LifecycleState != lifecycleState) {
+				continue
+			}
+			allrecs[i] = ins
+			i++
+		}
+		allrecs = allrecs[:i]
+	}
+
+	// The instances with the tag are found with a single search. Global Search indexes new
+	// instances and tag changes with a delay, they are only filtered by the tag once indexed.
+	if tag := d.Get("tag").(string); tag != "" {
+		taggedCRNs, err := instancesCRNsWithTag(meta, tag)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Search failed %s", err), "(Data) ibm_is_instances", "read")
+			log.Printf("[DEBUG] %s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		i := 0
+		for _, ins := range allrecs {
+			if ins.CRN == nil {
+				continue
+			}
+			if !taggedCRNs[*ins.CRN] {
+				continue
+			}
+			allrecs[i] = ins
+			i++
+		}
+		allrecs = allrecs[:i]
+	}
+
+	fields := d.Get("fields").(*schema.Set)
+	wants := func(field string) bool {
+		return fields.Len() == 0 || fields.Contains(field)
+	}
+
 	instancesInfo := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
 		id := *instance.ID
@@ -1567,19 +1659,23 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 			bootVolList = append(bootVolList, bootVol)
 			l["boot_volume"] = bootVolList
 		}
-		tags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceUserTagType)
-		if err != nil {
-			log.Printf(
-				"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
+		if wants(isInstanceTags) {
+			tags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceUserTagType)
+			if err != nil {
+				log.Printf(
+					"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
+			}
+			l[isInstanceTags] = tags
 		}
-		l[isInstanceTags] = tags
 
-		accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on get of resource vpc Instance (%s) access tags: %s", d.Id(), err)
+		if wants(isInstanceAccessTags) {
+			accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
+			if err != nil {
+				log.Printf(
+					"Error on get of resource vpc Instance (%s) access tags: %s", d.Id(), err)
+			}
+			l[isInstanceAccessTags] = accesstags
 		}
-		l[isInstanceAccessTags] = accesstags
 		//set the status reasons
 		statusReasonsList := make([]map[string]interface{}, 0)
 		if instance.StatusReasons != nil {
@@ -1613,7 +1709,7 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 			l["volume_attachments"] = volList
 		}
 
-		if instance.PrimaryNetworkInterface != nil {
+		if instance.PrimaryNetworkInterface != nil && wants("primary_network_interface") {
 			primaryNicList := make([]map[string]interface{}, 0)
 			currentPrimNic := map[string]interface{}{}
 			currentPrimNic["id"] = *instance.PrimaryNetworkInterface.ID
@@ -1675,7 +1771,7 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 		}
 		l["primary_network_attachment"] = primaryNetworkAttachment
 
-		if instance.NetworkInterfaces != nil {
+		if instance.NetworkInterfaces != nil && wants("network_interfaces") {
 			interfacesList := make([]map[string]interface{}, 0)
 			for _, intfc := range instance.NetworkInterfaces {
 				if *intfc.ID != *instance.PrimaryNetworkInterface.ID {
@@ -1837,6 +1933,13 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 			l[isInstanceReservation] = resList
 		}
 
+		if fields.Len() != 0 {
+			for field := range l {
+				if field != "id" && field != "crn" && field != "name" && !fields.Contains(field) {
+					delete(l, field)
+				}
+			}
+		}
 		instancesInfo = append(instancesInfo, l)
 	}
 	d.SetId(dataSourceIBMISInstancesID(d))
@@ -1898,3 +2001,33 @@ func dataSourceInstancesCollectionHealthReasonsToMap(statusReasonsItem vpcv1.Ins
 
 	return healthReasonsMap
 }
+
+// instancesCRNsWithTag returns the CRNs of the instances with the user tag, found with the Global
+// Search API.
+func instancesCRNsWithTag(meta interface{}, tag string) (map[string]bool, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+	options := globalsearchv2.SearchOptions{}
+	options.SetQuery(fmt.Sprintf("family:is AND type:instance AND tags:%q", tag))
+	options.SetFields([]string{"crn"})
+	options.SetLimit(isInstancesSearchLimit)
+
+	crns := map[string]bool{}
+	for {
+		result, resp, err := gsClient.Search(&options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error searching the instances with the tag %s: %s %s", tag, err, resp)
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil {
+				crns[*item.CRN] = true
+			}
+		}
+		if result.SearchCursor == nil || len(result.Items) < isInstancesSearchLimit {
+			return crns, nil
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+}
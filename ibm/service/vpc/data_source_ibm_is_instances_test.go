@@ -310,6 +310,44 @@ func TestAccIBMISInstancesDataSource_InsGroupfilter(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstancesDataSource_zoneFieldsFilter(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("tfins-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfins-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tfins-ssh-%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tfins-name-%d", acctest.RandIntRange(10, 100))
+	resName := "data.ibm_is_instances.ds_instances1"
+	userData := "a"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, instanceName, userData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "name", instanceName),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancesDataSourceZoneFieldsConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr(resName, "instances.0.zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr(resName, "instances.0.network_interfaces.#", "0"),
+					resource.TestCheckResourceAttr(resName, "instances.0.tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstancesDataSourceConfig() string {
 	return fmt.Sprintf(`
 	data "ibm_is_instances" "ds_instances" {
@@ -336,6 +374,15 @@ func testAccCheckIBMISInstancesDataSourceConfig1(vpcname string) string {
 		vpc_name = "%s"
 	}`, vpcname)
 }
+func testAccCheckIBMISInstancesDataSourceZoneFieldsConfig(vpcname string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_instances" "ds_instances1" {
+		vpc_name        = "%s"
+		zone_name       = "%s"
+		lifecycle_state = "stable"
+		fields          = ["zone", "status", "vpc"]
+	}`, vpcname, acc.ISZoneName)
+}
 func testAccCheckIBMISInstancesDataSourceConfigInstanceGroup(insGrpName string) string {
 	return fmt.Sprintf(`
 	data "ibm_is_instances" "ds_instances1" {
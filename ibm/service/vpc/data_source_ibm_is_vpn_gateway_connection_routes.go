@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsVPNGatewayConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNGatewayConnectionRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier, the routes of all the routing tables of the VPC are listed.",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway connection identifier.",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the VPC whose next hop is the VPN gateway connection, the routes learned from the peer are included.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routing_table": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the routing table of the route.",
+						},
+						"route_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this route.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this route.",
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination CIDR of the route.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the route applies to.",
+						},
+						"origin": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The origin of this route, `learned` for the routes learned from the peer of the connection.",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the route, `stable` once the route is propagated.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority of this route.",
+						},
+						"advertise": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNGatewayConnectionRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_connection_routes", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID := d.Get("vpc").(string)
+	connectionID := d.Get("vpn_gateway_connection").(string)
+
+	start := ""
+	routingTables := []vpcv1.RoutingTable{}
+	for {
+		listOptions := &vpcv1.ListVPCRoutingTablesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		result, _, err := sess.ListVPCRoutingTablesWithContext(context, listOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTablesWithContext failed: %s", err.Error()), "(Data) ibm_is_vpn_gateway_connection_routes", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		start = flex.GetNext(result.Next)
+		routingTables = append(routingTables, result.RoutingTables...)
+		if start == "" {
+			break
+		}
+	}
+
+	routes := make([]map[string]interface{}, 0)
+	for _, routingTable := range routingTables {
+		start = ""
+		for {
+			listVpcRoutingTablesRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, *routingTable.ID)
+			if start != "" {
+				listVpcRoutingTablesRoutesOptions.Start = &start
+			}
+			result, _, err := sess.ListVPCRoutingTableRoutesWithContext(context, listVpcRoutingTablesRoutesOptions)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "(Data) ibm_is_vpn_gateway_connection_routes", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			for _, instance := range result.Routes {
+				// The routes learned from the peer and the static routes to the connection have the
+				// connection as next hop, the routes to an address are not related to it.
+				if instance.NextHop == nil {
+					continue
+				}
+				nexthop, ok := instance.NextHop.(*vpcv1.RouteNextHop)
+				if !ok || nexthop.ID == nil || *nexthop.ID != connectionID {
+					continue
+				}
+				route := map[string]interface{}{
+					"routing_table": *routingTable.ID,
+				}
+				if instance.ID != nil {
+					route["route_id"] = *instance.ID
+				}
+				if instance.Name != nil {
+					route["name"] = *instance.Name
+				}
+				if instance.Destination != nil {
+					route["destination"] = *instance.Destination
+				}
+				if instance.Zone != nil && instance.Zone.Name != nil {
+					route["zone"] = *instance.Zone.Name
+				}
+				if instance.Origin != nil {
+					route["origin"] = *instance.Origin
+				}
+				if instance.LifecycleState != nil {
+					route["lifecycle_state"] = *instance.LifecycleState
+				}
+				if instance.Priority != nil {
+					route["priority"] = *instance.Priority
+				}
+				if instance.Advertise != nil {
+					route["advertise"] = *instance.Advertise
+				}
+				routes = append(routes, route)
+			}
+			start = flex.GetNext(result.Next)
+			if start == "" {
+				break
+			}
+		}
+	}
+
+	d.SetId(connectionID)
+	if err = d.Set("routes", routes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routes: %s", err), "(Data) ibm_is_vpn_gateway_connection_routes", "read", "set-routes").GetDiag()
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVpnGatewayConnectionRoutesDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_vpn_gateway_connection_routes.test1"
+	vpcname := fmt.Sprintf("tfvpnuat-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpnuat-subnet-%d", acctest.RandIntRange(100, 200))
+	vpngwname := fmt.Sprintf("tfvpnuat-vpngw-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpnuat-createname-%d", acctest.RandIntRange(100, 200))
+	routename := fmt.Sprintf("tfvpnuat-route-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVpnGatewayConnectionRoutesDataSourceConfig(vpcname, subnetname, vpngwname, name, routename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "id"),
+					resource.TestCheckResourceAttr(node, "routes.#", "1"),
+					resource.TestCheckResourceAttrPair(node, "routes.0.routing_table", "ibm_is_vpc.testacc_vpc", "default_routing_table"),
+					resource.TestCheckResourceAttrPair(node, "routes.0.route_id", "ibm_is_vpc_routing_table_route.testacc_route", "route_id"),
+					resource.TestCheckResourceAttr(node, "routes.0.name", routename),
+					resource.TestCheckResourceAttr(node, "routes.0.destination", "192.168.100.0/24"),
+					resource.TestCheckResourceAttr(node, "routes.0.zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr(node, "routes.0.origin", "user"),
+					resource.TestCheckResourceAttrSet(node, "routes.0.lifecycle_state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVpnGatewayConnectionRoutesDataSourceConfig(vpc, subnet, vpngwname, name, routename string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_vpn_gateway" "testacc_vpnGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "route"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_vpnGateway.id
+		peer_address = "1.2.3.4"
+		preshared_key = "VPNDemoPassword"
+	}
+	resource "ibm_is_vpc_routing_table_route" "testacc_route" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		routing_table = ibm_is_vpc.testacc_vpc.default_routing_table
+		name = "%s"
+		zone = "%s"
+		destination = "192.168.100.0/24"
+		next_hop = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection.gateway_connection
+	}
+	data "ibm_is_vpn_gateway_connection_routes" "test1" {
+		depends_on = [ibm_is_vpc_routing_table_route.testacc_route]
+		vpc = ibm_is_vpc.testacc_vpc.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection.gateway_connection
+	}`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpngwname, name, routename, acc.ISZoneName)
+}
@@ -80,6 +80,10 @@ func ResourceIBMISImage() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISImageLifecycleCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -127,13 +131,13 @@ func ResourceIBMISImage() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
+				Description: "The deprecation date and time (UTC) for this image, in the future. Set to `null` to remove it. If absent, no deprecation date and time has been set.",
 			},
 			isImageObsolescenceAt: {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
+				Description: "The obsolescence date and time (UTC) for this image, in the future and later than the deprecation date and time. Set to `null` to remove it. If absent, no obsolescence date and time has been set.",
 			},
 
 			isImageEncryptionKey: {
@@ -802,6 +806,35 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// resourceIBMISImageLifecycleCustomizeDiff checks the deprecation and obsolescence schedule of the
+// image at plan time. A new date and time must be in the future, a date and time already set may
+// have passed. The image must be deprecated before it is obsolete.
+func resourceIBMISImageLifecycleCustomizeDiff(diff *schema.ResourceDiff) error {
+	schedule := map[string]*strfmt.DateTime{}
+	for _, key := range []string{isImageDeprecationAt, isImageObsolescenceAt} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		value := diff.Get(key).(string)
+		if value == "" || value == "null" {
+			continue
+		}
+		at, err := strfmt.ParseDateTime(value)
+		if err != nil {
+			return fmt.Errorf("[ERROR] %s %q is not a valid date and time, use the RFC 3339 format such as 2026-12-31T00:00:00Z: %s", key, value, err)
+		}
+		if diff.HasChange(key) && !time.Time(at).After(time.Now()) {
+			return fmt.Errorf("[ERROR] %s %q must be in the future", key, value)
+		}
+		schedule[key] = &at
+	}
+	deprecationAt, obsolescenceAt := schedule[isImageDeprecationAt], schedule[isImageObsolescenceAt]
+	if deprecationAt != nil && obsolescenceAt != nil && !time.Time(*obsolescenceAt).After(time.Time(*deprecationAt)) {
+		return fmt.Errorf("[ERROR] %s must be later than %s", isImageObsolescenceAt, isImageDeprecationAt)
+	}
+	return nil
+}
+
 func resourceIBMISImageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -140,8 +141,9 @@ func testAccCheckIBMISImageAccessTagsConfig(name string) string {
 func TestAccIBMISImage_lifecycle(t *testing.T) {
 	var image string
 	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
-	deprecationAt := "2023-09-28T15:10:00.000Z"
-	obsolescenceAt := "2023-11-28T15:10:00.000Z"
+	// The deprecation and obsolescence of an image are scheduled in the future
+	deprecationAt := time.Now().UTC().AddDate(0, 1, 0).Format("2006-01-02T15:04:00.000Z")
+	obsolescenceAt := time.Now().UTC().AddDate(0, 3, 0).Format("2006-01-02T15:04:00.000Z")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
@@ -163,11 +165,32 @@ func TestAccIBMISImage_lifecycle(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImage_lifecycle_invalid(t *testing.T) {
+	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
+	deprecationAt := time.Now().UTC().AddDate(0, 3, 0).Format("2006-01-02T15:04:00.000Z")
+	obsolescenceAt := time.Now().UTC().AddDate(0, 1, 0).Format("2006-01-02T15:04:00.000Z")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckImage(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISImageLifecycleConfig(name, "2023-09-28T15:10:00.000Z", "null"),
+				ExpectError: regexp.MustCompile("deprecation_at \"2023-09-28T15:10:00.000Z\" must be in the future"),
+			},
+			{
+				Config:      testAccCheckIBMISImageLifecycleConfig(name, deprecationAt, obsolescenceAt),
+				ExpectError: regexp.MustCompile("obsolescence_at must be later than deprecation_at"),
+			},
+		},
+	})
+}
 func TestAccIBMISImage_lifecycle_test_steps(t *testing.T) {
 	var image string
 	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
-	deprecationAt := "2023-09-28T15:10:00.000Z"
-	obsolescenceAt := "2023-11-28T15:10:00.000Z"
+	// The deprecation and obsolescence of an image are scheduled in the future
+	deprecationAt := time.Now().UTC().AddDate(0, 1, 0).Format("2006-01-02T15:04:00.000Z")
+	obsolescenceAt := time.Now().UTC().AddDate(0, 3, 0).Format("2006-01-02T15:04:00.000Z")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
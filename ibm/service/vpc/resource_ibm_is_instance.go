@@ -187,6 +187,10 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceReservationAffinityCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -2089,10 +2093,11 @@ func ResourceIBMISInstance() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"policy": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The reservation affinity policy to use for this virtual server instance.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "reservation_affinity_policy"),
+							Description:  "The reservation affinity policy to use for this virtual server instance. With `manual` the instance is bound to the reservation of the pool, with `disabled` it does not use a reservation.",
 						},
 						isReservationAffinityPool: &schema.Schema{
 							Type:        schema.TypeList,
@@ -2241,6 +2246,14 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              host_failure})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "reservation_affinity_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "automatic, disabled, manual"})
+
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "accesstag",
@@ -2255,6 +2268,23 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 	return &ibmISInstanceValidator
 }
 
+// resourceIBMISInstanceReservationAffinityCustomizeDiff checks at plan time that an instance bound
+// to a reservation with the manual policy names the reservation in the pool.
+func resourceIBMISInstanceReservationAffinityCustomizeDiff(diff *schema.ResourceDiff) error {
+	resPol := "reservation_affinity.0.policy"
+	resPoolId := "reservation_affinity.0.pool.0.id"
+	if !diff.HasChange(resPol) && !diff.HasChange(resPoolId) {
+		return nil
+	}
+	if !diff.NewValueKnown(resPol) || !diff.NewValueKnown(resPoolId) {
+		return nil
+	}
+	if diff.Get(resPol).(string) == "manual" && diff.Get(resPoolId).(string) == "" {
+		return fmt.Errorf("[ERROR] reservation_affinity with the manual policy requires the id of a reservation in the pool")
+	}
+	return nil
+}
+
 func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, bootProfile string) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -7344,6 +7374,8 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 		}
 		if _, ok := d.GetOkExists("enable_secure_boot"); ok && d.HasChange("enable_secure_boot") {
 			instanceCCMPatchModel.EnableSecureBoot = core.BoolPtr(d.Get("enable_secure_boot").(bool))
+			// secure boot can only be toggled while the instance is stopped, like the confidential compute mode
+			restartNeeded = true
 		}
 		if d.HasChange("name") {
 			instanceCCMPatchModel.Name = &name
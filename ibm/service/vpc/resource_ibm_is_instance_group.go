@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -53,6 +54,11 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceGroupScaleInCustomizeDiff(diff)
+				},
+			),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -125,6 +131,22 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Description:  "load balancer pool ID",
 			},
 
+			"protected_memberships": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the memberships that the provider does not remove when instance_count is reduced",
+			},
+
+			"scale_in_memberships": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the memberships to remove first when instance_count is reduced",
+			},
+
 			"managers": {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -356,7 +378,16 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		changed = true
 	}
 
+	var scaleInMembershipIDs []string
 	if d.HasChange("instance_count") {
+		oldCount, newCount := d.GetChange("instance_count")
+		if newCount.(int) < oldCount.(int) {
+			var diagErr diag.Diagnostics
+			scaleInMembershipIDs, diagErr = instanceGroupScaleInMemberships(context, sess, d, oldCount.(int)-newCount.(int))
+			if diagErr != nil {
+				return diagErr
+			}
+		}
 		membershipCount := d.Get("instance_count").(int)
 		mc := int64(membershipCount)
 		instanceGroupPatchModel.MembershipCount = &mc
@@ -386,6 +417,12 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 
 	if changed {
 		instanceGroupID := d.Id()
+		if len(scaleInMembershipIDs) > 0 {
+			diagErr := deleteInstanceGroupScaleInMemberships(context, sess, instanceGroupID, scaleInMembershipIDs, d.Timeout(schema.TimeoutUpdate))
+			if diagErr != nil {
+				return diagErr
+			}
+		}
 		instanceGroupUpdateOptions.ID = &instanceGroupID
 		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 		if err != nil {
@@ -412,6 +449,141 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 	return resourceIBMISInstanceGroupRead(context, d, meta)
 }
 
+// Without protected or selected memberships, the instance group chooses the memberships removed
+// when its membership count is reduced. Otherwise instanceGroupScaleInMemberships returns the
+// memberships to remove, the selected ones first and then the newest unprotected ones. They are
+// deleted, and waited for, before the membership count is updated, so that once the new count is
+// set the instance group has no memberships left to remove and cannot pick a protected one. The
+// protection is only applied by the provider, the instance group managers and other clients can
+// still remove these memberships.
+func instanceGroupScaleInMemberships(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, count int) ([]string, diag.Diagnostics) {
+	protected := d.Get("protected_memberships").(*schema.Set)
+	selected := d.Get("scale_in_memberships").(*schema.Set)
+	if protected.Len() == 0 && selected.Len() == 0 {
+		return nil, nil
+	}
+
+	instanceGroupID := d.Id()
+	allrecs, err := listInstanceGroupMemberships(context, sess, instanceGroupID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListInstanceGroupMembershipsWithContext failed: %s", err.Error()), "ibm_is_instance_group", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return nil, tfErr.GetDiag()
+	}
+
+	sort.SliceStable(allrecs, func(i, j int) bool {
+		if allrecs[i].CreatedAt == nil || allrecs[j].CreatedAt == nil {
+			return false
+		}
+		return time.Time(*allrecs[i].CreatedAt).After(time.Time(*allrecs[j].CreatedAt))
+	})
+	membershipIDs := []string{}
+	for _, membership := range allrecs {
+		if selected.Contains(*membership.ID) {
+			membershipIDs = append(membershipIDs, *membership.ID)
+		}
+	}
+	for _, membership := range allrecs {
+		if !selected.Contains(*membership.ID) && !protected.Contains(*membership.ID) {
+			membershipIDs = append(membershipIDs, *membership.ID)
+		}
+	}
+	if len(membershipIDs) < count {
+		err := fmt.Errorf("[ERROR] The instance group %s has %d unprotected memberships, %d memberships cannot be removed", instanceGroupID, len(membershipIDs), count)
+		return nil, flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group", "update", "scale-in").GetDiag()
+	}
+	return membershipIDs[:count], nil
+}
+
+// deleteInstanceGroupScaleInMemberships deletes the memberships chosen for a scale-in and waits until
+// the instance group no longer lists them.
+func deleteInstanceGroupScaleInMemberships(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string, membershipIDs []string, timeout time.Duration) diag.Diagnostics {
+	for _, membershipID := range membershipIDs {
+		deleteInstanceGroupMembershipOptions := vpcv1.DeleteInstanceGroupMembershipOptions{
+			ID:              &membershipID,
+			InstanceGroupID: &instanceGroupID,
+		}
+		response, err := sess.DeleteInstanceGroupMembershipWithContext(context, &deleteInstanceGroupMembershipOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteInstanceGroupMembershipWithContext failed: %s", err.Error()), "ibm_is_instance_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	_, err := waitForInstanceGroupMembershipsDeleted(context, sess, instanceGroupID, membershipIDs, timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForInstanceGroupMembershipsDeleted failed: %s", err.Error()), "ibm_is_instance_group", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func listInstanceGroupMemberships(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	start := ""
+	allrecs := []vpcv1.InstanceGroupMembership{}
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, _, err := sess.ListInstanceGroupMembershipsWithContext(context, &listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, err
+		}
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		allrecs = append(allrecs, instanceGroupMembershipCollection.Memberships...)
+		if start == "" {
+			return allrecs, nil
+		}
+	}
+}
+
+// waitForInstanceGroupMembershipsDeleted waits until none of the memberships is listed by the
+// instance group anymore.
+func waitForInstanceGroupMembershipsDeleted(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string, membershipIDs []string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			memberships, err := listInstanceGroupMemberships(context, sess, instanceGroupID)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, membership := range memberships {
+				for _, membershipID := range membershipIDs {
+					if membership.ID != nil && *membership.ID == membershipID {
+						return memberships, "deleting", nil
+					}
+				}
+			}
+			return memberships, "deleted", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIBMISInstanceGroupScaleInCustomizeDiff(diff *schema.ResourceDiff) error {
+	protected := diff.Get("protected_memberships").(*schema.Set)
+	selected := diff.Get("scale_in_memberships").(*schema.Set)
+	for _, membershipID := range selected.List() {
+		if protected.Contains(membershipID) {
+			return fmt.Errorf("[ERROR] The membership %s is protected, it cannot be selected in scale_in_memberships", membershipID)
+		}
+	}
+	if diff.NewValueKnown("instance_count") && protected.Len() > diff.Get("instance_count").(int) {
+		return fmt.Errorf("[ERROR] instance_count cannot be lower than the %d protected memberships", protected.Len())
+	}
+	return nil
+}
+
 func resourceIBMISInstanceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -45,6 +45,42 @@ func TestAccIBMISInstanceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroup_scaleIn(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGroupConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "instance_count", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupScaleInConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "instance_count", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "protected_memberships.#", "1"),
+					testAccCheckIBMISInstanceGroupProtectedMembershipsExist("ibm_is_instance_group.instance_group"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstanceGroup_basic_loadbalancer(t *testing.T) {
 	// var lb string
 	randInt := acctest.RandIntRange(10, 100)
@@ -109,6 +145,35 @@ func TestAccIBMISInstanceGroup_basic_loadbalancer(t *testing.T) {
 	})
 }
 
+// testAccCheckIBMISInstanceGroupProtectedMembershipsExist checks that the scale-in kept the
+// protected memberships of the instance group.
+func testAccCheckIBMISInstanceGroupProtectedMembershipsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		for key, membershipID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "protected_memberships.") || key == "protected_memberships.#" {
+				continue
+			}
+			getInstanceGroupMembershipOptions := vpcv1.GetInstanceGroupMembershipOptions{
+				InstanceGroupID: &rs.Primary.ID,
+				ID:              &membershipID,
+			}
+			_, _, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
+			if err != nil {
+				return fmt.Errorf("protected membership %s of instance group %s was removed: %s", membershipID, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMISInstanceGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -229,3 +294,54 @@ func testAccCheckIBMISInstanceGroupConfig(vpcName, subnetName, sshKeyName, publi
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName)
 
 }
+
+func testAccCheckIBMISInstanceGroupScaleInConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%s"
+	   image   = "%s"
+	   profile = "bx2-8x32"
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	 }
+
+	data "ibm_is_instance_group" "instance_group" {
+		name = "%s"
+	}
+
+	data "ibm_is_instance_group_memberships" "memberships" {
+		instance_group = data.ibm_is_instance_group.instance_group.id
+	}
+
+	resource "ibm_is_instance_group" "instance_group" {
+		name =  "%s"
+		instance_template = ibm_is_instance_template.instancetemplate1.id
+		instance_count =  1
+		subnets = [ibm_is_subnet.subnet2.id]
+		protected_memberships = [data.ibm_is_instance_group_memberships.memberships.memberships.0.instance_group_membership]
+	}
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName, instanceGroupName)
+
+}
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -287,6 +288,16 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_instance.testacc_instance", "enable_secure_boot", "true"),
 				),
 			},
+			{
+				Config: testAccCheckIBMISInstanceConComConfig(vpcname, subnetname, sshname, publicKey, name, userData2, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "enable_secure_boot", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "status", "running"),
+				),
+			},
 		},
 	})
 }
@@ -1355,6 +1366,24 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISInstance_ReservationManualWithoutPool(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISInstanceReservationManualWithoutPool(vpcname, subnetname, name),
+				ExpectError: regexp.MustCompile("reservation_affinity with the manual policy requires the id of a reservation in the pool"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceDestroy(s *terraform.State) error {
 
 	instanceC, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -2598,6 +2627,35 @@ func testAccCheckIBMISInstanceReservation(vpcname, subnetname, name, publickey,
 	  `, vpcname, sshname, publickey, subnetname, acc.ISZoneName3, name, acc.IsImage2, acc.InstanceProfileName, acc.ISZoneName3)
 }
 
+func testAccCheckIBMISInstanceReservationManualWithoutPool(vpcname, subnetname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		reservation_affinity {
+			policy = "manual"
+		}
+	}
+	`, vpcname, subnetname, acc.ISZoneName, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName)
+}
+
 func testAccCheckIBMISInstanceByVolume(vpcname, subnetname, sshname, publicKey, volName, name, name1, sname string) string {
 	return testAccCheckIBMISVolumeConfigSnapshot(vpcname, subnetname, sshname, publicKey, volName, name, sname) + fmt.Sprintf(`
 	  
@@ -17,6 +17,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -67,6 +68,12 @@ func ResourceIBMISLBListenerPolicy() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBListenerPolicyValidate(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isLBListenerPolicyLBID: {
@@ -394,6 +401,98 @@ func ResourceIBMISLBListenerPolicyValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
+// The combinations of action, target and rules rejected by the API are reported at plan time.
+func resourceIBMISLBListenerPolicyValidate(diff *schema.ResourceDiff) error {
+	action := diff.Get(isLBListenerPolicyAction).(string)
+
+	var listener, url bool
+	var uri string
+	var statusCode int
+	if _, ok := diff.GetOk("target"); ok {
+		listener = len(diff.Get("target.0.listener").([]interface{})) > 0
+		if action == "forward_to_listener" {
+			// The listener of the forward_to_listener action can also be set in target.0.id
+			listener = listener || diff.Get("target.0.id").(string) != "" || !diff.NewValueKnown("target.0.id")
+		}
+		url = diff.Get("target.0.url").(string) != "" || !diff.NewValueKnown("target.0.url")
+		if diff.NewValueKnown("target.0.uri") {
+			uri = diff.Get("target.0.uri").(string)
+		}
+		if !diff.NewValueKnown("target.0.http_status_code") {
+			statusCode = -1
+		} else {
+			statusCode = diff.Get("target.0.http_status_code").(int)
+		}
+	} else {
+		// The listener of the forward_to_listener action is set in target_id
+		listenerKey := isLBListenerPolicyHTTPSRedirectListener
+		if action == "forward_to_listener" {
+			listenerKey = isLBListenerPolicyTargetID
+		}
+		listener = diff.Get(listenerKey).(string) != "" || !diff.NewValueKnown(listenerKey)
+		url = diff.Get(isLBListenerPolicyTargetURL).(string) != "" || !diff.NewValueKnown(isLBListenerPolicyTargetURL)
+		if diff.NewValueKnown(isLBListenerPolicyHTTPSRedirectURI) {
+			uri = diff.Get(isLBListenerPolicyHTTPSRedirectURI).(string)
+		}
+		statusCodeKey := isLBListenerPolicyTargetHTTPStatusCode
+		if action == "https_redirect" {
+			statusCodeKey = isLBListenerPolicyHTTPSRedirectStatusCode
+		}
+		if !diff.NewValueKnown(statusCodeKey) {
+			statusCode = -1
+		} else {
+			statusCode = diff.Get(statusCodeKey).(int)
+		}
+	}
+
+	switch action {
+	case "https_redirect":
+		if !listener {
+			return fmt.Errorf("[ERROR] The policy action https_redirect requires the target listener")
+		}
+	case "redirect":
+		if !url {
+			return fmt.Errorf("[ERROR] The policy action redirect requires the target url")
+		}
+	case "forward_to_listener":
+		if !listener {
+			return fmt.Errorf("[ERROR] The policy action forward_to_listener requires the target listener")
+		}
+	}
+	if action == "https_redirect" || action == "redirect" {
+		if statusCode == 0 {
+			return fmt.Errorf("[ERROR] The policy action %s requires the target http_status_code", action)
+		}
+		if statusCode > 0 && statusCode != 301 && statusCode != 302 && statusCode != 303 && statusCode != 307 && statusCode != 308 {
+			return fmt.Errorf("[ERROR] The target http_status_code %d of the policy must be one of 301, 302, 303, 307 and 308", statusCode)
+		}
+	}
+	if uri != "" {
+		if action != "https_redirect" {
+			return fmt.Errorf("[ERROR] The target uri is only supported by the policy action https_redirect")
+		}
+		if !strings.HasPrefix(uri, "/") {
+			return fmt.Errorf("[ERROR] The target uri %s of the policy must be a relative URI starting with /", uri)
+		}
+	}
+
+	for i, rule := range diff.Get(isLBListenerPolicyRules).([]interface{}) {
+		if rule == nil {
+			continue
+		}
+		rulex := rule.(map[string]interface{})
+		ruleType := rulex[isLBListenerPolicyRuleType].(string)
+		field := rulex[isLBListenerPolicyRuleField].(string)
+		if !diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isLBListenerPolicyRules, i, isLBListenerPolicyRuleField)) {
+			continue
+		}
+		if err := lbListenerPolicyRuleValidateField(ruleType, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func resourceIBMISLBListenerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Get the Load balancer ID
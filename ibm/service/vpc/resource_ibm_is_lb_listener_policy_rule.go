@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -56,6 +57,15 @@ func ResourceIBMISLBListenerPolicyRule() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if !diff.NewValueKnown(isLBListenerPolicyRulefield) {
+					return nil
+				}
+				return lbListenerPolicyRuleValidateField(diff.Get(isLBListenerPolicyRuletype).(string), diff.Get(isLBListenerPolicyRulefield).(string))
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isLBListenerPolicyRuleLBID: {
@@ -187,6 +197,15 @@ func ResourceIBMISLBListenerPolicyRuleValidator() *validate.ResourceValidator {
 	return &ibmISLBListenerPolicyRuleResourceValidator
 }
 
+// The field is the name of the header of a header rule, it is optional for the body and query rules.
+// It is not checked for the other rule types.
+func lbListenerPolicyRuleValidateField(ruleType, field string) error {
+	if ruleType == "header" && field == "" {
+		return fmt.Errorf("[ERROR] The field is required by the policy rules of type header")
+	}
+	return nil
+}
+
 func resourceIBMISLBListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Read lb, listerner, policy IDs
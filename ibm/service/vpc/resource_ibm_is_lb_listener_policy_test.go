@@ -160,6 +160,16 @@ func TestAccIBMISLBListenerListenerPolicy(t *testing.T) {
 						"ibm_is_lb_listener_policy.testacc_lb_listener_policy", "priority", priority1),
 				),
 			},
+			{
+				Config: testAccCheckIBMISLBListenerListenerPolicyTargetConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, port, protocol, port1, protocol1, lblistenerpolicyname1, actionlistener, priority1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBListenerPolicyExists("ibm_is_lb_listener_policy.testacc_lb_listener_policy", policyID),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policy.testacc_lb_listener_policy", "action", actionlistener),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_lb_listener_policy.testacc_lb_listener_policy", "target.0.id", "ibm_is_lb_listener.testacc_lb_listener1", "listener_id"),
+				),
+			},
 		},
 	})
 }
@@ -615,6 +625,47 @@ func testAccCheckIBMISLBListenerListenerPolicyConfig(vpcname, subnetname, zone,
 
 }
 
+func testAccCheckIBMISLBListenerListenerPolicyTargetConfig(vpcname, subnetname, zone, cidr, lbname, port, protocol, port1, protocol1, lblistenerpolicyname, action, priority string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+	  resource "ibm_is_lb" "testacc_LB" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	  }
+	  resource "ibm_is_lb_listener" "testacc_lb_listener" {
+		lb           = ibm_is_lb.testacc_LB.id
+		port         = %s
+		protocol     = "%s"
+	  }
+
+	  resource "ibm_is_lb_listener" "testacc_lb_listener1" {
+		lb       = ibm_is_lb.testacc_LB.id
+		port     = %s
+		protocol = "%s"
+	  }
+
+	  resource "ibm_is_lb_listener_policy" "testacc_lb_listener_policy" {
+		lb       = ibm_is_lb.testacc_LB.id
+		listener = ibm_is_lb_listener.testacc_lb_listener.listener_id
+		action   = "%s"
+		priority = %s
+		name     = "%s"
+		target {
+			id = ibm_is_lb_listener.testacc_lb_listener1.listener_id
+		}
+	  }`, vpcname, subnetname, zone, cidr, lbname, port, protocol, port1, protocol1, action, priority, lblistenerpolicyname)
+
+}
+
 func testAccCheckIBMISLBListenerPolicyConfigUpdate(vpcname, subnetname, zone, cidr, lbname, port, protocol, lblistenerpolicyname, priority, action string) string {
 
 	return fmt.Sprintf(`
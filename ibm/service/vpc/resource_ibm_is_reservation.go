@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -14,6 +15,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -64,6 +66,10 @@ func ResourceIBMISReservation() *schema.Resource {
 		DeleteContext: resourceIBMISReservationDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			isReservationAffinityPolicy: &schema.Schema{
 				Type:         schema.TypeString,
@@ -115,16 +121,17 @@ func ResourceIBMISReservation() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isReservationComittedUseExpirationPolicy: &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The maximum number of days to keep each backup after creation.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_reservation", isReservationComittedUseExpirationPolicy),
+							Description:  "The policy to apply when the committed use term expires, release the reservation or renew it for another term.",
 						},
 						isReservationComittedUseTerm: &schema.Schema{
 							Type:         schema.TypeString,
 							Required:     true,
 							ValidateFunc: validate.InvokeValidator("ibm_is_reservation", isReservationComittedUseTerm),
-							Description:  "The maximum number of recent backups to keep. If unspecified, there will be no maximum.",
+							Description:  "The term for this committed use reservation, one_year or three_year.",
 						},
 						isReservationCommittedUseExpirationAt: &schema.Schema{
 							Type:        schema.TypeString,
@@ -268,6 +275,7 @@ func ResourceIBMISReservationValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	affinityPolicy := "automatic, restricted"
 	term := "one_year, three_year"
+	expirationPolicy := "release, renew"
 	resourceType := "bare_metal_server_profile, instance_profile"
 
 	validateSchema = append(validateSchema,
@@ -293,6 +301,13 @@ func ResourceIBMISReservationValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              term})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isReservationComittedUseExpirationPolicy,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              expirationPolicy})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isReservationProfileResourceType,
@@ -701,6 +716,41 @@ func resourceIBMISReservationDelete(context context.Context, d *schema.ResourceD
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	_, err = isWaitForReservationDeleted(context, sess, d, id)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservationDeleted failed: %s", err.Error()), "ibm_is_reservation", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId("")
 	return nil
 }
+
+// The reservation is deleting until the instances bound to it release it
+func isWaitForReservationDeleted(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "updating", "waiting"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getReservationOptions := &vpcv1.GetReservationOptions{
+				ID: &id,
+			}
+			reservation, response, err := sess.GetReservationWithContext(context, getReservationOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return reservation, "done", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting reservation %s: %s\n%s", id, err, response)
+			}
+			if *reservation.LifecycleState == "failed" {
+				return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] The reservation %s failed to delete", id)
+			}
+			return reservation, *reservation.LifecycleState, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -46,6 +46,34 @@ func TestAccIBMISReservation_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISReservation_expirationPolicy(t *testing.T) {
+	var reservation string
+	name := fmt.Sprintf("tfres-name-%d", acctest.RandIntRange(10, 100))
+	zone := "us-south-1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISReservationExpirationPolicyConfig(name, zone, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISReservationExists("ibm_is_reservation.isExampleReservation", reservation),
+					resource.TestCheckResourceAttr("ibm_is_reservation.isExampleReservation", "committed_use.0.expiration_policy", "release"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISReservationExpirationPolicyConfig(name, zone, "renew"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISReservationExists("ibm_is_reservation.isExampleReservation", reservation),
+					resource.TestCheckResourceAttr("ibm_is_reservation.isExampleReservation", "committed_use.0.expiration_policy", "renew"),
+				),
+			},
+		},
+	})
+}
+
 func checkReservationDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -109,3 +137,22 @@ func testAccCheckIBMISReservationConfig(name, zone string) string {
 		zone = "%s"
 	}`, name, zone)
 }
+
+func testAccCheckIBMISReservationExpirationPolicyConfig(name, zone, expirationPolicy string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_reservation" "isExampleReservation" {
+		capacity {
+			total = 10
+		  }
+		  committed_use {
+			term              = "one_year"
+			expiration_policy = "%s"
+		  }
+		profile {
+			name = "cx2-2x4"
+			resource_type = "instance_profile"
+		  }
+		name = "%s"
+		zone = "%s"
+	}`, expirationPolicy, name, zone)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -28,6 +29,10 @@ func ResourceIbmIsShareReplicaOperations() *schema.Resource {
 		DeleteContext: resourceIbmIsShareReplicaOperationsDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"share_replica": {
 				Type:        schema.TypeString,
@@ -60,6 +65,25 @@ func ResourceIbmIsShareReplicaOperations() *schema.Resource {
 				ExactlyOneOf: []string{"split_share", "fallback_policy"},
 				Description:  "If set to true the replication relationship between source share and replica will be removed.",
 			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that perform the operation again when they change, for example to fail over back to the original source share.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"replication_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication role of the file share after the operation.",
+			},
+			"replication_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the file share after the operation.",
+			},
 		},
 	}
 }
@@ -101,7 +125,20 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 
 	splitShare := d.Get("split_share").(bool)
 
+	// the share jobs have no ID, the share before the operation is kept to tell its latest job from
+	// the job of the operation
+	shareOptions := &vpcv1.GetShareOptions{}
+	shareOptions.SetID(share_id)
+	share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetShareWithContext failed: %s\n%s", err.Error(), response), "ibm_is_share_replica_operations", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	jobType := "replication_split"
 	if !splitShare {
+		jobType = "replication_failover"
 		fallback_policy := d.Get("fallback_policy").(string)
 		timeout := d.Get("timeout").(int)
 		failOverShareOptions := &vpcv1.FailoverShareOptions{
@@ -128,21 +165,21 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForShareReplicationJobDone(context, vpcClient, share_id, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForShareReplicationJobDone(context, vpcClient, share_id, jobType, share, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForShareReplicationJobDone failed: %s", err.Error()), "ibm_is_share_replica_operations", "create").GetDiag()
 	}
 	d.SetId(share_id)
-	return nil
+	return resourceIbmIsShareReplicaOperationsRead(context, d, meta)
 }
 
-func isWaitForShareReplicationJobDone(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareReplicationJobDone(context context.Context, vpcClient *vpcv1.VpcV1, shareid, jobType string, previous *vpcv1.Share, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share (%s) to be available.", shareid)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"pending"},
 		Target:     []string{"active", "none"},
-		Refresh:    isShareReplicationJobRefreshFunc(context, vpcClient, shareid, d),
+		Refresh:    isShareReplicationJobRefreshFunc(context, vpcClient, shareid, jobType, previous, d),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
@@ -151,7 +188,8 @@ func isWaitForShareReplicationJobDone(context context.Context, vpcClient *vpcv1.
 	return stateConf.WaitForState()
 }
 
-func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid, jobType string, previous *vpcv1.Share, d *schema.ResourceData) resource.StateRefreshFunc {
+	started := false
 	return func() (interface{}, string, error) {
 		shareOptions := &vpcv1.GetShareOptions{}
 
@@ -161,6 +199,31 @@ func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
 		}
+		job := share.LatestJob
+		if job == nil || job.Type == nil || *job.Type != jobType || job.Status == nil {
+			return share, "pending", nil
+		}
+		// The replication status is still active before the failover starts, the operation is done
+		// once its job succeeds. A finished job that looks like the latest job before the operation,
+		// with the replication role unchanged, is not the job of the operation.
+		switch *job.Status {
+		case "failed", "cancelled", "succeeded":
+			if !started && isSameShareJob(previous, share) {
+				return share, "pending", nil
+			}
+		default:
+			started = true
+			return share, "pending", nil
+		}
+		if *job.Status != "succeeded" {
+			reasons := []string{}
+			for _, reason := range job.StatusReasons {
+				if reason.Message != nil {
+					reasons = append(reasons, *reason.Message)
+				}
+			}
+			return share, *job.Status, fmt.Errorf("The %s job of the share %s is %s: %s", jobType, shareid, *job.Status, strings.Join(reasons, ", "))
+		}
 		if *share.ReplicationStatus == "active" || *share.ReplicationStatus == "none" {
 
 			return share, *share.ReplicationStatus, nil
@@ -170,7 +233,40 @@ func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.
 	}
 }
 
+func isSameShareJob(previous, share *vpcv1.Share) bool {
+	previousJob, job := previous.LatestJob, share.LatestJob
+	if previousJob == nil || previousJob.Type == nil || previousJob.Status == nil {
+		return false
+	}
+	if previous.ReplicationRole != nil && share.ReplicationRole != nil && *previous.ReplicationRole != *share.ReplicationRole {
+		return false
+	}
+	return *previousJob.Type == *job.Type && *previousJob.Status == *job.Status && len(previousJob.StatusReasons) == len(job.StatusReasons)
+}
+
 func resourceIbmIsShareReplicaOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("vpcClient creation failed: %s", err.Error()), "ibm_is_share_replica_operations", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	shareOptions := &vpcv1.GetShareOptions{}
+	shareOptions.SetID(d.Id())
+	share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetShareWithContext failed: %s\n%s", err.Error(), response), "ibm_is_share_replica_operations", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set("share_replica", d.Id())
+	d.Set("replication_role", share.ReplicationRole)
+	d.Set("replication_status", share.ReplicationStatus)
 	return nil
 }
 
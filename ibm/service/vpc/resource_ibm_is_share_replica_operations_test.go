@@ -58,6 +58,35 @@ func TestAccIbmIsShareReplicaOperationsFailover(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsShareReplicaOperationsFailoverTriggers(t *testing.T) {
+	var conf vpcv1.Share
+	shareName := fmt.Sprintf("tf-fs-name-%d", acctest.RandIntRange(10, 100))
+	replicaName := fmt.Sprintf("tf-fsrep-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIsShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsShareReplicaOperationsFailoverTriggers(shareName, replicaName, "ibm_is_share.replica.id", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsShareExists("ibm_is_share.share", conf),
+					resource.TestCheckResourceAttr("ibm_is_share_replica_operations.test", "replication_role", "source"),
+					resource.TestCheckResourceAttr("ibm_is_share_replica_operations.test", "replication_status", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIsShareReplicaOperationsFailoverTriggers(shareName, replicaName, "ibm_is_share.share.id", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsShareExists("ibm_is_share.share", conf),
+					resource.TestCheckResourceAttr("ibm_is_share_replica_operations.test", "replication_role", "source"),
+					resource.TestCheckResourceAttr("ibm_is_share_replica_operations.test", "triggers.round", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIbmIsShareReplicaOperationsSplit(t *testing.T) {
 	var conf vpcv1.Share
 
@@ -136,6 +165,33 @@ func testAccCheckIbmIsShareReplicaOperationsFailoverStepTwo(shareName, replicaNa
 	`, shareName, acc.ShareProfileName, replicaName, acc.ShareProfileName)
 }
 
+func testAccCheckIbmIsShareReplicaOperationsFailoverTriggers(shareName, replicaName, shareReplica, round string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_share" "share" {
+			zone = "us-south-1"
+			size = 200
+			name = "%s"
+			profile = "%s"
+		}
+		resource "ibm_is_share" "replica" {
+			zone = "us-south-3"
+			name = "%s"
+			profile = "%s"
+			replication_cron_spec = "0 */5 * * *"
+			source_share = ibm_is_share.share.id
+		}
+
+		resource "ibm_is_share_replica_operations" "test" {
+			share_replica = %s
+			fallback_policy = "fail"
+			timeout = 500
+			triggers = {
+				round = "%s"
+			}
+		}
+	`, shareName, acc.ShareProfileName, replicaName, acc.ShareProfileName, shareReplica, round)
+}
+
 func testAccCheckIbmIsShareReplicaOperationsSplit(shareName, replicaName string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_share" "share" {
@@ -0,0 +1,458 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isReservedIPPoolReservedIPs = "reserved_ips"
+)
+
+func ResourceIBMISSubnetReservedIPPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSubnetReservedIPPoolCreate,
+		ReadContext:   resourceIBMISSubnetReservedIPPoolRead,
+		UpdateContext: resourceIBMISSubnetReservedIPPoolUpdate,
+		DeleteContext: resourceIBMISSubnetReservedIPPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISSubnetReservedIPPoolImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			isSubNetID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subnet identifier.",
+			},
+			isReservedIPPoolReservedIPs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMISSubnetReservedIPPoolHash,
+				Description: "The reserved IPs of the pool, identified by their names.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isReservedIPName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_subnet_reserved_ip", isReservedIPName),
+							Description:  "The name for this reserved IP, unique in the pool.",
+						},
+						isReservedIPAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The address for this reserved IP, an available address of the subnet is selected if unspecified.",
+						},
+						isReservedIPAutoDelete: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, this reserved IP will be automatically deleted when its target is deleted",
+						},
+						isReservedIP: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the reserved IP.",
+						},
+						isReservedIPhref: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						isReservedIPLifecycleState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the reserved IP",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// The reserved IPs are identified by their names, a change of the other arguments updates or
+// replaces the reserved IP in place of adding a new element to the set.
+func resourceIBMISSubnetReservedIPPoolHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})[isReservedIPName].(string))
+}
+
+func resourceIBMISSubnetReservedIPPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip_pool", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	subnetID := d.Get(isSubNetID).(string)
+	d.SetId(fmt.Sprintf("%s/%s", subnetID, id.UniqueId()))
+
+	toCreate := make([]map[string]interface{}, 0)
+	for _, ripIntf := range d.Get(isReservedIPPoolReservedIPs).(*schema.Set).List() {
+		toCreate = append(toCreate, ripIntf.(map[string]interface{}))
+	}
+	pool, err := createSubnetReservedIPPoolMembers(context, sess, subnetID, toCreate)
+	// The reserved IPs already created are kept in the state, they are removed by the next apply
+	d.Set(isReservedIPPoolReservedIPs, pool)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSubnetReservedIPWithContext failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	diagErr := waitForSubnetReservedIPPoolAvailable(sess, subnetID, pool, d.Timeout(schema.TimeoutCreate), "create")
+	if diagErr != nil {
+		return diagErr
+	}
+	return resourceIBMISSubnetReservedIPPoolRead(context, d, meta)
+}
+
+func createSubnetReservedIPPoolMember(context context.Context, sess *vpcv1.VpcV1, subnetID string, rip map[string]interface{}) (*vpcv1.ReservedIP, error) {
+	options := sess.NewCreateSubnetReservedIPOptions(subnetID)
+	options.Name = core.StringPtr(rip[isReservedIPName].(string))
+	if address, ok := rip[isReservedIPAddress].(string); ok && address != "" {
+		options.Address = core.StringPtr(address)
+	}
+	options.AutoDelete = core.BoolPtr(rip[isReservedIPAutoDelete].(bool))
+	reservedIP, response, err := sess.CreateSubnetReservedIPWithContext(context, options)
+	if err != nil || reservedIP == nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the reserved IP %s: %s\n%s", *options.Name, err, response)
+	}
+	return reservedIP, nil
+}
+
+// createSubnetReservedIPPoolMembers requests all the reserved IPs at once, it returns the reserved
+// IPs that were created along with the first error.
+func createSubnetReservedIPPoolMembers(context context.Context, sess *vpcv1.VpcV1, subnetID string, rips []map[string]interface{}) ([]map[string]interface{}, error) {
+	var wg sync.WaitGroup
+	errs := make([]error, len(rips))
+	for i, rip := range rips {
+		wg.Add(1)
+		go func(i int, rip map[string]interface{}) {
+			defer wg.Done()
+			reservedIP, err := createSubnetReservedIPPoolMember(context, sess, subnetID, rip)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			rip[isReservedIP] = *reservedIP.ID
+		}(i, rip)
+	}
+	wg.Wait()
+
+	created := make([]map[string]interface{}, 0, len(rips))
+	var firstErr error
+	for i, rip := range rips {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		created = append(created, rip)
+	}
+	return created, firstErr
+}
+
+func waitForSubnetReservedIPPoolAvailable(sess *vpcv1.VpcV1, subnetID string, pool []map[string]interface{}, timeout time.Duration, operation string) diag.Diagnostics {
+	// The reserved IPs are awaited together, each refresh only gets the ones that are still pending
+	pending := map[string]bool{}
+	for _, rip := range pool {
+		pending[rip[isReservedIP].(string)] = true
+	}
+	log.Printf("Waiting for %d reseved ips of the subnet %s to be available.", len(pending), subnetID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			for reservedIPID := range pending {
+				options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
+				reservedIP, response, err := sess.GetSubnetReservedIP(options)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetID, reservedIPID, err, response)
+				}
+				if reservedIP.LifecycleState != nil && *reservedIP.LifecycleState == "failed" {
+					return reservedIP, "failed", fmt.Errorf("[ERROR] Error Reserved ip(%s/%s) creation failed", subnetID, reservedIPID)
+				}
+				if reservedIP.LifecycleState == nil || *reservedIP.LifecycleState == "stable" {
+					delete(pending, reservedIPID)
+				}
+			}
+			if len(pending) == 0 {
+				return pool, "done", nil
+			}
+			return pool, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForSubnetReservedIPPoolAvailable failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSubnetReservedIPPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip_pool", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	subnetID := d.Get(isSubNetID).(string)
+
+	pool := make([]map[string]interface{}, 0)
+	for _, ripIntf := range d.Get(isReservedIPPoolReservedIPs).(*schema.Set).List() {
+		rip := ripIntf.(map[string]interface{})
+		reservedIPID, _ := rip[isReservedIP].(string)
+		if reservedIPID == "" {
+			continue
+		}
+		options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
+		reservedIP, response, err := sess.GetSubnetReservedIPWithContext(context, options)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				// The reserved IP is created again by the next apply
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSubnetReservedIPWithContext failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		pool = append(pool, subnetReservedIPPoolMemberToMap(reservedIP))
+	}
+	if len(pool) == 0 {
+		log.Printf("[WARN] No reserved IPs of the pool %s were found in the subnet %s, removing it from the state", d.Id(), subnetID)
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set(isReservedIPPoolReservedIPs, pool); err != nil {
+		err = fmt.Errorf("Error setting reserved_ips: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip_pool", "read", "set-reserved_ips").GetDiag()
+	}
+	return nil
+}
+
+func subnetReservedIPPoolMemberToMap(reservedIP *vpcv1.ReservedIP) map[string]interface{} {
+	rip := map[string]interface{}{
+		isReservedIP: *reservedIP.ID,
+	}
+	if !core.IsNil(reservedIP.Name) {
+		rip[isReservedIPName] = *reservedIP.Name
+	}
+	if !core.IsNil(reservedIP.Address) {
+		rip[isReservedIPAddress] = *reservedIP.Address
+	}
+	if !core.IsNil(reservedIP.AutoDelete) {
+		rip[isReservedIPAutoDelete] = *reservedIP.AutoDelete
+	}
+	if !core.IsNil(reservedIP.Href) {
+		rip[isReservedIPhref] = *reservedIP.Href
+	}
+	if !core.IsNil(reservedIP.LifecycleState) {
+		rip[isReservedIPLifecycleState] = *reservedIP.LifecycleState
+	}
+	return rip
+}
+
+func resourceIBMISSubnetReservedIPPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange(isReservedIPPoolReservedIPs) {
+		return resourceIBMISSubnetReservedIPPoolRead(context, d, meta)
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip_pool", "update", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	subnetID := d.Get(isSubNetID).(string)
+
+	oldPool := map[string]map[string]interface{}{}
+	o, n := d.GetChange(isReservedIPPoolReservedIPs)
+	for _, ripIntf := range o.(*schema.Set).List() {
+		rip := ripIntf.(map[string]interface{})
+		oldPool[rip[isReservedIPName].(string)] = rip
+	}
+
+	// The reserved IPs are deleted first, so that their addresses can be reused by the new ones
+	newPool := make([]map[string]interface{}, 0)
+	var toCreate []map[string]interface{}
+	var toDelete []string
+	for _, ripIntf := range n.(*schema.Set).List() {
+		rip := ripIntf.(map[string]interface{})
+		name := rip[isReservedIPName].(string)
+		oldRip, exists := oldPool[name]
+		delete(oldPool, name)
+		if !exists {
+			toCreate = append(toCreate, rip)
+			continue
+		}
+		rip[isReservedIP] = oldRip[isReservedIP]
+		address, _ := rip[isReservedIPAddress].(string)
+		if address != "" && address != oldRip[isReservedIPAddress].(string) {
+			// The address of a reserved IP cannot be updated, it is replaced
+			toDelete = append(toDelete, oldRip[isReservedIP].(string))
+			toCreate = append(toCreate, rip)
+			continue
+		}
+		if rip[isReservedIPAutoDelete].(bool) != oldRip[isReservedIPAutoDelete].(bool) {
+			patch := &vpcv1.ReservedIPPatch{AutoDelete: core.BoolPtr(rip[isReservedIPAutoDelete].(bool))}
+			reservedIPPatch, err := patch.AsPatch()
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("patch.AsPatch() failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", "update")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			options := &vpcv1.UpdateSubnetReservedIPOptions{
+				SubnetID:        &subnetID,
+				ID:              core.StringPtr(rip[isReservedIP].(string)),
+				ReservedIPPatch: reservedIPPatch,
+			}
+			_, _, err = sess.UpdateSubnetReservedIPWithContext(context, options)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateSubnetReservedIPWithContext failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", "update")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+		newPool = append(newPool, rip)
+	}
+	for _, oldRip := range oldPool {
+		toDelete = append(toDelete, oldRip[isReservedIP].(string))
+	}
+
+	for _, reservedIPID := range toDelete {
+		if diagErr := deleteSubnetReservedIPPoolMember(context, sess, subnetID, reservedIPID, "update"); diagErr != nil {
+			return diagErr
+		}
+	}
+	created, err := createSubnetReservedIPPoolMembers(context, sess, subnetID, toCreate)
+	d.Set(isReservedIPPoolReservedIPs, append(newPool, created...))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSubnetReservedIPWithContext failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	diagErr := waitForSubnetReservedIPPoolAvailable(sess, subnetID, created, d.Timeout(schema.TimeoutUpdate), "update")
+	if diagErr != nil {
+		return diagErr
+	}
+	return resourceIBMISSubnetReservedIPPoolRead(context, d, meta)
+}
+
+func deleteSubnetReservedIPPoolMember(context context.Context, sess *vpcv1.VpcV1, subnetID, reservedIPID, operation string) diag.Diagnostics {
+	deleteOptions := sess.NewDeleteSubnetReservedIPOptions(subnetID, reservedIPID)
+	response, err := sess.DeleteSubnetReservedIPWithContext(context, deleteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSubnetReservedIPWithContext failed: %s", err.Error()), "ibm_is_subnet_reserved_ip_pool", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSubnetReservedIPPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet_reserved_ip_pool", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	subnetID := d.Get(isSubNetID).(string)
+	for _, ripIntf := range d.Get(isReservedIPPoolReservedIPs).(*schema.Set).List() {
+		reservedIPID, _ := ripIntf.(map[string]interface{})[isReservedIP].(string)
+		if reservedIPID == "" {
+			continue
+		}
+		if diagErr := deleteSubnetReservedIPPoolMember(context, sess, subnetID, reservedIPID, "delete"); diagErr != nil {
+			return diagErr
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// The import ID is <subnet_id>/<name>,<name>,... , the reserved IPs of the subnet with these names
+// make up the pool.
+func resourceIBMISSubnetReservedIPPoolImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of subnetID/name,name,...", d.Id())
+	}
+	subnetID := parts[0]
+	names := map[string]bool{}
+	for _, name := range strings.Split(parts[1], ",") {
+		names[strings.TrimSpace(name)] = true
+	}
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	pool := make([]map[string]interface{}, 0, len(names))
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetReservedIpsOptions{SubnetID: &subnetID}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := sess.ListSubnetReservedIpsWithContext(context, options)
+		if err != nil || result == nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the reserved IPs of the subnet %s: %s\n%s", subnetID, err, response)
+		}
+		for i := range result.ReservedIps {
+			reservedIP := &result.ReservedIps[i]
+			if reservedIP.Name != nil && names[*reservedIP.Name] {
+				delete(names, *reservedIP.Name)
+				pool = append(pool, subnetReservedIPPoolMemberToMap(reservedIP))
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	if len(names) > 0 {
+		missing := make([]string, 0, len(names))
+		for name := range names {
+			missing = append(missing, name)
+		}
+		return nil, fmt.Errorf("[ERROR] The reserved IPs %s were not found in the subnet %s", strings.Join(missing, ", "), subnetID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", subnetID, id.UniqueId()))
+	d.Set(isSubNetID, subnetID)
+	d.Set(isReservedIPPoolReservedIPs, pool)
+	return []*schema.ResourceData{d}, nil
+}
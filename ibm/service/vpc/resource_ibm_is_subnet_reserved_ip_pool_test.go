@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSubnetReservedIPPoolResource_basic(t *testing.T) {
+	vpcName := fmt.Sprintf("tfresip-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfresip-subnet-%d", acctest.RandIntRange(10, 100))
+	terraformTag := "ibm_is_subnet_reserved_ip_pool.pool"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckisSubnetReservedIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Tests create
+				Config: testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName, []string{"pool-ip-1", "pool-ip-2", "pool-ip-3"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(terraformTag, "reserved_ips.*", map[string]string{
+						"name":            "pool-ip-1",
+						"auto_delete":     "false",
+						"lifecycle_state": "stable",
+					}),
+				),
+			},
+			{
+				// Tests adding and removing reserved IPs
+				Config: testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName, []string{"pool-ip-1", "pool-ip-2", "pool-ip-4", "pool-ip-5"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(terraformTag, "reserved_ips.*", map[string]string{
+						"name":            "pool-ip-5",
+						"lifecycle_state": "stable",
+					}),
+				),
+			},
+			{
+				// Tests importing the pool by the names of its reserved IPs
+				ResourceName: terraformTag,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[terraformTag]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", terraformTag)
+					}
+					return fmt.Sprintf("%s/pool-ip-1,pool-ip-2,pool-ip-4,pool-ip-5", rs.Primary.Attributes["subnet"]), nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("Expected 1 imported pool, got %d", len(states))
+					}
+					if count := states[0].Attributes["reserved_ips.#"]; count != "4" {
+						return fmt.Errorf("Expected 4 imported reserved IPs, got %s", count)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckisSubnetReservedIPPoolDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_subnet_reserved_ip_pool" {
+			continue
+		}
+		for key, reservedIPID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "reserved_ips.") || !strings.HasSuffix(key, ".reserved_ip") {
+				continue
+			}
+			getOptions := sess.NewGetSubnetReservedIPOptions(rs.Primary.Attributes["subnet"], reservedIPID)
+			_, _, err := sess.GetSubnetReservedIP(getOptions)
+			if err == nil {
+				return fmt.Errorf("Reserved IP %s still exists", reservedIPID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName string, names []string) string {
+	reservedIPs := ""
+	for _, name := range names {
+		reservedIPs += fmt.Sprintf(`
+		reserved_ips {
+			name = "%s"
+		}`, name)
+	}
+	return fmt.Sprintf(`
+	  resource "ibm_is_vpc" "vpc1" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "subnet1" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.vpc1.id
+		zone                     = "us-south-1"
+		total_ipv4_address_count = 256
+	  }
+
+	  resource "ibm_is_subnet_reserved_ip_pool" "pool" {
+		subnet = ibm_is_subnet.subnet1.id
+		%s
+	  }`, vpcName, subnetName, reservedIPs)
+}
@@ -56,6 +56,8 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -96,6 +98,13 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 				Description: "Indicates whether the traffic is distributed between the `up` tunnels of the VPN gateway connection when the VPC route's next hop is a VPN connection. If `false`, the traffic is only routed through the `up` tunnel with the lower `public_ip` address.",
 			},
 
+			"wait_for_tunnels_up": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the VPN gateway connection and all its tunnels to be `up` after the connection is created or updated, the peer must be configured to establish the connection.",
+			},
+
 			// new breaking changes
 			"establish_mode": &schema.Schema{
 				Type:         schema.TypeString,
@@ -449,6 +458,12 @@ func resourceIBMISVPNGatewayConnectionCreate(context context.Context, d *schema.
 	if err != nil {
 		return err
 	}
+	if d.Get("wait_for_tunnels_up").(bool) {
+		diagErr := vpngwconWaitForTunnelsUp(context, d, meta, d.Timeout(schema.TimeoutCreate), "create")
+		if diagErr != nil {
+			return diagErr
+		}
+	}
 	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
@@ -745,6 +760,12 @@ func resourceIBMISVPNGatewayConnectionUpdate(context context.Context, d *schema.
 	if diagErr != nil {
 		return diagErr
 	}
+	if d.Get("wait_for_tunnels_up").(bool) {
+		diagErr = vpngwconWaitForTunnelsUp(context, d, meta, d.Timeout(schema.TimeoutUpdate), "update")
+		if diagErr != nil {
+			return diagErr
+		}
+	}
 	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
@@ -1050,6 +1071,79 @@ func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+func vpngwconWaitForTunnelsUp(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", operation, "sep-id-parts").GetDiag()
+	}
+	_, err = isWaitForVPNGatewayConnectionTunnelsUp(context, sess, parts[0], parts[1], timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNGatewayConnectionTunnelsUp failed: %s", err.Error()), "ibm_is_vpn_gateway_connection", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func isWaitForVPNGatewayConnectionTunnelsUp(context context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPNGatewayConnection (%s) and its tunnels to be up.", gConnID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"down"},
+		Target:     []string{"up"},
+		Refresh:    isVPNGatewayConnectionTunnelsUpRefreshFunc(context, vpnGatewayConnection, gID, gConnID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isVPNGatewayConnectionTunnelsUpRefreshFunc(context context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
+			VPNGatewayID: &gID,
+			ID:           &gConnID,
+		}
+		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnectionWithContext(context, getVpnGatewayConnectionOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection: %s\n%s", err, response)
+		}
+		status, tunnels := vpnGatewayConnectionStatusAndTunnels(vpngwcon)
+		if status == nil || *status != "up" {
+			return vpngwcon, "down", nil
+		}
+		// The connections in policy mode have no tunnels, their status is the status of the connection
+		for _, tunnel := range tunnels {
+			if tunnel.Status == nil || *tunnel.Status != "up" {
+				return vpngwcon, "down", nil
+			}
+		}
+		return vpngwcon, "up", nil
+	}
+}
+
+func vpnGatewayConnectionStatusAndTunnels(vpnGatewayConnectionIntf vpcv1.VPNGatewayConnectionIntf) (*string, []vpcv1.VPNGatewayConnectionStaticRouteModeTunnel) {
+	switch vpnGatewayConnection := vpnGatewayConnectionIntf.(type) {
+	case *vpcv1.VPNGatewayConnection:
+		return vpnGatewayConnection.Status, vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionRouteMode:
+		return vpnGatewayConnection.Status, vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode:
+		return vpnGatewayConnection.Status, vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionPolicyMode:
+		return vpnGatewayConnection.Status, nil
+	}
+	return nil, nil
+}
+
 func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 